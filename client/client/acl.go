@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	pb "github.com/Lucascluz/memora-proto/gen"
+)
+
+// ACLSetUser applies ACL rules such as "+@read" or "%R~session:*" to a user, creating it if needed.
+// The change only lasts until the server restarts or reloads its ACL file, unless ACLSave is called.
+func (c *Client) ACLSetUser(ctx context.Context, username string, rules ...string) error {
+	req := &pb.ACLSetUserRequest{Username: username, Rules: rules}
+	if _, err := c.client.ACLSetUser(ctx, req); err != nil {
+		return fmt.Errorf("failed to set acl user %s: %w", username, err)
+	}
+	return nil
+}
+
+// ACLDelUser removes the ACL rules of a user, reporting whether it had any.
+func (c *Client) ACLDelUser(ctx context.Context, username string) (bool, error) {
+	req := &pb.ACLDelUserRequest{Username: username}
+	resp, err := c.client.ACLDelUser(ctx, req)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to delete acl user %s: %w", username, err)
+	}
+	return resp.Found, nil
+}
+
+// ACLList returns the rules of every user, one ACL file line per user.
+func (c *Client) ACLList(ctx context.Context) ([]string, error) {
+	req := &pb.ACLListRequest{}
+	resp, err := c.client.ACLList(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list acl users: %w", err)
+	}
+	return resp.Users, nil
+}
+
+// ACLLoad makes the server reload its ACL file, discarding the changes that were not saved.
+func (c *Client) ACLLoad(ctx context.Context) error {
+	req := &pb.ACLLoadRequest{}
+	if _, err := c.client.ACLLoad(ctx, req); err != nil {
+		return fmt.Errorf("failed to load acl: %w", err)
+	}
+	return nil
+}
+
+// ACLSave makes the server write its current ACL rules to its ACL file.
+func (c *Client) ACLSave(ctx context.Context) error {
+	req := &pb.ACLSaveRequest{}
+	if _, err := c.client.ACLSave(ctx, req); err != nil {
+		return fmt.Errorf("failed to save acl: %w", err)
+	}
+	return nil
+}
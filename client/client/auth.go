@@ -0,0 +1,107 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pb "github.com/Lucascluz/memora-proto/gen"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// authorizationHeader carries the client key as a bearer token
+	authorizationHeader = "authorization"
+	bearerPrefix        = "Bearer "
+)
+
+// intercept attaches the client key to every request. With automatic re-authentication it
+// connects again before using an expired key, and once more when the server rejects the key,
+// retrying the request with the new one.
+func (c *Client) intercept(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	// these carry the session themselves and run under c.mu
+	if method == pb.MemoraService_Connect_FullMethodName || method == pb.MemoraService_Disconnect_FullMethodName {
+		return fromStatus(invoker(ctx, method, req, reply, cc, opts...))
+	}
+
+	key, err := c.session(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = invoker(withClientKey(ctx, key), method, req, reply, cc, opts...)
+	if !c.reauth || status.Code(err) != codes.Unauthenticated {
+		return c.callError(err, key)
+	}
+
+	key, err = c.reconnect(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	return c.callError(invoker(withClientKey(ctx, key), method, req, reply, cc, opts...), key)
+}
+
+// interceptStream attaches the client key to every stream, connecting again before using an
+// expired key with automatic re-authentication. A rejected stream is not retried since it may
+// already have exchanged messages.
+func (c *Client) interceptStream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	key, err := c.session(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := streamer(withClientKey(ctx, key), desc, cc, method, opts...)
+	return stream, c.callError(err, key)
+}
+
+// callError converts the error of a request sent with key into an *Error, which matches ErrExpired
+// when the server rejected the key after it expired even if it no longer tells why
+func (c *Client) callError(err error, key string) error {
+	err = fromStatus(err)
+
+	var e *Error
+	if errors.As(err, &e) && e.Code == codes.Unauthenticated {
+		c.mu.Lock()
+		e.expired = key != "" && key == c.key && !c.expiresAt.IsZero() && !time.Now().Before(c.expiresAt)
+		c.mu.Unlock()
+	}
+	return err
+}
+
+// session returns the client key to send, connecting again first when it expired and re-authentication is enabled
+func (c *Client) session(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.reauth && c.key != "" && !c.expiresAt.IsZero() && !time.Now().Before(c.expiresAt) {
+		if err := c.connect(ctx); err != nil {
+			return "", err
+		}
+	}
+	return c.key, nil
+}
+
+// reconnect replaces the rejected client key stale with a new one. Concurrent requests rejected
+// with the same key share a single reconnection.
+func (c *Client) reconnect(ctx context.Context, stale string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.key == stale {
+		if err := c.connect(ctx); err != nil {
+			return "", err
+		}
+	}
+	return c.key, nil
+}
+
+// withClientKey returns a copy of ctx sending key as the bearer token of the request
+func withClientKey(ctx context.Context, key string) context.Context {
+	if key == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, authorizationHeader, bearerPrefix+key)
+}
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/Lucascluz/memora-proto/gen"
+)
+
+// Entry is a key-value pair stored by MSet
+type Entry struct {
+	Key   string
+	Value []byte
+	// TTL is how long the entry lives, with millisecond precision, 0 never expires
+	TTL time.Duration
+}
+
+// MGet retrieves the values of several keys in a single round trip.
+// The values are returned in the order of keys, nil for the keys that don't exist or failed, along
+// with the error of every key, which matches ErrWrongType for a key holding another type of value.
+// Missing keys have no error. The error is set when the whole batch failed.
+func (c *Client) MGet(ctx context.Context, keys ...string) ([][]byte, []error, error) {
+	req := &pb.MGetRequest{EntryKeys: keys}
+	resp, err := c.client.MGet(ctx, req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get %d keys: %w", len(keys), err)
+	}
+
+	values := make([][]byte, len(resp.Results))
+	errs := make([]error, len(resp.Results))
+	for i, result := range resp.Results {
+		if result.Result != nil && !result.Result.Success {
+			errs[i] = fmt.Errorf("failed to get key %s: %w", keys[i], entryError(result.Result))
+			continue
+		}
+		// found values are never nil, even when they are empty
+		if result.Found && result.Value == nil {
+			result.Value = []byte{}
+		}
+		values[i] = result.Value
+	}
+	return values, errs, nil
+}
+
+// MSet stores several entries in a single round trip, each with its own TTL.
+// It returns the error of every entry in the order of entries, nil for the stored ones, so a
+// failed entry does not fail the others. The error is set when the whole batch failed.
+func (c *Client) MSet(ctx context.Context, entries ...Entry) ([]error, error) {
+	req := &pb.MSetRequest{Entries: make([]*pb.MSetEntry, len(entries))}
+	for i, entry := range entries {
+		req.Entries[i] = &pb.MSetEntry{
+			EntryKey: entry.Key,
+			Value:    entry.Value,
+			Ttl:      milliseconds(entry.TTL),
+			TtlMode:  pb.TtlMode_RELATIVE_MILLISECONDS,
+		}
+	}
+
+	resp, err := c.client.MSet(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set %d keys: %w", len(entries), err)
+	}
+
+	errs := make([]error, len(resp.Results))
+	for i, result := range resp.Results {
+		if !result.Success {
+			errs[i] = fmt.Errorf("failed to set key %s: %w", entries[i].Key, entryError(result))
+		}
+	}
+	return errs, nil
+}
+
+// MDelete removes several keys in a single round trip.
+// It reports for every key, in the order of keys, whether it was found and deleted.
+func (c *Client) MDelete(ctx context.Context, keys ...string) ([]bool, error) {
+	req := &pb.MDeleteRequest{EntryKeys: keys}
+	resp, err := c.client.MDelete(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete %d keys: %w", len(keys), err)
+	}
+	return resp.Found, nil
+}
@@ -4,105 +4,356 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
 	"net"
+	"sync"
+	"time"
 
 	pb "github.com/Lucascluz/memora-proto/gen"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
+// NoExpiration is the ttl reported by TTL for entries that never expire
+const NoExpiration time.Duration = -1
+
+// disconnectTimeout bounds how long Close waits for the server to end the session
+const disconnectTimeout = 5 * time.Second
+
 type Client struct {
 	conn   *grpc.ClientConn
 	client pb.MemoraServiceClient
-	key    string
+
+	// mu guards the session, which automatic re-authentication replaces concurrently with requests
+	mu        sync.Mutex
+	key       string
+	expiresAt time.Time
+
+	username string
+	password string
+	apiKey   string
+	reauth   bool
+
+	// tls is nil for plaintext connections
+	tls *tls.Config
+}
+
+// Option configures a client created by NewClient
+type Option func(*Client)
+
+// WithCredentials authenticates with a username and password on Connect
+func WithCredentials(username, password string) Option {
+	return func(c *Client) {
+		c.username = username
+		c.password = password
+	}
+}
+
+// WithAPIKey authenticates with an API key on Connect
+func WithAPIKey(key string) Option {
+	return func(c *Client) {
+		c.apiKey = key
+	}
+}
+
+// WithAutoReauth connects again when the client key expired or was rejected by the server,
+// retrying the request once with the new key
+func WithAutoReauth() Option {
+	return func(c *Client) {
+		c.reauth = true
+	}
+}
+
+// Stats holds the cache counters reported by the server
+type Stats struct {
+	// Keys is the number of entries currently stored
+	Keys int64
+	// VolatileKeys is the number of entries that have a ttl
+	VolatileKeys int64
+	// ExpiredKeys is the number of entries removed because their ttl passed
+	ExpiredKeys uint64
+	// EvictedKeys is the number of entries removed to stay within the memory limit
+	EvictedKeys uint64
+	// UsedMemory is the memory in bytes taken by keys, values and their overhead
+	UsedMemory int64
+	// MaxMemory is the configured memory limit in bytes, 0 when there is none
+	MaxMemory int64
+}
+
+// WithTLSConfig connects over TLS with the given config, e.g. to set the server name
+func WithTLSConfig(config *tls.Config) Option {
+	return func(c *Client) {
+		c.tls = config.Clone()
+	}
+}
+
+// WithRootCAs connects over TLS, verifying the server certificate against pool instead of the system roots
+func WithRootCAs(pool *x509.CertPool) Option {
+	return func(c *Client) {
+		c.tlsConfig().RootCAs = pool
+	}
+}
+
+// WithClientCertificate connects over TLS, presenting cert to servers that verify client certificates.
+// The server identifies the client by the certificate subject.
+func WithClientCertificate(cert tls.Certificate) Option {
+	return func(c *Client) {
+		config := c.tlsConfig()
+		config.Certificates = append(config.Certificates, cert)
+	}
+}
+
+// tlsConfig returns the TLS config of the client, creating it when the connection was plaintext so far
+func (c *Client) tlsConfig() *tls.Config {
+	if c.tls == nil {
+		c.tls = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	return c.tls
 }
 
 // NewClient creates a new gRPC client connection to the Memora service at the specified address.
-// It establishes an insecure connection and returns a Client instance or an error if connection fails.
-func NewClient(address string) (*Client, error) {
+// It establishes an insecure connection unless TLS options are given and returns a Client instance
+// or an error if connection fails.
+func NewClient(address string, opts ...Option) (*Client, error) {
+	c := &Client{}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	transport := insecure.NewCredentials()
+	if c.tls != nil {
+		transport = credentials.NewTLS(c.tls)
+	}
 
-	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.NewClient(address,
+		grpc.WithTransportCredentials(transport),
+		grpc.WithUnaryInterceptor(c.intercept),
+		grpc.WithStreamInterceptor(c.interceptStream),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to server at %s: %w", address, err)
 	}
 
 	// return connection to the grpc server
-	c := pb.NewMemoraServiceClient(conn)
+	c.conn = conn
+	c.client = pb.NewMemoraServiceClient(conn)
 
-	return &Client{conn: conn, client: c, key: ""}, nil
+	return c, nil
 }
 
-// Connect establishes a connection with the Memora server and gets a client key
+// Connect establishes a connection with the Memora server and gets a client key,
+// authenticating with the credentials given to NewClient.
 func (c *Client) Connect(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.connect(ctx)
+}
+
+// connect opens a new session, callers must hold c.mu
+func (c *Client) connect(ctx context.Context) error {
 	// Get the local IP address
 	clientIP, err := getLocalIP()
 	if err != nil {
 		return fmt.Errorf("failed to get local IP: %w", err)
 	}
 
-	req := &pb.ConnectionRequest{ClientIP: clientIP}
+	req := &pb.ConnectionRequest{ClientIP: clientIP, Username: c.username, Password: c.password, ApiKey: c.apiKey}
 
 	resp, err := c.client.Connect(ctx, req)
 	if err != nil {
 		return fmt.Errorf("failed to connect to server: %w", err)
 	}
 
-	if !resp.Success {
-		return fmt.Errorf("connection failed")
-	}
-
 	// Store the client key for future requests
 	c.key = resp.ClientKey
+	c.expiresAt = time.Time{}
+	if resp.ExpiresAt != 0 {
+		c.expiresAt = time.UnixMilli(resp.ExpiresAt)
+	}
 	return nil
 }
 
 // Set stores a key-value pair in the Memora service.
-// It takes a context, key string, and value as bytes, returning an error if the operation fails.
+// It takes a context, key string, value as bytes and the absolute Unix timestamp in seconds the
+// entry expires at, where 0 means it never expires. It returns an error if the operation fails.
 func (c *Client) Set(ctx context.Context, key string, value []byte, ttl int64) error {
-	req := &pb.SetRequest{ClientKey: c.key, EntryKey: key, Value: value, Ttl: ttl}
-	resp, err := c.client.Set(ctx, req)
-	if err != nil {
+	req := &pb.SetRequest{EntryKey: key, Value: value, Ttl: ttl}
+	if _, err := c.client.Set(ctx, req); err != nil {
 		return fmt.Errorf("failed to set key %s: %w", key, err)
 	}
-	if !resp.Success {
-		return fmt.Errorf("set operation failed for key %s: %s", key, resp.Status)
+	return nil
+}
+
+// SetWithTTL stores a key-value pair that expires once ttl has elapsed, with millisecond precision.
+// A ttl of 0 stores the entry without expiration.
+func (c *Client) SetWithTTL(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	if ttl < 0 {
+		return fmt.Errorf("%w: ttl %s for key %s", ErrInvalidArgument, ttl, key)
+	}
+
+	ms := milliseconds(ttl)
+
+	req := &pb.SetRequest{EntryKey: key, Value: value, Ttl: ms, TtlMode: pb.TtlMode_RELATIVE_MILLISECONDS}
+	if _, err := c.client.Set(ctx, req); err != nil {
+		return fmt.Errorf("failed to set key %s: %w", key, err)
 	}
 	return nil
 }
 
 // Get retrieves the value associated with the given key from the Memora service.
-// It returns the value as bytes if found, or an error if the key doesn't exist or operation fails.
+// It returns the value as bytes if found, or an error matching ErrNotFound if the key doesn't exist.
 func (c *Client) Get(ctx context.Context, key string) ([]byte, error) {
-	req := &pb.GetRequest{ClientKey: c.key, EntryKey: key}
+	req := &pb.GetRequest{EntryKey: key}
 	resp, err := c.client.Get(ctx, req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get key %s: %w", key, err)
 	}
-	if resp.Status != "found" {
-		return nil, fmt.Errorf("key %s not found", key)
-	}
 	return resp.Value, nil
 }
 
+// TTL returns the remaining time to live of the given key, or NoExpiration when it never expires.
+// It returns an error matching ErrNotFound if the key doesn't exist.
+func (c *Client) TTL(ctx context.Context, key string) (time.Duration, error) {
+	req := &pb.TTLRequest{EntryKey: key}
+	resp, err := c.client.TTL(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get ttl of key %s: %w", key, err)
+	}
+	if resp.ExpiresAt == 0 {
+		return NoExpiration, nil
+	}
+	return time.Duration(resp.Ttl) * time.Millisecond, nil
+}
+
+// Expire makes the given key expire once ttl has elapsed, a ttl of 0 removes its expiration.
+// Calling it on every access gives the key a sliding expiration.
+// It returns true if the key was found and updated, false otherwise, along with any error.
+func (c *Client) Expire(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	if ttl < 0 {
+		return false, fmt.Errorf("%w: ttl %s for key %s", ErrInvalidArgument, ttl, key)
+	}
+
+	ms := milliseconds(ttl)
+
+	return c.expire(ctx, key, ms, pb.TtlMode_RELATIVE_MILLISECONDS)
+}
+
+// ExpireAt makes the given key expire at the given time, with second precision.
+// A time in the past deletes the key right away.
+// It returns true if the key was found and updated, false otherwise, along with any error.
+func (c *Client) ExpireAt(ctx context.Context, key string, at time.Time) (bool, error) {
+	if at.Unix() <= 0 {
+		return false, fmt.Errorf("%w: expiration time %s for key %s", ErrInvalidArgument, at, key)
+	}
+	return c.expire(ctx, key, at.Unix(), pb.TtlMode_ABSOLUTE_SECONDS)
+}
+
+// Persist removes the expiration of the given key.
+// It returns true if the key had an expiration that was removed, false otherwise, along with any error.
+func (c *Client) Persist(ctx context.Context, key string) (bool, error) {
+	req := &pb.PersistRequest{EntryKey: key}
+	resp, err := c.client.Persist(ctx, req)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to persist key %s: %w", key, err)
+	}
+	return resp.Persisted, nil
+}
+
+func (c *Client) expire(ctx context.Context, key string, ttl int64, mode pb.TtlMode) (bool, error) {
+	req := &pb.ExpireRequest{EntryKey: key, Ttl: ttl, TtlMode: mode}
+	resp, err := c.client.Expire(ctx, req)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to expire key %s: %w", key, err)
+	}
+	return resp.Found, nil
+}
+
 // Delete removes the key-value pair from the Memora service.
 // It returns true if the key was found and deleted, false otherwise, along with any error.
 func (c *Client) Delete(ctx context.Context, key string) (bool, error) {
-	req := &pb.DeleteRequest{ClientKey: c.key, EntryKey: key}
+	req := &pb.DeleteRequest{EntryKey: key}
 	resp, err := c.client.Delete(ctx, req)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
 	if err != nil {
 		return false, fmt.Errorf("failed to delete key %s: %w", key, err)
 	}
 	return resp.Found, nil
 }
 
-// Close terminates the gRPC connection to the Memora service.
-// It returns an error if the connection fails to close properly.
+// Snapshot asks the server to save a point in time snapshot of the cache to disk.
+// It returns an error if snapshots are disabled on the server or the dump fails.
+func (c *Client) Snapshot(ctx context.Context) error {
+	req := &pb.SnapshotRequest{}
+	if _, err := c.client.Snapshot(ctx, req); err != nil {
+		return fmt.Errorf("failed to save snapshot: %w", err)
+	}
+	return nil
+}
+
+// RewriteAOF asks the server to compact its append only file from the current cache contents.
+// It returns an error if the append only file is disabled or a rewrite is already running.
+func (c *Client) RewriteAOF(ctx context.Context) error {
+	req := &pb.RewriteAOFRequest{}
+	if _, err := c.client.RewriteAOF(ctx, req); err != nil {
+		return fmt.Errorf("failed to rewrite append only file: %w", err)
+	}
+	return nil
+}
+
+// Stats retrieves the current cache counters from the server.
+func (c *Client) Stats(ctx context.Context) (*Stats, error) {
+	req := &pb.StatsRequest{}
+	resp, err := c.client.Stats(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stats: %w", err)
+	}
+	return &Stats{
+		Keys:         resp.Keys,
+		VolatileKeys: resp.VolatileKeys,
+		ExpiredKeys:  resp.ExpiredKeys,
+		EvictedKeys:  resp.EvictedKeys,
+		UsedMemory:   resp.UsedMemory,
+		MaxMemory:    resp.MaxMemory,
+	}, nil
+}
+
+// Close ends the session with the Memora service and terminates the gRPC connection.
+// It returns an error if the session could not be ended or the connection fails to close properly.
 func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var disconnectErr error
+	if c.key != "" {
+		ctx, cancel := context.WithTimeout(context.Background(), disconnectTimeout)
+		_, err := c.client.Disconnect(withClientKey(ctx, c.key), &pb.DisconnectRequest{})
+		cancel()
+		// a rejected key means the session already expired on the server
+		if err != nil && !errors.Is(err, ErrUnauthenticated) {
+			disconnectErr = fmt.Errorf("failed to disconnect from server: %w", err)
+		}
+		c.key = ""
+	}
+
 	if c.conn != nil {
-		return c.conn.Close()
+		if err := c.conn.Close(); err != nil {
+			return err
+		}
 	}
-	return nil
+	return disconnectErr
 }
 
 // SetString stores a string value in the cache with the given key.
@@ -122,6 +373,16 @@ func (c *Client) GetString(ctx context.Context, key string) (string, error) {
 	return string(data), nil
 }
 
+// milliseconds converts a ttl into milliseconds, rounding sub millisecond durations up so they
+// do not turn into "never expires"
+func milliseconds(ttl time.Duration) int64 {
+	ms := ttl.Milliseconds()
+	if ttl > 0 && ms == 0 {
+		ms = 1
+	}
+	return ms
+}
+
 // getLocalIP returns the local IP address of the client
 func getLocalIP() (string, error) {
 	conn, err := net.Dial("udp", "8.8.8.8:80")
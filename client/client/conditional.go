@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/Lucascluz/memora-proto/gen"
+)
+
+// GetWithVersion retrieves the value of the given key along with its version, which changes on
+// every write of the key. Pass the version to CompareAndSwap to update the key only if it did not change.
+func (c *Client) GetWithVersion(ctx context.Context, key string) ([]byte, uint64, error) {
+	req := &pb.GetRequest{EntryKey: key}
+	resp, err := c.client.Get(ctx, req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get key %s: %w", key, err)
+	}
+	return resp.Value, resp.Version, nil
+}
+
+// SetNX stores a key-value pair only if the key does not exist, a ttl of 0 never expires.
+// It returns true if the value was stored, false if the key already existed.
+func (c *Client) SetNX(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	_, err := c.setIf(ctx, key, value, ttl, pb.SetCondition_IF_ABSENT, 0)
+	if errors.Is(err, ErrExists) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// SetXX stores a key-value pair only if the key already exists, a ttl of 0 never expires.
+// It returns true if the value was stored, false if the key did not exist.
+func (c *Client) SetXX(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	_, err := c.setIf(ctx, key, value, ttl, pb.SetCondition_IF_PRESENT, 0)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// CompareAndSwap stores a key-value pair only if the key still has the given version, as returned
+// by GetWithVersion, a version of 0 only matching a missing key. A ttl of 0 never expires.
+// It returns the new version and true if the value was stored, false if the version did not match.
+func (c *Client) CompareAndSwap(ctx context.Context, key string, version uint64, value []byte, ttl time.Duration) (uint64, bool, error) {
+	newVersion, err := c.setIf(ctx, key, value, ttl, pb.SetCondition_IF_VERSION, version)
+	if errors.Is(err, ErrConflict) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return newVersion, true, nil
+}
+
+// GetSet stores a key-value pair and returns the value it replaced, nil if the key did not exist.
+// A ttl of 0 never expires.
+func (c *Client) GetSet(ctx context.Context, key string, value []byte, ttl time.Duration) ([]byte, error) {
+	if ttl < 0 {
+		return nil, fmt.Errorf("%w: ttl %s for key %s", ErrInvalidArgument, ttl, key)
+	}
+
+	req := &pb.GetSetRequest{EntryKey: key, Value: value, Ttl: milliseconds(ttl), TtlMode: pb.TtlMode_RELATIVE_MILLISECONDS}
+	resp, err := c.client.GetSet(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to getset key %s: %w", key, err)
+	}
+	if !resp.Found {
+		return nil, nil
+	}
+	return resp.OldValue, nil
+}
+
+// GetDel removes the given key and returns the value it held.
+// It returns an error matching ErrNotFound if the key doesn't exist.
+func (c *Client) GetDel(ctx context.Context, key string) ([]byte, error) {
+	req := &pb.GetDelRequest{EntryKey: key}
+	resp, err := c.client.GetDel(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to getdel key %s: %w", key, err)
+	}
+	return resp.Value, nil
+}
+
+// setIf stores a key-value pair expiring after ttl when the condition holds and returns its version
+func (c *Client) setIf(ctx context.Context, key string, value []byte, ttl time.Duration, cond pb.SetCondition, version uint64) (uint64, error) {
+	if ttl < 0 {
+		return 0, fmt.Errorf("%w: ttl %s for key %s", ErrInvalidArgument, ttl, key)
+	}
+
+	req := &pb.SetRequest{
+		EntryKey:  key,
+		Value:     value,
+		Ttl:       milliseconds(ttl),
+		TtlMode:   pb.TtlMode_RELATIVE_MILLISECONDS,
+		Condition: cond,
+		Version:   version,
+	}
+	resp, err := c.client.Set(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to set key %s: %w", key, err)
+	}
+	return resp.Version, nil
+}
@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	pb "github.com/Lucascluz/memora-proto/gen"
+)
+
+// IncrOption configures the ttl of a counter updated by Incr, Decr, IncrBy, DecrBy and IncrByFloat
+type IncrOption func(*incrOptions)
+
+type incrOptions struct {
+	ttl     time.Duration
+	replace bool
+}
+
+// WithIncrTTL makes a counter created by the increment expire once ttl has elapsed.
+// Existing counters keep their ttl.
+func WithIncrTTL(ttl time.Duration) IncrOption {
+	return func(o *incrOptions) {
+		o.ttl = ttl
+		o.replace = false
+	}
+}
+
+// WithIncrResetTTL makes the counter expire once ttl has elapsed, replacing the ttl of an existing
+// counter. A ttl of 0 removes its expiration.
+func WithIncrResetTTL(ttl time.Duration) IncrOption {
+	return func(o *incrOptions) {
+		o.ttl = ttl
+		o.replace = true
+	}
+}
+
+// Incr adds 1 to the integer stored under the given key and returns the new value.
+// A missing key is created at 0 first, see IncrBy.
+func (c *Client) Incr(ctx context.Context, key string, opts ...IncrOption) (int64, error) {
+	return c.IncrBy(ctx, key, 1, opts...)
+}
+
+// Decr subtracts 1 from the integer stored under the given key and returns the new value.
+// A missing key is created at 0 first, see IncrBy.
+func (c *Client) Decr(ctx context.Context, key string, opts ...IncrOption) (int64, error) {
+	return c.IncrBy(ctx, key, -1, opts...)
+}
+
+// IncrBy atomically adds delta to the integer stored as decimal text under the given key and
+// returns the new value. A missing key is created at 0 without expiration unless WithIncrTTL is
+// given, an existing key keeps its ttl unless WithIncrResetTTL is given.
+// It returns an error matching ErrInvalidArgument if the value is not an integer or the result overflows.
+func (c *Client) IncrBy(ctx context.Context, key string, delta int64, opts ...IncrOption) (int64, error) {
+	o, err := incrConfig(key, opts)
+	if err != nil {
+		return 0, err
+	}
+
+	req := &pb.IncrByRequest{
+		EntryKey:   key,
+		Delta:      delta,
+		Ttl:        milliseconds(o.ttl),
+		TtlMode:    pb.TtlMode_RELATIVE_MILLISECONDS,
+		ReplaceTtl: o.replace,
+	}
+	resp, err := c.client.IncrBy(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to increment key %s: %w", key, err)
+	}
+	return resp.Value, nil
+}
+
+// DecrBy atomically subtracts delta from the integer stored under the given key and returns the
+// new value, see IncrBy.
+func (c *Client) DecrBy(ctx context.Context, key string, delta int64, opts ...IncrOption) (int64, error) {
+	if delta == math.MinInt64 {
+		return 0, fmt.Errorf("%w: decrement %d of key %s overflows", ErrInvalidArgument, delta, key)
+	}
+	return c.IncrBy(ctx, key, -delta, opts...)
+}
+
+// IncrByFloat atomically adds delta to the number stored as decimal text under the given key and
+// returns the new value, negative deltas decrementing it. The ttl is handled like IncrBy.
+// It returns an error matching ErrInvalidArgument if the value is not a number or the result is not finite.
+func (c *Client) IncrByFloat(ctx context.Context, key string, delta float64, opts ...IncrOption) (float64, error) {
+	if math.IsNaN(delta) || math.IsInf(delta, 0) {
+		return 0, fmt.Errorf("%w: increment %v of key %s", ErrInvalidArgument, delta, key)
+	}
+	o, err := incrConfig(key, opts)
+	if err != nil {
+		return 0, err
+	}
+
+	req := &pb.IncrByFloatRequest{
+		EntryKey:   key,
+		Delta:      delta,
+		Ttl:        milliseconds(o.ttl),
+		TtlMode:    pb.TtlMode_RELATIVE_MILLISECONDS,
+		ReplaceTtl: o.replace,
+	}
+	resp, err := c.client.IncrByFloat(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to increment key %s: %w", key, err)
+	}
+	return resp.Value, nil
+}
+
+// incrConfig applies the options of an increment, rejecting negative ttls
+func incrConfig(key string, opts []IncrOption) (incrOptions, error) {
+	var o incrOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.ttl < 0 {
+		return o, fmt.Errorf("%w: ttl %s for key %s", ErrInvalidArgument, o.ttl, key)
+	}
+	return o, nil
+}
@@ -0,0 +1,123 @@
+package client
+
+import (
+	"errors"
+
+	pb "github.com/Lucascluz/memora-proto/gen"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Sentinel errors matched by the errors returned from the client methods, test them with errors.Is
+var (
+	// ErrNotFound is returned for a key, or ACL user, that does not exist
+	ErrNotFound = errors.New("not found")
+	// ErrUnauthenticated is returned when the credentials or the client key are rejected
+	ErrUnauthenticated = errors.New("unauthenticated")
+	// ErrExpired is returned when the client key expired, it also matches ErrUnauthenticated
+	ErrExpired = errors.New("client key expired")
+	// ErrPermissionDenied is returned for requests the ACL rules of the user deny
+	ErrPermissionDenied = errors.New("permission denied")
+	// ErrInvalidArgument is returned for invalid requests, e.g. a negative ttl
+	ErrInvalidArgument = errors.New("invalid argument")
+	// ErrOutOfMemory is returned when the server cannot make room for an entry
+	ErrOutOfMemory = errors.New("out of memory")
+	// ErrExists is returned by conditional writes when the key already exists
+	ErrExists = errors.New("already exists")
+	// ErrConflict is returned when a concurrent change got in the way, e.g. a version that no longer matches
+	ErrConflict = errors.New("conflict")
+	// ErrDisabled is returned for features the server runs without, e.g. snapshots
+	ErrDisabled = errors.New("disabled")
+	// ErrWrongType is returned for commands on a key holding another type of value, e.g. HGet on a string
+	ErrWrongType = errors.New("wrong type")
+)
+
+// ErrorInfo reasons of the server the client tells apart
+const (
+	reasonSessionExpired = "SESSION_EXPIRED"
+	reasonWrongType      = "WRONG_TYPE"
+	reasonDisabled       = "DISABLED"
+)
+
+// sentinels maps the status codes of the server to the sentinel errors they match
+var sentinels = map[codes.Code]error{
+	codes.NotFound:          ErrNotFound,
+	codes.Unauthenticated:   ErrUnauthenticated,
+	codes.PermissionDenied:  ErrPermissionDenied,
+	codes.InvalidArgument:   ErrInvalidArgument,
+	codes.ResourceExhausted: ErrOutOfMemory,
+	codes.AlreadyExists:     ErrExists,
+	codes.Aborted:           ErrConflict,
+}
+
+// reasonSentinels maps the ErrorInfo reasons of the server to the sentinel errors they match
+// instead of the sentinel of their status code
+var reasonSentinels = map[string]error{
+	reasonWrongType: ErrWrongType,
+	reasonDisabled:  ErrDisabled,
+}
+
+// Error is a request the server failed. It matches the sentinel error of its reason or status code
+// with errors.Is, and still works with status.FromError and status.Code.
+type Error struct {
+	Code    codes.Code
+	Message string
+	// Reason is the ErrorInfo reason sent by the server, e.g. KEY_NOT_FOUND, empty when there is none
+	Reason string
+	// Metadata is the ErrorInfo metadata sent by the server, e.g. the key the error is about
+	Metadata map[string]string
+
+	status *status.Status
+	// expired is set when the client key the request was sent with had expired
+	expired bool
+}
+
+func (e *Error) Error() string {
+	return e.Message + " (" + e.Code.String() + ")"
+}
+
+// Is reports whether target is the sentinel error matched by e
+func (e *Error) Is(target error) bool {
+	if target == ErrExpired {
+		return e.Code == codes.Unauthenticated && (e.expired || e.Reason == reasonSessionExpired)
+	}
+	if sentinel, ok := reasonSentinels[e.Reason]; ok {
+		return target == sentinel
+	}
+	sentinel, ok := sentinels[e.Code]
+	return ok && target == sentinel
+}
+
+// GRPCStatus returns the status the server failed the request with
+func (e *Error) GRPCStatus() *status.Status {
+	return e.status
+}
+
+// fromStatus converts the errors of gRPC calls into an *Error, other errors are returned unchanged
+func fromStatus(err error) error {
+	var e *Error
+	if err == nil || errors.As(err, &e) {
+		return err
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	e = &Error{Code: st.Code(), Message: st.Message(), status: st}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			e.Reason = info.Reason
+			e.Metadata = info.Metadata
+			break
+		}
+	}
+	return e
+}
+
+// entryError converts the result of a failed batch entry into an *Error
+func entryError(result *pb.EntryResult) error {
+	code := codes.Code(result.Code)
+	return &Error{Code: code, Message: result.Message, Reason: result.Reason, status: status.New(code, result.Message)}
+}
@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	pb "github.com/Lucascluz/memora-proto/gen"
+)
+
+// HSet stores the values of fields of the hash under the given key, creating the hash without
+// expiration if the key doesn't exist. It returns how many of the fields are new.
+// It returns an error matching ErrWrongType if the key holds another type of value.
+func (c *Client) HSet(ctx context.Context, key string, fields map[string][]byte) (int, error) {
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("%w: no fields for key %s", ErrInvalidArgument, key)
+	}
+
+	req := &pb.HSetRequest{EntryKey: key, Fields: fields}
+	resp, err := c.client.HSet(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to hset key %s: %w", key, err)
+	}
+	return int(resp.Added), nil
+}
+
+// HGet retrieves the value of a field of the hash under the given key.
+// It returns an error matching ErrNotFound if the key or the field doesn't exist.
+func (c *Client) HGet(ctx context.Context, key, field string) ([]byte, error) {
+	req := &pb.HGetRequest{EntryKey: key, Field: field}
+	resp, err := c.client.HGet(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hget field %s of key %s: %w", field, key, err)
+	}
+	if resp.Value == nil {
+		return []byte{}, nil
+	}
+	return resp.Value, nil
+}
+
+// HGetAll retrieves every field of the hash under the given key.
+// It returns an error matching ErrNotFound if the key doesn't exist.
+func (c *Client) HGetAll(ctx context.Context, key string) (map[string][]byte, error) {
+	req := &pb.HGetAllRequest{EntryKey: key}
+	resp, err := c.client.HGetAll(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hgetall key %s: %w", key, err)
+	}
+	return resp.Fields, nil
+}
+
+// HDel removes fields from the hash under the given key, deleting the key along with its last field.
+// It returns how many of the fields existed, 0 if the key doesn't exist.
+func (c *Client) HDel(ctx context.Context, key string, fields ...string) (int, error) {
+	req := &pb.HDelRequest{EntryKey: key, Fields: fields}
+	resp, err := c.client.HDel(ctx, req)
+	if errors.Is(err, ErrNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to hdel key %s: %w", key, err)
+	}
+	return int(resp.Deleted), nil
+}
+
+// HIncrBy atomically adds delta to the integer stored in a field of the hash under the given key
+// and returns the new value, creating the key and the field at 0 if they don't exist.
+// It returns an error matching ErrInvalidArgument if the field is not an integer or the result overflows.
+func (c *Client) HIncrBy(ctx context.Context, key, field string, delta int64) (int64, error) {
+	req := &pb.HIncrByRequest{EntryKey: key, Field: field, Delta: delta}
+	resp, err := c.client.HIncrBy(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to hincrby field %s of key %s: %w", field, key, err)
+	}
+	return resp.Value, nil
+}
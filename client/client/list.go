@@ -0,0 +1,171 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/Lucascluz/memora-proto/gen"
+)
+
+// LPush pushes values to the head of the list under the given key one after the other, so they end
+// up in reverse order, creating the list without expiration if the key doesn't exist.
+// It returns the length of the list after the push.
+func (c *Client) LPush(ctx context.Context, key string, values ...[]byte) (int, error) {
+	return c.push(ctx, key, values, true)
+}
+
+// RPush pushes values to the tail of the list under the given key, so they end up in order, creating
+// the list without expiration if the key doesn't exist. It returns the length of the list after the push.
+func (c *Client) RPush(ctx context.Context, key string, values ...[]byte) (int, error) {
+	return c.push(ctx, key, values, false)
+}
+
+func (c *Client) push(ctx context.Context, key string, values [][]byte, front bool) (int, error) {
+	if len(values) == 0 {
+		return 0, fmt.Errorf("%w: no values for key %s", ErrInvalidArgument, key)
+	}
+
+	req := &pb.PushRequest{EntryKey: key, Values: values}
+	var resp *pb.PushResponse
+	var err error
+	if front {
+		resp, err = c.client.LPush(ctx, req)
+	} else {
+		resp, err = c.client.RPush(ctx, req)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to push to key %s: %w", key, err)
+	}
+	return int(resp.Length), nil
+}
+
+// LPop removes the value at the head of the list under the given key and returns it.
+// It returns an error matching ErrNotFound if the list is empty.
+func (c *Client) LPop(ctx context.Context, key string) ([]byte, error) {
+	values, err := c.pop(ctx, key, 1, true)
+	if err != nil {
+		return nil, err
+	}
+	return values[0], nil
+}
+
+// RPop removes the value at the tail of the list under the given key and returns it.
+// It returns an error matching ErrNotFound if the list is empty.
+func (c *Client) RPop(ctx context.Context, key string) ([]byte, error) {
+	values, err := c.pop(ctx, key, 1, false)
+	if err != nil {
+		return nil, err
+	}
+	return values[0], nil
+}
+
+// LPopCount removes up to count values from the head of the list under the given key and returns
+// them in the order they were removed. It returns an error matching ErrNotFound if the list is empty.
+func (c *Client) LPopCount(ctx context.Context, key string, count int) ([][]byte, error) {
+	return c.pop(ctx, key, count, true)
+}
+
+// RPopCount removes up to count values from the tail of the list under the given key like LPopCount
+func (c *Client) RPopCount(ctx context.Context, key string, count int) ([][]byte, error) {
+	return c.pop(ctx, key, count, false)
+}
+
+func (c *Client) pop(ctx context.Context, key string, count int, front bool) ([][]byte, error) {
+	if count < 1 {
+		return nil, fmt.Errorf("%w: count %d for key %s", ErrInvalidArgument, count, key)
+	}
+
+	req := &pb.PopRequest{EntryKey: key, Count: int64(count)}
+	var resp *pb.PopResponse
+	var err error
+	if front {
+		resp, err = c.client.LPop(ctx, req)
+	} else {
+		resp, err = c.client.RPop(ctx, req)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to pop from key %s: %w", key, err)
+	}
+	return nonNil(resp.Values), nil
+}
+
+// BLPop removes the value at the head of the first list of keys that is not empty and returns it
+// along with its key. When they all are it waits up to timeout for a value to be pushed, a timeout
+// of 0 waiting as long as ctx allows. Clients blocked on the same key are served in the order they
+// blocked. It returns an error matching ErrNotFound if no value was pushed in time.
+func (c *Client) BLPop(ctx context.Context, timeout time.Duration, keys ...string) (string, []byte, error) {
+	return c.blockingPop(ctx, timeout, keys, true)
+}
+
+// BRPop removes the value at the tail of the first list of keys that is not empty like BLPop
+func (c *Client) BRPop(ctx context.Context, timeout time.Duration, keys ...string) (string, []byte, error) {
+	return c.blockingPop(ctx, timeout, keys, false)
+}
+
+func (c *Client) blockingPop(ctx context.Context, timeout time.Duration, keys []string, front bool) (string, []byte, error) {
+	if len(keys) == 0 {
+		return "", nil, fmt.Errorf("%w: no keys", ErrInvalidArgument)
+	}
+	if timeout < 0 {
+		return "", nil, fmt.Errorf("%w: timeout %s", ErrInvalidArgument, timeout)
+	}
+
+	req := &pb.BlockingPopRequest{EntryKeys: keys, Timeout: milliseconds(timeout)}
+	var resp *pb.BlockingPopResponse
+	var err error
+	if front {
+		resp, err = c.client.BLPop(ctx, req)
+	} else {
+		resp, err = c.client.BRPop(ctx, req)
+	}
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to pop from keys %v: %w", keys, err)
+	}
+	if resp.Value == nil {
+		return resp.EntryKey, []byte{}, nil
+	}
+	return resp.EntryKey, resp.Value, nil
+}
+
+// LRange retrieves the values of the list under the given key from index start to stop included,
+// negative indexes counting from the tail, e.g. LRange(ctx, key, 0, -1) for the whole list.
+// A missing key is an empty list.
+func (c *Client) LRange(ctx context.Context, key string, start, stop int) ([][]byte, error) {
+	req := &pb.LRangeRequest{EntryKey: key, Start: int64(start), Stop: int64(stop)}
+	resp, err := c.client.LRange(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read range of key %s: %w", key, err)
+	}
+	return nonNil(resp.Values), nil
+}
+
+// LTrim keeps the values of the list under the given key from index start to stop included like
+// LRange, deleting the key if the range is empty
+func (c *Client) LTrim(ctx context.Context, key string, start, stop int) error {
+	req := &pb.LTrimRequest{EntryKey: key, Start: int64(start), Stop: int64(stop)}
+	if _, err := c.client.LTrim(ctx, req); err != nil {
+		return fmt.Errorf("failed to trim key %s: %w", key, err)
+	}
+	return nil
+}
+
+// LLen returns the length of the list under the given key, 0 if the key doesn't exist
+func (c *Client) LLen(ctx context.Context, key string) (int, error) {
+	req := &pb.LLenRequest{EntryKey: key}
+	resp, err := c.client.LLen(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get length of key %s: %w", key, err)
+	}
+	return int(resp.Length), nil
+}
+
+// nonNil replaces the empty values decoded as nil with empty byte slices
+func nonNil(values [][]byte) [][]byte {
+	for i, value := range values {
+		if value == nil {
+			values[i] = []byte{}
+		}
+	}
+	return values
+}
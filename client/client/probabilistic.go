@@ -0,0 +1,169 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/Lucascluz/memora-proto/gen"
+)
+
+// PFAdd adds elements to the HyperLogLog under the given key, creating it without expiration if
+// the key doesn't exist. A HyperLogLog estimates the number of distinct elements added to it with a
+// standard error of 0.81% in 16 KB. It reports whether the estimate may have changed.
+// It returns an error matching ErrWrongType if the key holds another type of value.
+func (c *Client) PFAdd(ctx context.Context, key string, elements ...string) (bool, error) {
+	req := &pb.PFAddRequest{EntryKey: key, Elements: elements}
+	resp, err := c.client.PFAdd(ctx, req)
+	if err != nil {
+		return false, fmt.Errorf("failed to pfadd key %s: %w", key, err)
+	}
+	return resp.Changed, nil
+}
+
+// PFCount estimates the number of distinct elements added to the HyperLogLogs under the given keys,
+// counting the elements added to several of them once. Missing keys count no elements.
+func (c *Client) PFCount(ctx context.Context, keys ...string) (uint64, error) {
+	if len(keys) == 0 {
+		return 0, fmt.Errorf("%w: no keys", ErrInvalidArgument)
+	}
+
+	req := &pb.PFCountRequest{EntryKeys: keys}
+	resp, err := c.client.PFCount(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count keys %v: %w", keys, err)
+	}
+	return resp.Count, nil
+}
+
+// PFMerge stores under dest a HyperLogLog counting the elements of dest and of the HyperLogLogs
+// under the given keys, creating dest without expiration if it doesn't exist
+func (c *Client) PFMerge(ctx context.Context, dest string, keys ...string) error {
+	req := &pb.PFMergeRequest{Destination: dest, EntryKeys: keys}
+	if _, err := c.client.PFMerge(ctx, req); err != nil {
+		return fmt.Errorf("failed to merge keys %v into key %s: %w", keys, dest, err)
+	}
+	return nil
+}
+
+// BFReserve creates an empty Bloom filter under the given key without expiration, with a rate of
+// false positives of at most errorRate and room for capacity items before it grows.
+// It returns an error matching ErrExists if the key already exists.
+func (c *Client) BFReserve(ctx context.Context, key string, errorRate float64, capacity int) error {
+	req := &pb.BFReserveRequest{EntryKey: key, ErrorRate: errorRate, Capacity: int64(capacity)}
+	if _, err := c.client.BFReserve(ctx, req); err != nil {
+		return fmt.Errorf("failed to reserve key %s: %w", key, err)
+	}
+	return nil
+}
+
+// BFAdd adds an item to the Bloom filter under the given key, creating it without expiration with
+// an error rate of 0.01 and a capacity of 100 if the key doesn't exist. It reports whether the item
+// was added, false if the filter may already hold it.
+func (c *Client) BFAdd(ctx context.Context, key, item string) (bool, error) {
+	added, err := c.BFMAdd(ctx, key, item)
+	if err != nil {
+		return false, err
+	}
+	return added[0], nil
+}
+
+// BFMAdd adds items to the Bloom filter under the given key like BFAdd, reporting for every item
+// whether it was added
+func (c *Client) BFMAdd(ctx context.Context, key string, items ...string) ([]bool, error) {
+	if len(items) == 0 {
+		return nil, fmt.Errorf("%w: no items for key %s", ErrInvalidArgument, key)
+	}
+
+	req := &pb.BFAddRequest{EntryKey: key, Items: items}
+	resp, err := c.client.BFAdd(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bfadd key %s: %w", key, err)
+	}
+	return resp.Added, nil
+}
+
+// BFExists reports whether the Bloom filter under the given key may hold an item, false if the key
+// doesn't exist. A filter never misses an item added to it.
+func (c *Client) BFExists(ctx context.Context, key, item string) (bool, error) {
+	exists, err := c.BFMExists(ctx, key, item)
+	if err != nil {
+		return false, err
+	}
+	return exists[0], nil
+}
+
+// BFMExists reports for every item whether the Bloom filter under the given key may hold it
+func (c *Client) BFMExists(ctx context.Context, key string, items ...string) ([]bool, error) {
+	req := &pb.BFExistsRequest{EntryKey: key, Items: items}
+	resp, err := c.client.BFExists(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check items of key %s: %w", key, err)
+	}
+	return resp.Exists, nil
+}
+
+// CMSItem is an item of a Count-Min Sketch along with the count to add to it
+type CMSItem struct {
+	Item      string
+	Increment uint64
+}
+
+// CMSInitByDim creates a Count-Min Sketch under the given key without expiration with depth rows of
+// width counters. It returns an error matching ErrExists if the key already exists.
+func (c *Client) CMSInitByDim(ctx context.Context, key string, width, depth uint64) error {
+	req := &pb.CMSInitByDimRequest{EntryKey: key, Width: width, Depth: depth}
+	if _, err := c.client.CMSInitByDim(ctx, req); err != nil {
+		return fmt.Errorf("failed to init key %s: %w", key, err)
+	}
+	return nil
+}
+
+// CMSInitByProb creates a Count-Min Sketch like CMSInitByDim, sized to overcount an item by at most
+// errorRate of the total count with a probability of at least 1 - probability
+func (c *Client) CMSInitByProb(ctx context.Context, key string, errorRate, probability float64) error {
+	req := &pb.CMSInitByProbRequest{EntryKey: key, ErrorRate: errorRate, Probability: probability}
+	if _, err := c.client.CMSInitByProb(ctx, req); err != nil {
+		return fmt.Errorf("failed to init key %s: %w", key, err)
+	}
+	return nil
+}
+
+// CMSIncrBy adds increment to the count of an item in the Count-Min Sketch under the given key and
+// returns its estimated count. It returns an error matching ErrNotFound if the key doesn't exist.
+func (c *Client) CMSIncrBy(ctx context.Context, key, item string, increment uint64) (uint64, error) {
+	counts, err := c.CMSMIncrBy(ctx, key, CMSItem{Item: item, Increment: increment})
+	if err != nil {
+		return 0, err
+	}
+	return counts[0], nil
+}
+
+// CMSMIncrBy adds to the counts of items like CMSIncrBy, counting nothing if a count would overflow,
+// and returns their estimated counts
+func (c *Client) CMSMIncrBy(ctx context.Context, key string, items ...CMSItem) ([]uint64, error) {
+	if len(items) == 0 {
+		return nil, fmt.Errorf("%w: no items for key %s", ErrInvalidArgument, key)
+	}
+
+	req := &pb.CMSIncrByRequest{EntryKey: key, Items: make([]*pb.CMSItem, len(items))}
+	for i, item := range items {
+		req.Items[i] = &pb.CMSItem{Item: item.Item, Increment: item.Increment}
+	}
+	resp, err := c.client.CMSIncrBy(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cmsincrby key %s: %w", key, err)
+	}
+	return resp.Counts, nil
+}
+
+// CMSQuery returns the estimated counts of items in the Count-Min Sketch under the given key, which
+// never undercounts, along with the total count of the sketch.
+// It returns an error matching ErrNotFound if the key doesn't exist.
+func (c *Client) CMSQuery(ctx context.Context, key string, items ...string) ([]uint64, uint64, error) {
+	req := &pb.CMSQueryRequest{EntryKey: key, Items: items}
+	resp, err := c.client.CMSQuery(ctx, req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query items of key %s: %w", key, err)
+	}
+	return resp.Counts, resp.Total, nil
+}
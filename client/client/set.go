@@ -0,0 +1,161 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	pb "github.com/Lucascluz/memora-proto/gen"
+	"google.golang.org/grpc"
+)
+
+// SAdd adds members to the set under the given key, creating the set without expiration if the key
+// doesn't exist. It returns how many of the members are new.
+// It returns an error matching ErrWrongType if the key holds another type of value.
+func (c *Client) SAdd(ctx context.Context, key string, members ...string) (int, error) {
+	if len(members) == 0 {
+		return 0, fmt.Errorf("%w: no members for key %s", ErrInvalidArgument, key)
+	}
+
+	req := &pb.SAddRequest{EntryKey: key, Members: members}
+	resp, err := c.client.SAdd(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to sadd key %s: %w", key, err)
+	}
+	return int(resp.Added), nil
+}
+
+// SRem removes members from the set under the given key, deleting the key along with its last member.
+// It returns how many of the members existed, 0 if the key doesn't exist.
+func (c *Client) SRem(ctx context.Context, key string, members ...string) (int, error) {
+	req := &pb.SRemRequest{EntryKey: key, Members: members}
+	resp, err := c.client.SRem(ctx, req)
+	if errors.Is(err, ErrNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to srem key %s: %w", key, err)
+	}
+	return int(resp.Removed), nil
+}
+
+// SIsMember reports whether member belongs to the set under the given key, false if the key doesn't exist
+func (c *Client) SIsMember(ctx context.Context, key, member string) (bool, error) {
+	req := &pb.SIsMemberRequest{EntryKey: key, Member: member}
+	resp, err := c.client.SIsMember(ctx, req)
+	if err != nil {
+		return false, fmt.Errorf("failed to check member of key %s: %w", key, err)
+	}
+	return resp.IsMember, nil
+}
+
+// SCard returns the number of members of the set under the given key, 0 if the key doesn't exist
+func (c *Client) SCard(ctx context.Context, key string) (int, error) {
+	req := &pb.SCardRequest{EntryKey: key}
+	resp, err := c.client.SCard(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get cardinality of key %s: %w", key, err)
+	}
+	return int(resp.Cardinality), nil
+}
+
+// SMembers retrieves the members of the set under the given key in no particular order.
+// A missing key is an empty set.
+func (c *Client) SMembers(ctx context.Context, key string) ([]string, error) {
+	req := &pb.SMembersRequest{EntryKey: key}
+	resp, err := c.client.SMembers(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get members of key %s: %w", key, err)
+	}
+	return resp.Members, nil
+}
+
+// SRandMember returns a member of the set under the given key picked at random, without removing it.
+// It returns an error matching ErrNotFound if the set is empty.
+func (c *Client) SRandMember(ctx context.Context, key string) (string, error) {
+	members, err := c.SRandMembers(ctx, key, 1)
+	if err != nil {
+		return "", err
+	}
+	if len(members) == 0 {
+		return "", fmt.Errorf("failed to get random member of key %s: %w", key, ErrNotFound)
+	}
+	return members[0], nil
+}
+
+// SRandMembers returns up to count distinct members of the set under the given key picked at random,
+// without removing them
+func (c *Client) SRandMembers(ctx context.Context, key string, count int) ([]string, error) {
+	if count < 1 {
+		return nil, fmt.Errorf("%w: count %d for key %s", ErrInvalidArgument, count, key)
+	}
+
+	req := &pb.SRandMemberRequest{EntryKey: key, Count: int64(count)}
+	resp, err := c.client.SRandMember(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get random members of key %s: %w", key, err)
+	}
+	return resp.Members, nil
+}
+
+// SInter returns the members found in every set under the given keys. Missing keys are empty sets.
+func (c *Client) SInter(ctx context.Context, keys ...string) ([]string, error) {
+	return c.setAlgebra(ctx, c.client.SInter, keys)
+}
+
+// SUnion returns the members found in any set under the given keys. Missing keys are empty sets.
+func (c *Client) SUnion(ctx context.Context, keys ...string) ([]string, error) {
+	return c.setAlgebra(ctx, c.client.SUnion, keys)
+}
+
+// SDiff returns the members of the set under the first key found in none of the others.
+// Missing keys are empty sets.
+func (c *Client) SDiff(ctx context.Context, keys ...string) ([]string, error) {
+	return c.setAlgebra(ctx, c.client.SDiff, keys)
+}
+
+type setAlgebraFunc func(ctx context.Context, req *pb.SetAlgebraRequest, opts ...grpc.CallOption) (*pb.SetAlgebraResponse, error)
+
+func (c *Client) setAlgebra(ctx context.Context, combine setAlgebraFunc, keys []string) ([]string, error) {
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("%w: no keys", ErrInvalidArgument)
+	}
+
+	req := &pb.SetAlgebraRequest{EntryKeys: keys}
+	resp, err := combine(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to combine keys %v: %w", keys, err)
+	}
+	return resp.Members, nil
+}
+
+// SInterStore stores the result of SInter under dest in place of any value, without expiration,
+// and returns its number of members. An empty result deletes dest.
+func (c *Client) SInterStore(ctx context.Context, dest string, keys ...string) (int, error) {
+	return c.setAlgebraStore(ctx, c.client.SInterStore, dest, keys)
+}
+
+// SUnionStore stores the result of SUnion under dest like SInterStore
+func (c *Client) SUnionStore(ctx context.Context, dest string, keys ...string) (int, error) {
+	return c.setAlgebraStore(ctx, c.client.SUnionStore, dest, keys)
+}
+
+// SDiffStore stores the result of SDiff under dest like SInterStore
+func (c *Client) SDiffStore(ctx context.Context, dest string, keys ...string) (int, error) {
+	return c.setAlgebraStore(ctx, c.client.SDiffStore, dest, keys)
+}
+
+type setAlgebraStoreFunc func(ctx context.Context, req *pb.SetAlgebraStoreRequest, opts ...grpc.CallOption) (*pb.SetAlgebraStoreResponse, error)
+
+func (c *Client) setAlgebraStore(ctx context.Context, combine setAlgebraStoreFunc, dest string, keys []string) (int, error) {
+	if len(keys) == 0 {
+		return 0, fmt.Errorf("%w: no keys", ErrInvalidArgument)
+	}
+
+	req := &pb.SetAlgebraStoreRequest{Destination: dest, EntryKeys: keys}
+	resp, err := combine(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to store combination of keys %v into key %s: %w", keys, dest, err)
+	}
+	return int(resp.Cardinality), nil
+}
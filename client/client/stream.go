@@ -0,0 +1,236 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/Lucascluz/memora-proto/gen"
+	"google.golang.org/grpc"
+)
+
+// StreamEntry is an entry of a stream. Its ID is formatted as <ms>-<seq>, the unix millisecond time
+// the entry was added at and a sequence number telling apart the entries of the same millisecond.
+type StreamEntry struct {
+	ID     string
+	Fields map[string][]byte
+}
+
+// PendingEntry is an entry delivered to a consumer of a group and not acknowledged yet
+type PendingEntry struct {
+	ID       string
+	Consumer string
+	// Idle is the time since the last delivery
+	Idle time.Duration
+	// Deliveries counts how many times the entry was delivered
+	Deliveries int
+}
+
+// XAddOption configures how XAdd appends an entry
+type XAddOption func(*pb.XAddRequest)
+
+// WithMaxLen trims the oldest entries of the stream to keep at most maxLen of them
+func WithMaxLen(maxLen int) XAddOption {
+	return func(req *pb.XAddRequest) { req.MaxLen = int64(maxLen) }
+}
+
+// XAdd appends an entry with the given fields to the stream under the given key and returns its id,
+// creating the stream without expiration if the key doesn't exist. Ids grow with time and never
+// repeat within a stream.
+func (c *Client) XAdd(ctx context.Context, key string, fields map[string][]byte, opts ...XAddOption) (string, error) {
+	if len(fields) == 0 {
+		return "", fmt.Errorf("%w: no fields for key %s", ErrInvalidArgument, key)
+	}
+
+	req := &pb.XAddRequest{EntryKey: key, Fields: fields}
+	for _, opt := range opts {
+		opt(req)
+	}
+	if req.MaxLen < 0 {
+		return "", fmt.Errorf("%w: max length %d for key %s", ErrInvalidArgument, req.MaxLen, key)
+	}
+
+	resp, err := c.client.XAdd(ctx, req)
+	if err != nil {
+		return "", fmt.Errorf("failed to xadd key %s: %w", key, err)
+	}
+	return resp.Id, nil
+}
+
+// XLen returns the number of entries of the stream under the given key, 0 if the key doesn't exist
+func (c *Client) XLen(ctx context.Context, key string) (int, error) {
+	req := &pb.XLenRequest{EntryKey: key}
+	resp, err := c.client.XLen(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get length of key %s: %w", key, err)
+	}
+	return int(resp.Length), nil
+}
+
+// XRange retrieves up to count entries of the stream under the given key with an id from start to
+// end included by ascending id, all of them when count is 0. "-" and "+" are the first and the last
+// entry, and an id without sequence number covers its whole millisecond.
+// A missing key is an empty stream.
+func (c *Client) XRange(ctx context.Context, key, start, end string, count int) ([]StreamEntry, error) {
+	return c.xrange(ctx, key, start, end, count, false)
+}
+
+// XRevRange retrieves the entries from end down to start by descending id like XRange, e.g.
+// XRevRange(ctx, key, "+", "-", 1) for the last entry
+func (c *Client) XRevRange(ctx context.Context, key, end, start string, count int) ([]StreamEntry, error) {
+	return c.xrange(ctx, key, start, end, count, true)
+}
+
+func (c *Client) xrange(ctx context.Context, key, start, end string, count int, reverse bool) ([]StreamEntry, error) {
+	if count < 0 {
+		return nil, fmt.Errorf("%w: count %d for key %s", ErrInvalidArgument, count, key)
+	}
+
+	req := &pb.XRangeRequest{EntryKey: key, Start: start, End: end, Count: int64(count), Reverse: reverse}
+	resp, err := c.client.XRange(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read range of key %s: %w", key, err)
+	}
+	return streamEntries(resp.Entries), nil
+}
+
+// XGroupCreate creates a consumer group of the stream under the given key delivering the entries
+// with an id above start, creating an empty stream without expiration if the key doesn't exist.
+// "$" delivers the entries added from now on and "0" every entry.
+// It returns an error matching ErrExists if the group already exists.
+func (c *Client) XGroupCreate(ctx context.Context, key, group, start string) error {
+	req := &pb.XGroupCreateRequest{EntryKey: key, Group: group, Start: start}
+	if _, err := c.client.XGroupCreate(ctx, req); err != nil {
+		return fmt.Errorf("failed to create group %s of key %s: %w", group, key, err)
+	}
+	return nil
+}
+
+// XGroupDestroy deletes a consumer group of the stream under the given key along with its pending
+// entries, reporting whether it existed
+func (c *Client) XGroupDestroy(ctx context.Context, key, group string) (bool, error) {
+	req := &pb.XGroupDestroyRequest{EntryKey: key, Group: group}
+	resp, err := c.client.XGroupDestroy(ctx, req)
+	if err != nil {
+		return false, fmt.Errorf("failed to destroy group %s of key %s: %w", group, key, err)
+	}
+	return resp.Destroyed, nil
+}
+
+// GroupReader receives the entries a consumer group delivers to a consumer, see XReadGroup
+type GroupReader struct {
+	stream grpc.ServerStreamingClient[pb.XReadGroupResponse]
+	ctx    context.Context
+	cancel context.CancelFunc
+	key    string
+	group  string
+}
+
+// XReadGroup reads the stream under the given key as consumer of a group, receiving the entries the
+// group did not deliver to any of its consumers yet, up to count entries at a time. Delivered
+// entries stay pending until they are acknowledged with XAck, and can be claimed by another
+// consumer with XAutoClaim if consumer fails to. The reader waits for new entries until ctx is done
+// or it is closed.
+func (c *Client) XReadGroup(ctx context.Context, key, group, consumer string, count int) (*GroupReader, error) {
+	if count < 0 {
+		return nil, fmt.Errorf("%w: count %d for key %s", ErrInvalidArgument, count, key)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	req := &pb.XReadGroupRequest{EntryKey: key, Group: group, Consumer: consumer, Count: int64(count)}
+	stream, err := c.client.XReadGroup(ctx, req)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to read group %s of key %s: %w", group, key, err)
+	}
+	return &GroupReader{stream: stream, ctx: ctx, cancel: cancel, key: key, group: group}, nil
+}
+
+// Recv waits for the next entries delivered to the consumer.
+// It returns an error matching ErrNotFound if the key or the group doesn't exist or is deleted,
+// and the error of the context of XReadGroup once it is done, context.Canceled after Close.
+func (r *GroupReader) Recv() ([]StreamEntry, error) {
+	resp, err := r.stream.Recv()
+	if err != nil {
+		if ctxErr := r.ctx.Err(); ctxErr != nil {
+			err = ctxErr
+		}
+		return nil, fmt.Errorf("failed to read group %s of key %s: %w", r.group, r.key, fromStatus(err))
+	}
+	return streamEntries(resp.Entries), nil
+}
+
+// Close stops the reader. Entries delivered but not received yet stay pending in the group.
+func (r *GroupReader) Close() {
+	r.cancel()
+}
+
+// XAck acknowledges entries delivered to a consumer group of the stream under the given key,
+// removing them from its pending entries. It returns how many of the ids were pending.
+func (c *Client) XAck(ctx context.Context, key, group string, ids ...string) (int, error) {
+	req := &pb.XAckRequest{EntryKey: key, Group: group, Ids: ids}
+	resp, err := c.client.XAck(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to acknowledge entries of group %s of key %s: %w", group, key, err)
+	}
+	return int(resp.Acknowledged), nil
+}
+
+// XPending retrieves up to count pending entries of a consumer group of the stream under the given
+// key by ascending id, all of them when count is 0, only those of consumer unless it is empty
+func (c *Client) XPending(ctx context.Context, key, group, consumer string, count int) ([]PendingEntry, error) {
+	if count < 0 {
+		return nil, fmt.Errorf("%w: count %d for key %s", ErrInvalidArgument, count, key)
+	}
+
+	req := &pb.XPendingRequest{EntryKey: key, Group: group, Consumer: consumer, Count: int64(count)}
+	resp, err := c.client.XPending(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pending entries of group %s of key %s: %w", group, key, err)
+	}
+
+	entries := make([]PendingEntry, len(resp.Entries))
+	for i, p := range resp.Entries {
+		entries[i] = PendingEntry{
+			ID:         p.GetId(),
+			Consumer:   p.GetConsumer(),
+			Idle:       time.Duration(p.GetIdle()) * time.Millisecond,
+			Deliveries: int(p.GetDeliveries()),
+		}
+	}
+	return entries, nil
+}
+
+// XAutoClaim hands to consumer up to count pending entries of a consumer group of the stream under
+// the given key, with an id from start, that were delivered at least minIdle ago, e.g. the entries
+// of a consumer that crashed. It returns the claimed entries and the id to start the next call at,
+// "0-0" once every pending entry was scanned. Pending entries trimmed from the stream meanwhile are
+// acknowledged, their ids are returned last.
+func (c *Client) XAutoClaim(ctx context.Context, key, group, consumer string, minIdle time.Duration, start string, count int) ([]StreamEntry, string, []string, error) {
+	if minIdle < 0 || count < 0 {
+		return nil, "", nil, fmt.Errorf("%w: min idle %s and count %d for key %s", ErrInvalidArgument, minIdle, count, key)
+	}
+
+	req := &pb.XAutoClaimRequest{
+		EntryKey: key,
+		Group:    group,
+		Consumer: consumer,
+		MinIdle:  milliseconds(minIdle),
+		Start:    start,
+		Count:    int64(count),
+	}
+	resp, err := c.client.XAutoClaim(ctx, req)
+	if err != nil {
+		return nil, "", nil, fmt.Errorf("failed to claim entries of group %s of key %s: %w", group, key, err)
+	}
+	return streamEntries(resp.Entries), resp.Next, resp.DeletedIds, nil
+}
+
+// streamEntries converts the entries of a stream from their messages
+func streamEntries(entries []*pb.StreamEntry) []StreamEntry {
+	result := make([]StreamEntry, len(entries))
+	for i, e := range entries {
+		result[i] = StreamEntry{ID: e.GetId(), Fields: e.GetFields()}
+	}
+	return result
+}
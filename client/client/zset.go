@@ -0,0 +1,278 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	pb "github.com/Lucascluz/memora-proto/gen"
+)
+
+// ZMember is a member of a sorted set along with its score
+type ZMember struct {
+	Member string
+	Score  float64
+}
+
+// ScoreBound is the minimum or maximum score of a range, see MinScore and MaxScore for unbounded ranges
+type ScoreBound struct {
+	Value     float64
+	Exclusive bool
+}
+
+// LexBound is the minimum or maximum member of a range, compared byte by byte. Unbounded leaves
+// the range open on the side of the bound, see MinLex and MaxLex.
+type LexBound struct {
+	Value     string
+	Exclusive bool
+	Unbounded bool
+}
+
+var (
+	// MinScore and MaxScore leave a score range unbounded
+	MinScore = ScoreBound{Value: math.Inf(-1)}
+	MaxScore = ScoreBound{Value: math.Inf(1)}
+
+	// MinLex and MaxLex leave a lexicographical range unbounded
+	MinLex = LexBound{Unbounded: true}
+	MaxLex = LexBound{Unbounded: true}
+)
+
+// ZAddOption restricts which members ZAdd adds or updates
+type ZAddOption func(*pb.ZAddRequest)
+
+// WithZAddNX only adds new members, leaving existing ones untouched
+func WithZAddNX() ZAddOption {
+	return func(req *pb.ZAddRequest) { req.Nx = true }
+}
+
+// WithZAddXX only updates existing members, never adding new ones
+func WithZAddXX() ZAddOption {
+	return func(req *pb.ZAddRequest) { req.Xx = true }
+}
+
+// WithZAddGT only updates existing members to a greater score, new members are still added
+func WithZAddGT() ZAddOption {
+	return func(req *pb.ZAddRequest) { req.Gt = true }
+}
+
+// WithZAddLT only updates existing members to a lower score, new members are still added
+func WithZAddLT() ZAddOption {
+	return func(req *pb.ZAddRequest) { req.Lt = true }
+}
+
+// RangeOption configures the order and the page of ZRangeByScore and ZRangeByLex
+type RangeOption func(*rangeOptions)
+
+type rangeOptions struct {
+	reverse       bool
+	offset, count int
+}
+
+// WithRangeReverse returns the range from its highest member to its lowest
+func WithRangeReverse() RangeOption {
+	return func(o *rangeOptions) { o.reverse = true }
+}
+
+// WithRangeLimit skips offset members of the range and returns up to count of them, a count of 0
+// returning the rest of the range
+func WithRangeLimit(offset, count int) RangeOption {
+	return func(o *rangeOptions) { o.offset, o.count = offset, count }
+}
+
+// ZAdd sets the scores of members of the sorted set under the given key, creating the sorted set
+// without expiration if the key doesn't exist. It returns how many members were added and how many
+// existing ones had their score changed.
+// It returns an error matching ErrInvalidArgument if the options are incompatible, e.g. WithZAddNX with WithZAddXX.
+func (c *Client) ZAdd(ctx context.Context, key string, members []ZMember, opts ...ZAddOption) (int, int, error) {
+	if len(members) == 0 {
+		return 0, 0, fmt.Errorf("%w: no members for key %s", ErrInvalidArgument, key)
+	}
+
+	req := &pb.ZAddRequest{EntryKey: key, Members: make([]*pb.ZMember, len(members))}
+	for i, m := range members {
+		req.Members[i] = &pb.ZMember{Member: m.Member, Score: m.Score}
+	}
+	for _, opt := range opts {
+		opt(req)
+	}
+
+	resp, err := c.client.ZAdd(ctx, req)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to zadd key %s: %w", key, err)
+	}
+	return int(resp.Added), int(resp.Updated), nil
+}
+
+// ZIncrBy adds delta to the score of a member of the sorted set under the given key and returns
+// the new score, creating the key and the member at 0 if they don't exist
+func (c *Client) ZIncrBy(ctx context.Context, key, member string, delta float64) (float64, error) {
+	req := &pb.ZIncrByRequest{EntryKey: key, Member: member, Delta: delta}
+	resp, err := c.client.ZIncrBy(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to zincrby member %s of key %s: %w", member, key, err)
+	}
+	return resp.Score, nil
+}
+
+// ZRem removes members from the sorted set under the given key, deleting the key along with its
+// last member. It returns how many of the members existed, 0 if the key doesn't exist.
+func (c *Client) ZRem(ctx context.Context, key string, members ...string) (int, error) {
+	req := &pb.ZRemRequest{EntryKey: key, Members: members}
+	resp, err := c.client.ZRem(ctx, req)
+	if errors.Is(err, ErrNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to zrem key %s: %w", key, err)
+	}
+	return int(resp.Removed), nil
+}
+
+// ZScore retrieves the score of a member of the sorted set under the given key.
+// It returns an error matching ErrNotFound if the key or the member doesn't exist.
+func (c *Client) ZScore(ctx context.Context, key, member string) (float64, error) {
+	req := &pb.ZScoreRequest{EntryKey: key, Member: member}
+	resp, err := c.client.ZScore(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get score of member %s of key %s: %w", member, key, err)
+	}
+	return resp.Score, nil
+}
+
+// ZCard returns the number of members of the sorted set under the given key, 0 if the key doesn't exist
+func (c *Client) ZCard(ctx context.Context, key string) (int, error) {
+	req := &pb.ZCardRequest{EntryKey: key}
+	resp, err := c.client.ZCard(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get cardinality of key %s: %w", key, err)
+	}
+	return int(resp.Cardinality), nil
+}
+
+// ZRank returns the 0 based rank of a member of the sorted set under the given key by ascending
+// score, along with its score. It returns an error matching ErrNotFound if the key or the member doesn't exist.
+func (c *Client) ZRank(ctx context.Context, key, member string) (int, float64, error) {
+	return c.zrank(ctx, key, member, false)
+}
+
+// ZRevRank returns the 0 based rank of a member by descending score like ZRank, e.g. 0 for the top
+// of a leaderboard
+func (c *Client) ZRevRank(ctx context.Context, key, member string) (int, float64, error) {
+	return c.zrank(ctx, key, member, true)
+}
+
+func (c *Client) zrank(ctx context.Context, key, member string, reverse bool) (int, float64, error) {
+	req := &pb.ZRankRequest{EntryKey: key, Member: member, Reverse: reverse}
+	resp, err := c.client.ZRank(ctx, req)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get rank of member %s of key %s: %w", member, key, err)
+	}
+	return int(resp.Rank), resp.Score, nil
+}
+
+// ZRange retrieves the members of the sorted set under the given key from rank start to stop
+// included by ascending score, negative ranks counting from the last member.
+// A missing key is an empty sorted set.
+func (c *Client) ZRange(ctx context.Context, key string, start, stop int) ([]ZMember, error) {
+	return c.zrange(ctx, key, start, stop, false)
+}
+
+// ZRevRange retrieves the members from rank start to stop included by descending score like ZRange,
+// e.g. ZRevRange(ctx, key, 0, 9) for the top 10 of a leaderboard
+func (c *Client) ZRevRange(ctx context.Context, key string, start, stop int) ([]ZMember, error) {
+	return c.zrange(ctx, key, start, stop, true)
+}
+
+func (c *Client) zrange(ctx context.Context, key string, start, stop int, reverse bool) ([]ZMember, error) {
+	req := &pb.ZRangeRequest{EntryKey: key, Start: int64(start), Stop: int64(stop), Reverse: reverse}
+	resp, err := c.client.ZRange(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read range of key %s: %w", key, err)
+	}
+	return zmembers(resp.Members), nil
+}
+
+// ZRangeByScore retrieves the members of the sorted set under the given key with a score from min
+// to max by ascending score, see WithRangeReverse and WithRangeLimit. A missing key is an empty sorted set.
+func (c *Client) ZRangeByScore(ctx context.Context, key string, min, max ScoreBound, opts ...RangeOption) ([]ZMember, error) {
+	o, err := rangeConfig(key, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &pb.ZRangeByScoreRequest{
+		EntryKey: key,
+		Min:      &pb.ScoreBound{Value: min.Value, Exclusive: min.Exclusive},
+		Max:      &pb.ScoreBound{Value: max.Value, Exclusive: max.Exclusive},
+		Reverse:  o.reverse,
+		Offset:   int64(o.offset),
+		Limit:    int64(o.count),
+	}
+	resp, err := c.client.ZRangeByScore(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read score range of key %s: %w", key, err)
+	}
+	return zmembers(resp.Members), nil
+}
+
+// ZRangeByLex retrieves the members of the sorted set under the given key from min to max like
+// ZRangeByScore, comparing members byte by byte. The result is only meaningful when every member
+// has the same score, e.g. for autocompletion.
+func (c *Client) ZRangeByLex(ctx context.Context, key string, min, max LexBound, opts ...RangeOption) ([]ZMember, error) {
+	o, err := rangeConfig(key, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &pb.ZRangeByLexRequest{
+		EntryKey: key,
+		Min:      &pb.LexBound{Value: min.Value, Exclusive: min.Exclusive, Unbounded: min.Unbounded},
+		Max:      &pb.LexBound{Value: max.Value, Exclusive: max.Exclusive, Unbounded: max.Unbounded},
+		Reverse:  o.reverse,
+		Offset:   int64(o.offset),
+		Limit:    int64(o.count),
+	}
+	resp, err := c.client.ZRangeByLex(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read lexicographical range of key %s: %w", key, err)
+	}
+	return zmembers(resp.Members), nil
+}
+
+// ZRemRangeByScore removes the members of the sorted set under the given key with a score from min
+// to max and returns how many were removed, deleting the key along with its last member
+func (c *Client) ZRemRangeByScore(ctx context.Context, key string, min, max ScoreBound) (int, error) {
+	req := &pb.ZRemRangeByScoreRequest{
+		EntryKey: key,
+		Min:      &pb.ScoreBound{Value: min.Value, Exclusive: min.Exclusive},
+		Max:      &pb.ScoreBound{Value: max.Value, Exclusive: max.Exclusive},
+	}
+	resp, err := c.client.ZRemRangeByScore(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to remove score range of key %s: %w", key, err)
+	}
+	return int(resp.Removed), nil
+}
+
+// rangeConfig applies the options of a range, rejecting negative offsets and counts
+func rangeConfig(key string, opts []RangeOption) (rangeOptions, error) {
+	var o rangeOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.offset < 0 || o.count < 0 {
+		return o, fmt.Errorf("%w: limit %d %d for key %s", ErrInvalidArgument, o.offset, o.count, key)
+	}
+	return o, nil
+}
+
+// zmembers converts the members of a sorted set from their messages
+func zmembers(members []*pb.ZMember) []ZMember {
+	result := make([]ZMember, len(members))
+	for i, m := range members {
+		result[i] = ZMember{Member: m.GetMember(), Score: m.GetScore()}
+	}
+	return result
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -120,7 +121,7 @@ func main() {
 	// Test 11: Get deleted key (should fail)
 	log.Println("Test 11: Get deleted key (should fail)...")
 	_, err = memClient.Get(ctx, testKey)
-	if err != nil {
+	if errors.Is(err, client.ErrNotFound) {
 		log.Printf("✓ Expected error: %v\n\n", err)
 	} else {
 		log.Println("❌ Deleted key should not exist")
@@ -142,7 +143,7 @@ func main() {
 	// Test 13: Get non-existent key
 	log.Println("Test 13: Get non-existent key...")
 	_, err = memClient.Get(ctx, "another-non-existent-key")
-	if err != nil {
+	if errors.Is(err, client.ErrNotFound) {
 		log.Printf("✓ Expected error: %v\n\n", err)
 	} else {
 		log.Println("❌ Non-existent key should return error")
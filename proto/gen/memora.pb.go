@@ -21,12 +21,122 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// TtlMode tells how the ttl of a request is interpreted, a ttl of 0 never expires in every mode
+type TtlMode int32
+
+const (
+	// ttl is an absolute unix timestamp in seconds
+	TtlMode_ABSOLUTE_SECONDS TtlMode = 0
+	// ttl is a duration in milliseconds from now
+	TtlMode_RELATIVE_MILLISECONDS TtlMode = 1
+)
+
+// Enum value maps for TtlMode.
+var (
+	TtlMode_name = map[int32]string{
+		0: "ABSOLUTE_SECONDS",
+		1: "RELATIVE_MILLISECONDS",
+	}
+	TtlMode_value = map[string]int32{
+		"ABSOLUTE_SECONDS":      0,
+		"RELATIVE_MILLISECONDS": 1,
+	}
+)
+
+func (x TtlMode) Enum() *TtlMode {
+	p := new(TtlMode)
+	*p = x
+	return p
+}
+
+func (x TtlMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TtlMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_memora_proto_enumTypes[0].Descriptor()
+}
+
+func (TtlMode) Type() protoreflect.EnumType {
+	return &file_memora_proto_enumTypes[0]
+}
+
+func (x TtlMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TtlMode.Descriptor instead.
+func (TtlMode) EnumDescriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{0}
+}
+
+// SetCondition tells when a Set stores its value
+type SetCondition int32
+
+const (
+	SetCondition_ALWAYS SetCondition = 0
+	// store only when the key does not exist, fails with ALREADY_EXISTS otherwise
+	SetCondition_IF_ABSENT SetCondition = 1
+	// store only when the key exists, fails with NOT_FOUND otherwise
+	SetCondition_IF_PRESENT SetCondition = 2
+	// store only when the version of the entry matches, 0 matching a missing key, fails with ABORTED otherwise
+	SetCondition_IF_VERSION SetCondition = 3
+)
+
+// Enum value maps for SetCondition.
+var (
+	SetCondition_name = map[int32]string{
+		0: "ALWAYS",
+		1: "IF_ABSENT",
+		2: "IF_PRESENT",
+		3: "IF_VERSION",
+	}
+	SetCondition_value = map[string]int32{
+		"ALWAYS":     0,
+		"IF_ABSENT":  1,
+		"IF_PRESENT": 2,
+		"IF_VERSION": 3,
+	}
+)
+
+func (x SetCondition) Enum() *SetCondition {
+	p := new(SetCondition)
+	*p = x
+	return p
+}
+
+func (x SetCondition) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SetCondition) Descriptor() protoreflect.EnumDescriptor {
+	return file_memora_proto_enumTypes[1].Descriptor()
+}
+
+func (SetCondition) Type() protoreflect.EnumType {
+	return &file_memora_proto_enumTypes[1]
+}
+
+func (x SetCondition) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SetCondition.Descriptor instead.
+func (SetCondition) EnumDescriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{1}
+}
+
 type SetRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ClientKey     string                 `protobuf:"bytes,1,opt,name=clientKey,proto3" json:"clientKey,omitempty"`
-	EntryKey      string                 `protobuf:"bytes,2,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
-	Value         []byte                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
-	Ttl           int64                  `protobuf:"varint,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in memora.proto.
+	ClientKey string       `protobuf:"bytes,1,opt,name=clientKey,proto3" json:"clientKey,omitempty"`
+	EntryKey  string       `protobuf:"bytes,2,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Value     []byte       `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Ttl       int64        `protobuf:"varint,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	TtlMode   TtlMode      `protobuf:"varint,5,opt,name=ttlMode,proto3,enum=memora.TtlMode" json:"ttlMode,omitempty"`
+	Condition SetCondition `protobuf:"varint,6,opt,name=condition,proto3,enum=memora.SetCondition" json:"condition,omitempty"`
+	// version is the version the entry must have with the IF_VERSION condition
+	Version       uint64 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -61,6 +171,7 @@ func (*SetRequest) Descriptor() ([]byte, []int) {
 	return file_memora_proto_rawDescGZIP(), []int{0}
 }
 
+// Deprecated: Marked as deprecated in memora.proto.
 func (x *SetRequest) GetClientKey() string {
 	if x != nil {
 		return x.ClientKey
@@ -89,10 +200,33 @@ func (x *SetRequest) GetTtl() int64 {
 	return 0
 }
 
+func (x *SetRequest) GetTtlMode() TtlMode {
+	if x != nil {
+		return x.TtlMode
+	}
+	return TtlMode_ABSOLUTE_SECONDS
+}
+
+func (x *SetRequest) GetCondition() SetCondition {
+	if x != nil {
+		return x.Condition
+	}
+	return SetCondition_ALWAYS
+}
+
+func (x *SetRequest) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type SetResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Status  string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// version is the version of the stored entry, it changes on every write of the entry
+	Version       uint64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -141,10 +275,18 @@ func (x *SetResponse) GetStatus() string {
 	return ""
 }
 
+func (x *SetResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type GetRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ClientKey     string                 `protobuf:"bytes,1,opt,name=clientKey,proto3" json:"clientKey,omitempty"`
-	EntryKey      string                 `protobuf:"bytes,2,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in memora.proto.
+	ClientKey     string `protobuf:"bytes,1,opt,name=clientKey,proto3" json:"clientKey,omitempty"`
+	EntryKey      string `protobuf:"bytes,2,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -179,6 +321,7 @@ func (*GetRequest) Descriptor() ([]byte, []int) {
 	return file_memora_proto_rawDescGZIP(), []int{2}
 }
 
+// Deprecated: Marked as deprecated in memora.proto.
 func (x *GetRequest) GetClientKey() string {
 	if x != nil {
 		return x.ClientKey
@@ -194,9 +337,11 @@ func (x *GetRequest) GetEntryKey() string {
 }
 
 type GetResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Value         []byte                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Status string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Value  []byte                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// version is the version of the entry, to be passed to a Set with the IF_VERSION condition
+	Version       uint64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -245,28 +390,37 @@ func (x *GetResponse) GetValue() []byte {
 	return nil
 }
 
-type DeleteRequest struct {
+func (x *GetResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type GetSetRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ClientKey     string                 `protobuf:"bytes,1,opt,name=clientKey,proto3" json:"clientKey,omitempty"`
-	EntryKey      string                 `protobuf:"bytes,2,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Value         []byte                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Ttl           int64                  `protobuf:"varint,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	TtlMode       TtlMode                `protobuf:"varint,4,opt,name=ttlMode,proto3,enum=memora.TtlMode" json:"ttlMode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteRequest) Reset() {
-	*x = DeleteRequest{}
+func (x *GetSetRequest) Reset() {
+	*x = GetSetRequest{}
 	mi := &file_memora_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRequest) String() string {
+func (x *GetSetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRequest) ProtoMessage() {}
+func (*GetSetRequest) ProtoMessage() {}
 
-func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
+func (x *GetSetRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_memora_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -278,47 +432,64 @@ func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
-func (*DeleteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSetRequest.ProtoReflect.Descriptor instead.
+func (*GetSetRequest) Descriptor() ([]byte, []int) {
 	return file_memora_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *DeleteRequest) GetClientKey() string {
+func (x *GetSetRequest) GetEntryKey() string {
 	if x != nil {
-		return x.ClientKey
+		return x.EntryKey
 	}
 	return ""
 }
 
-func (x *DeleteRequest) GetEntryKey() string {
+func (x *GetSetRequest) GetValue() []byte {
 	if x != nil {
-		return x.EntryKey
+		return x.Value
 	}
-	return ""
+	return nil
 }
 
-type DeleteResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Found         bool                   `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+func (x *GetSetRequest) GetTtl() int64 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+func (x *GetSetRequest) GetTtlMode() TtlMode {
+	if x != nil {
+		return x.TtlMode
+	}
+	return TtlMode_ABSOLUTE_SECONDS
+}
+
+type GetSetResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// found is true when the key existed, oldValue then holds the value it replaced
+	Found    bool   `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
+	OldValue []byte `protobuf:"bytes,2,opt,name=oldValue,proto3" json:"oldValue,omitempty"`
+	// version is the version of the stored entry
+	Version       uint64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteResponse) Reset() {
-	*x = DeleteResponse{}
+func (x *GetSetResponse) Reset() {
+	*x = GetSetResponse{}
 	mi := &file_memora_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteResponse) String() string {
+func (x *GetSetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteResponse) ProtoMessage() {}
+func (*GetSetResponse) ProtoMessage() {}
 
-func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
+func (x *GetSetResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_memora_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -330,46 +501,53 @@ func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteResponse.ProtoReflect.Descriptor instead.
-func (*DeleteResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSetResponse.ProtoReflect.Descriptor instead.
+func (*GetSetResponse) Descriptor() ([]byte, []int) {
 	return file_memora_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *DeleteResponse) GetFound() bool {
+func (x *GetSetResponse) GetFound() bool {
 	if x != nil {
 		return x.Found
 	}
 	return false
 }
 
-func (x *DeleteResponse) GetStatus() string {
+func (x *GetSetResponse) GetOldValue() []byte {
 	if x != nil {
-		return x.Status
+		return x.OldValue
 	}
-	return ""
+	return nil
 }
 
-type ConnectionRequest struct {
+func (x *GetSetResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type GetDelRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ClientIP      string                 `protobuf:"bytes,1,opt,name=clientIP,proto3" json:"clientIP,omitempty"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConnectionRequest) Reset() {
-	*x = ConnectionRequest{}
+func (x *GetDelRequest) Reset() {
+	*x = GetDelRequest{}
 	mi := &file_memora_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConnectionRequest) String() string {
+func (x *GetDelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectionRequest) ProtoMessage() {}
+func (*GetDelRequest) ProtoMessage() {}
 
-func (x *ConnectionRequest) ProtoReflect() protoreflect.Message {
+func (x *GetDelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_memora_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -381,40 +559,41 @@ func (x *ConnectionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectionRequest.ProtoReflect.Descriptor instead.
-func (*ConnectionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDelRequest.ProtoReflect.Descriptor instead.
+func (*GetDelRequest) Descriptor() ([]byte, []int) {
 	return file_memora_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ConnectionRequest) GetClientIP() string {
+func (x *GetDelRequest) GetEntryKey() string {
 	if x != nil {
-		return x.ClientIP
+		return x.EntryKey
 	}
 	return ""
 }
 
-type ConnectionResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	ClientKey     string                 `protobuf:"bytes,2,opt,name=clientKey,proto3" json:"clientKey,omitempty"`
+type GetDelResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Value []byte                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// version is the version the deleted entry had
+	Version       uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConnectionResponse) Reset() {
-	*x = ConnectionResponse{}
+func (x *GetDelResponse) Reset() {
+	*x = GetDelResponse{}
 	mi := &file_memora_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConnectionResponse) String() string {
+func (x *GetDelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectionResponse) ProtoMessage() {}
+func (*GetDelResponse) ProtoMessage() {}
 
-func (x *ConnectionResponse) ProtoReflect() protoreflect.Message {
+func (x *GetDelResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_memora_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -426,62 +605,8098 @@ func (x *ConnectionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectionResponse.ProtoReflect.Descriptor instead.
-func (*ConnectionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDelResponse.ProtoReflect.Descriptor instead.
+func (*GetDelResponse) Descriptor() ([]byte, []int) {
 	return file_memora_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ConnectionResponse) GetSuccess() bool {
+func (x *GetDelResponse) GetValue() []byte {
 	if x != nil {
-		return x.Success
+		return x.Value
 	}
-	return false
+	return nil
 }
 
-func (x *ConnectionResponse) GetClientKey() string {
+func (x *GetDelResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type DeleteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in memora.proto.
+	ClientKey     string `protobuf:"bytes,1,opt,name=clientKey,proto3" json:"clientKey,omitempty"`
+	EntryKey      string `protobuf:"bytes,2,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRequest) Reset() {
+	*x = DeleteRequest{}
+	mi := &file_memora_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRequest) ProtoMessage() {}
+
+func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{8}
+}
+
+// Deprecated: Marked as deprecated in memora.proto.
+func (x *DeleteRequest) GetClientKey() string {
 	if x != nil {
 		return x.ClientKey
 	}
 	return ""
 }
 
-var File_memora_proto protoreflect.FileDescriptor
+func (x *DeleteRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
 
-const file_memora_proto_rawDesc = "" +
-	"\n" +
-	"\fmemora.proto\x12\x06memora\"n\n" +
-	"\n" +
-	"SetRequest\x12\x1c\n" +
-	"\tclientKey\x18\x01 \x01(\tR\tclientKey\x12\x1a\n" +
-	"\bentryKey\x18\x02 \x01(\tR\bentryKey\x12\x14\n" +
-	"\x05value\x18\x03 \x01(\fR\x05value\x12\x10\n" +
-	"\x03ttl\x18\x04 \x01(\x03R\x03ttl\"?\n" +
-	"\vSetResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\"F\n" +
-	"\n" +
-	"GetRequest\x12\x1c\n" +
-	"\tclientKey\x18\x01 \x01(\tR\tclientKey\x12\x1a\n" +
-	"\bentryKey\x18\x02 \x01(\tR\bentryKey\";\n" +
-	"\vGetResponse\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\fR\x05value\"I\n" +
-	"\rDeleteRequest\x12\x1c\n" +
-	"\tclientKey\x18\x01 \x01(\tR\tclientKey\x12\x1a\n" +
-	"\bentryKey\x18\x02 \x01(\tR\bentryKey\">\n" +
-	"\x0eDeleteResponse\x12\x14\n" +
-	"\x05found\x18\x01 \x01(\bR\x05found\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\"/\n" +
-	"\x11ConnectionRequest\x12\x1a\n" +
-	"\bclientIP\x18\x01 \x01(\tR\bclientIP\"L\n" +
-	"\x12ConnectionResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1c\n" +
-	"\tclientKey\x18\x02 \x01(\tR\tclientKey2\xea\x01\n" +
-	"\rMemoraService\x12.\n" +
-	"\x03Set\x12\x12.memora.SetRequest\x1a\x13.memora.SetResponse\x12.\n" +
-	"\x03Get\x12\x12.memora.GetRequest\x1a\x13.memora.GetResponse\x127\n" +
-	"\x06Delete\x12\x15.memora.DeleteRequest\x1a\x16.memora.DeleteResponse\x12@\n" +
-	"\aConnect\x12\x19.memora.ConnectionRequest\x1a\x1a.memora.ConnectionResponseB.Z,github.com/Lucascluz/memora/proto/gen;memorab\x06proto3"
+type DeleteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Found         bool                   `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteResponse) Reset() {
+	*x = DeleteResponse{}
+	mi := &file_memora_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResponse) ProtoMessage() {}
+
+func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteResponse) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *DeleteResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// IncrByRequest adds delta to the integer stored as decimal text under entryKey, fails with
+// INVALID_ARGUMENT when the value is not an integer or the result overflows
+type IncrByRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Delta    int64                  `protobuf:"zigzag64,2,opt,name=delta,proto3" json:"delta,omitempty"`
+	// ttl is the expiration of a key the increment creates, and of an existing one with replaceTtl
+	Ttl     int64   `protobuf:"varint,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	TtlMode TtlMode `protobuf:"varint,4,opt,name=ttlMode,proto3,enum=memora.TtlMode" json:"ttlMode,omitempty"`
+	// replaceTtl applies ttl to an existing key too, which keeps its expiration otherwise
+	ReplaceTtl    bool `protobuf:"varint,5,opt,name=replaceTtl,proto3" json:"replaceTtl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncrByRequest) Reset() {
+	*x = IncrByRequest{}
+	mi := &file_memora_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncrByRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrByRequest) ProtoMessage() {}
+
+func (x *IncrByRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrByRequest.ProtoReflect.Descriptor instead.
+func (*IncrByRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *IncrByRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *IncrByRequest) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *IncrByRequest) GetTtl() int64 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+func (x *IncrByRequest) GetTtlMode() TtlMode {
+	if x != nil {
+		return x.TtlMode
+	}
+	return TtlMode_ABSOLUTE_SECONDS
+}
+
+func (x *IncrByRequest) GetReplaceTtl() bool {
+	if x != nil {
+		return x.ReplaceTtl
+	}
+	return false
+}
+
+type IncrByResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// value is the value after the increment
+	Value         int64  `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Version       uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncrByResponse) Reset() {
+	*x = IncrByResponse{}
+	mi := &file_memora_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncrByResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrByResponse) ProtoMessage() {}
+
+func (x *IncrByResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrByResponse.ProtoReflect.Descriptor instead.
+func (*IncrByResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *IncrByResponse) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *IncrByResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// IncrByFloatRequest adds delta to the number stored as decimal text under entryKey, like IncrByRequest
+type IncrByFloatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Delta         float64                `protobuf:"fixed64,2,opt,name=delta,proto3" json:"delta,omitempty"`
+	Ttl           int64                  `protobuf:"varint,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	TtlMode       TtlMode                `protobuf:"varint,4,opt,name=ttlMode,proto3,enum=memora.TtlMode" json:"ttlMode,omitempty"`
+	ReplaceTtl    bool                   `protobuf:"varint,5,opt,name=replaceTtl,proto3" json:"replaceTtl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncrByFloatRequest) Reset() {
+	*x = IncrByFloatRequest{}
+	mi := &file_memora_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncrByFloatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrByFloatRequest) ProtoMessage() {}
+
+func (x *IncrByFloatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrByFloatRequest.ProtoReflect.Descriptor instead.
+func (*IncrByFloatRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *IncrByFloatRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *IncrByFloatRequest) GetDelta() float64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *IncrByFloatRequest) GetTtl() int64 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+func (x *IncrByFloatRequest) GetTtlMode() TtlMode {
+	if x != nil {
+		return x.TtlMode
+	}
+	return TtlMode_ABSOLUTE_SECONDS
+}
+
+func (x *IncrByFloatRequest) GetReplaceTtl() bool {
+	if x != nil {
+		return x.ReplaceTtl
+	}
+	return false
+}
+
+type IncrByFloatResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	Version       uint64                 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncrByFloatResponse) Reset() {
+	*x = IncrByFloatResponse{}
+	mi := &file_memora_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncrByFloatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrByFloatResponse) ProtoMessage() {}
+
+func (x *IncrByFloatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrByFloatResponse.ProtoReflect.Descriptor instead.
+func (*IncrByFloatResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *IncrByFloatResponse) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *IncrByFloatResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// HSetRequest sets fields of the hash stored under entryKey, creating it without expiration when
+// the key is missing
+type HSetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Fields        map[string][]byte      `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HSetRequest) Reset() {
+	*x = HSetRequest{}
+	mi := &file_memora_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HSetRequest) ProtoMessage() {}
+
+func (x *HSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HSetRequest.ProtoReflect.Descriptor instead.
+func (*HSetRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *HSetRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *HSetRequest) GetFields() map[string][]byte {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type HSetResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// added is how many of the fields did not exist before
+	Added         int64  `protobuf:"varint,1,opt,name=added,proto3" json:"added,omitempty"`
+	Version       uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HSetResponse) Reset() {
+	*x = HSetResponse{}
+	mi := &file_memora_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HSetResponse) ProtoMessage() {}
+
+func (x *HSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HSetResponse.ProtoReflect.Descriptor instead.
+func (*HSetResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *HSetResponse) GetAdded() int64 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+func (x *HSetResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// HGetRequest reads a field of a hash, fails with NOT_FOUND and reason FIELD_NOT_FOUND when the
+// hash exists without the field
+type HGetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HGetRequest) Reset() {
+	*x = HGetRequest{}
+	mi := &file_memora_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HGetRequest) ProtoMessage() {}
+
+func (x *HGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HGetRequest.ProtoReflect.Descriptor instead.
+func (*HGetRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *HGetRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *HGetRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+type HGetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         []byte                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Version       uint64                 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HGetResponse) Reset() {
+	*x = HGetResponse{}
+	mi := &file_memora_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HGetResponse) ProtoMessage() {}
+
+func (x *HGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HGetResponse.ProtoReflect.Descriptor instead.
+func (*HGetResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *HGetResponse) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *HGetResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type HGetAllRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HGetAllRequest) Reset() {
+	*x = HGetAllRequest{}
+	mi := &file_memora_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HGetAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HGetAllRequest) ProtoMessage() {}
+
+func (x *HGetAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HGetAllRequest.ProtoReflect.Descriptor instead.
+func (*HGetAllRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *HGetAllRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+type HGetAllResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fields        map[string][]byte      `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Version       uint64                 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HGetAllResponse) Reset() {
+	*x = HGetAllResponse{}
+	mi := &file_memora_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HGetAllResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HGetAllResponse) ProtoMessage() {}
+
+func (x *HGetAllResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HGetAllResponse.ProtoReflect.Descriptor instead.
+func (*HGetAllResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *HGetAllResponse) GetFields() map[string][]byte {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *HGetAllResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// HDelRequest removes fields of a hash, the key is deleted once its last field is
+type HDelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Fields        []string               `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HDelRequest) Reset() {
+	*x = HDelRequest{}
+	mi := &file_memora_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HDelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HDelRequest) ProtoMessage() {}
+
+func (x *HDelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HDelRequest.ProtoReflect.Descriptor instead.
+func (*HDelRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *HDelRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *HDelRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type HDelResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// deleted is how many of the fields existed
+	Deleted       int64 `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HDelResponse) Reset() {
+	*x = HDelResponse{}
+	mi := &file_memora_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HDelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HDelResponse) ProtoMessage() {}
+
+func (x *HDelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HDelResponse.ProtoReflect.Descriptor instead.
+func (*HDelResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *HDelResponse) GetDeleted() int64 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+// HIncrByRequest adds delta to the integer stored as decimal text in a field of a hash, creating
+// the key and the field at 0 when they are missing
+type HIncrByRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Delta         int64                  `protobuf:"zigzag64,3,opt,name=delta,proto3" json:"delta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HIncrByRequest) Reset() {
+	*x = HIncrByRequest{}
+	mi := &file_memora_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HIncrByRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HIncrByRequest) ProtoMessage() {}
+
+func (x *HIncrByRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HIncrByRequest.ProtoReflect.Descriptor instead.
+func (*HIncrByRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *HIncrByRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *HIncrByRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *HIncrByRequest) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+type HIncrByResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         int64                  `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Version       uint64                 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HIncrByResponse) Reset() {
+	*x = HIncrByResponse{}
+	mi := &file_memora_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HIncrByResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HIncrByResponse) ProtoMessage() {}
+
+func (x *HIncrByResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HIncrByResponse.ProtoReflect.Descriptor instead.
+func (*HIncrByResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *HIncrByResponse) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *HIncrByResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// PushRequest pushes values to the head (LPush) or the tail (RPush) of the list stored under
+// entryKey one after the other, creating it without expiration when the key is missing
+type PushRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Values        [][]byte               `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushRequest) Reset() {
+	*x = PushRequest{}
+	mi := &file_memora_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushRequest) ProtoMessage() {}
+
+func (x *PushRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushRequest.ProtoReflect.Descriptor instead.
+func (*PushRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PushRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *PushRequest) GetValues() [][]byte {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type PushResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// length is the length of the list after the push
+	Length        int64 `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushResponse) Reset() {
+	*x = PushResponse{}
+	mi := &file_memora_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushResponse) ProtoMessage() {}
+
+func (x *PushResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushResponse.ProtoReflect.Descriptor instead.
+func (*PushResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PushResponse) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+// PopRequest removes values from the head (LPop) or the tail (RPop) of a list, fails with
+// NOT_FOUND when the key is missing. The key is deleted once its last value is removed.
+type PopRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	// count is how many values to remove at most, 0 removes one
+	Count         int64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PopRequest) Reset() {
+	*x = PopRequest{}
+	mi := &file_memora_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PopRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PopRequest) ProtoMessage() {}
+
+func (x *PopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PopRequest.ProtoReflect.Descriptor instead.
+func (*PopRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PopRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *PopRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type PopResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// values are in the order they were removed
+	Values        [][]byte `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PopResponse) Reset() {
+	*x = PopResponse{}
+	mi := &file_memora_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PopResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PopResponse) ProtoMessage() {}
+
+func (x *PopResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PopResponse.ProtoReflect.Descriptor instead.
+func (*PopResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PopResponse) GetValues() [][]byte {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// BlockingPopRequest pops a value from the first list of entryKeys that is not empty, from the
+// head (BLPop) or the tail (BRPop). When they all are it waits for a push, fails with NOT_FOUND
+// and reason TIMEOUT when none arrives in time and with UNAVAILABLE when the server shuts down.
+// Requests blocked on the same key are served in the order they blocked.
+type BlockingPopRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	EntryKeys []string               `protobuf:"bytes,1,rep,name=entryKeys,proto3" json:"entryKeys,omitempty"`
+	// timeout is how long to wait in milliseconds, 0 waits until the deadline of the call
+	Timeout       int64 `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockingPopRequest) Reset() {
+	*x = BlockingPopRequest{}
+	mi := &file_memora_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockingPopRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockingPopRequest) ProtoMessage() {}
+
+func (x *BlockingPopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockingPopRequest.ProtoReflect.Descriptor instead.
+func (*BlockingPopRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BlockingPopRequest) GetEntryKeys() []string {
+	if x != nil {
+		return x.EntryKeys
+	}
+	return nil
+}
+
+func (x *BlockingPopRequest) GetTimeout() int64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+type BlockingPopResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// entryKey is the key the value was popped from
+	EntryKey      string `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Value         []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockingPopResponse) Reset() {
+	*x = BlockingPopResponse{}
+	mi := &file_memora_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockingPopResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockingPopResponse) ProtoMessage() {}
+
+func (x *BlockingPopResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockingPopResponse.ProtoReflect.Descriptor instead.
+func (*BlockingPopResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BlockingPopResponse) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *BlockingPopResponse) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// LRangeRequest reads the values of a list from index start to stop included, negative indexes
+// counting from the tail, e.g. 0 and -1 for the whole list. A missing key is an empty list.
+type LRangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Start         int64                  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Stop          int64                  `protobuf:"varint,3,opt,name=stop,proto3" json:"stop,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LRangeRequest) Reset() {
+	*x = LRangeRequest{}
+	mi := &file_memora_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LRangeRequest) ProtoMessage() {}
+
+func (x *LRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LRangeRequest.ProtoReflect.Descriptor instead.
+func (*LRangeRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *LRangeRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *LRangeRequest) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *LRangeRequest) GetStop() int64 {
+	if x != nil {
+		return x.Stop
+	}
+	return 0
+}
+
+type LRangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        [][]byte               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LRangeResponse) Reset() {
+	*x = LRangeResponse{}
+	mi := &file_memora_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LRangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LRangeResponse) ProtoMessage() {}
+
+func (x *LRangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LRangeResponse.ProtoReflect.Descriptor instead.
+func (*LRangeResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *LRangeResponse) GetValues() [][]byte {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// LTrimRequest keeps the values of a list from index start to stop included like LRangeRequest,
+// deleting the key when the range is empty
+type LTrimRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Start         int64                  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Stop          int64                  `protobuf:"varint,3,opt,name=stop,proto3" json:"stop,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LTrimRequest) Reset() {
+	*x = LTrimRequest{}
+	mi := &file_memora_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LTrimRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LTrimRequest) ProtoMessage() {}
+
+func (x *LTrimRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LTrimRequest.ProtoReflect.Descriptor instead.
+func (*LTrimRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *LTrimRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *LTrimRequest) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *LTrimRequest) GetStop() int64 {
+	if x != nil {
+		return x.Stop
+	}
+	return 0
+}
+
+type LTrimResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LTrimResponse) Reset() {
+	*x = LTrimResponse{}
+	mi := &file_memora_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LTrimResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LTrimResponse) ProtoMessage() {}
+
+func (x *LTrimResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LTrimResponse.ProtoReflect.Descriptor instead.
+func (*LTrimResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{33}
+}
+
+type LLenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LLenRequest) Reset() {
+	*x = LLenRequest{}
+	mi := &file_memora_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LLenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LLenRequest) ProtoMessage() {}
+
+func (x *LLenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LLenRequest.ProtoReflect.Descriptor instead.
+func (*LLenRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *LLenRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+type LLenResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// length is 0 when the key is missing
+	Length        int64 `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LLenResponse) Reset() {
+	*x = LLenResponse{}
+	mi := &file_memora_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LLenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LLenResponse) ProtoMessage() {}
+
+func (x *LLenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LLenResponse.ProtoReflect.Descriptor instead.
+func (*LLenResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *LLenResponse) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+// SAddRequest adds members to the set stored under entryKey, creating it without expiration when
+// the key is missing
+type SAddRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Members       []string               `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SAddRequest) Reset() {
+	*x = SAddRequest{}
+	mi := &file_memora_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SAddRequest) ProtoMessage() {}
+
+func (x *SAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SAddRequest.ProtoReflect.Descriptor instead.
+func (*SAddRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SAddRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *SAddRequest) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type SAddResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// added is how many of the members were not in the set before
+	Added         int64 `protobuf:"varint,1,opt,name=added,proto3" json:"added,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SAddResponse) Reset() {
+	*x = SAddResponse{}
+	mi := &file_memora_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SAddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SAddResponse) ProtoMessage() {}
+
+func (x *SAddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SAddResponse.ProtoReflect.Descriptor instead.
+func (*SAddResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SAddResponse) GetAdded() int64 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+// SRemRequest removes members of a set, the key is deleted once its last member is
+type SRemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Members       []string               `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SRemRequest) Reset() {
+	*x = SRemRequest{}
+	mi := &file_memora_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SRemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRemRequest) ProtoMessage() {}
+
+func (x *SRemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRemRequest.ProtoReflect.Descriptor instead.
+func (*SRemRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SRemRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *SRemRequest) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type SRemResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// removed is how many of the members were in the set
+	Removed       int64 `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SRemResponse) Reset() {
+	*x = SRemResponse{}
+	mi := &file_memora_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SRemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRemResponse) ProtoMessage() {}
+
+func (x *SRemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRemResponse.ProtoReflect.Descriptor instead.
+func (*SRemResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SRemResponse) GetRemoved() int64 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+// SIsMemberRequest checks whether member belongs to a set, a missing key is an empty set
+type SIsMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Member        string                 `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SIsMemberRequest) Reset() {
+	*x = SIsMemberRequest{}
+	mi := &file_memora_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SIsMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SIsMemberRequest) ProtoMessage() {}
+
+func (x *SIsMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SIsMemberRequest.ProtoReflect.Descriptor instead.
+func (*SIsMemberRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SIsMemberRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *SIsMemberRequest) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+type SIsMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsMember      bool                   `protobuf:"varint,1,opt,name=isMember,proto3" json:"isMember,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SIsMemberResponse) Reset() {
+	*x = SIsMemberResponse{}
+	mi := &file_memora_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SIsMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SIsMemberResponse) ProtoMessage() {}
+
+func (x *SIsMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SIsMemberResponse.ProtoReflect.Descriptor instead.
+func (*SIsMemberResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SIsMemberResponse) GetIsMember() bool {
+	if x != nil {
+		return x.IsMember
+	}
+	return false
+}
+
+type SCardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SCardRequest) Reset() {
+	*x = SCardRequest{}
+	mi := &file_memora_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SCardRequest) ProtoMessage() {}
+
+func (x *SCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SCardRequest.ProtoReflect.Descriptor instead.
+func (*SCardRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SCardRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+type SCardResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// cardinality is 0 when the key is missing
+	Cardinality   int64 `protobuf:"varint,1,opt,name=cardinality,proto3" json:"cardinality,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SCardResponse) Reset() {
+	*x = SCardResponse{}
+	mi := &file_memora_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SCardResponse) ProtoMessage() {}
+
+func (x *SCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SCardResponse.ProtoReflect.Descriptor instead.
+func (*SCardResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SCardResponse) GetCardinality() int64 {
+	if x != nil {
+		return x.Cardinality
+	}
+	return 0
+}
+
+type SMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SMembersRequest) Reset() {
+	*x = SMembersRequest{}
+	mi := &file_memora_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SMembersRequest) ProtoMessage() {}
+
+func (x *SMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SMembersRequest.ProtoReflect.Descriptor instead.
+func (*SMembersRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SMembersRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+type SMembersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// members are in no particular order
+	Members       []string `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SMembersResponse) Reset() {
+	*x = SMembersResponse{}
+	mi := &file_memora_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SMembersResponse) ProtoMessage() {}
+
+func (x *SMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SMembersResponse.ProtoReflect.Descriptor instead.
+func (*SMembersResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SMembersResponse) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// SRandMemberRequest picks distinct members of a set at random without removing them
+type SRandMemberRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	// count is how many members to pick at most, 0 picks one
+	Count         int64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SRandMemberRequest) Reset() {
+	*x = SRandMemberRequest{}
+	mi := &file_memora_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SRandMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRandMemberRequest) ProtoMessage() {}
+
+func (x *SRandMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRandMemberRequest.ProtoReflect.Descriptor instead.
+func (*SRandMemberRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SRandMemberRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *SRandMemberRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SRandMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []string               `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SRandMemberResponse) Reset() {
+	*x = SRandMemberResponse{}
+	mi := &file_memora_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SRandMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRandMemberResponse) ProtoMessage() {}
+
+func (x *SRandMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRandMemberResponse.ProtoReflect.Descriptor instead.
+func (*SRandMemberResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SRandMemberResponse) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// SetAlgebraRequest combines the sets stored under entryKeys: SInter keeps the members found in
+// every set, SUnion the members found in any and SDiff the members of the first set found in none
+// of the others. Missing keys are empty sets.
+type SetAlgebraRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKeys     []string               `protobuf:"bytes,1,rep,name=entryKeys,proto3" json:"entryKeys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAlgebraRequest) Reset() {
+	*x = SetAlgebraRequest{}
+	mi := &file_memora_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAlgebraRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAlgebraRequest) ProtoMessage() {}
+
+func (x *SetAlgebraRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAlgebraRequest.ProtoReflect.Descriptor instead.
+func (*SetAlgebraRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SetAlgebraRequest) GetEntryKeys() []string {
+	if x != nil {
+		return x.EntryKeys
+	}
+	return nil
+}
+
+type SetAlgebraResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// members are in no particular order
+	Members       []string `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAlgebraResponse) Reset() {
+	*x = SetAlgebraResponse{}
+	mi := &file_memora_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAlgebraResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAlgebraResponse) ProtoMessage() {}
+
+func (x *SetAlgebraResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAlgebraResponse.ProtoReflect.Descriptor instead.
+func (*SetAlgebraResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SetAlgebraResponse) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// SetAlgebraStoreRequest combines sets like SetAlgebraRequest and stores the result under
+// destination in place of any value, without expiration. An empty result deletes destination.
+type SetAlgebraStoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Destination   string                 `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	EntryKeys     []string               `protobuf:"bytes,2,rep,name=entryKeys,proto3" json:"entryKeys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAlgebraStoreRequest) Reset() {
+	*x = SetAlgebraStoreRequest{}
+	mi := &file_memora_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAlgebraStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAlgebraStoreRequest) ProtoMessage() {}
+
+func (x *SetAlgebraStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAlgebraStoreRequest.ProtoReflect.Descriptor instead.
+func (*SetAlgebraStoreRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SetAlgebraStoreRequest) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *SetAlgebraStoreRequest) GetEntryKeys() []string {
+	if x != nil {
+		return x.EntryKeys
+	}
+	return nil
+}
+
+type SetAlgebraStoreResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// cardinality is the number of members stored under destination
+	Cardinality   int64 `protobuf:"varint,1,opt,name=cardinality,proto3" json:"cardinality,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAlgebraStoreResponse) Reset() {
+	*x = SetAlgebraStoreResponse{}
+	mi := &file_memora_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAlgebraStoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAlgebraStoreResponse) ProtoMessage() {}
+
+func (x *SetAlgebraStoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAlgebraStoreResponse.ProtoReflect.Descriptor instead.
+func (*SetAlgebraStoreResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SetAlgebraStoreResponse) GetCardinality() int64 {
+	if x != nil {
+		return x.Cardinality
+	}
+	return 0
+}
+
+// ZMember is a member of a sorted set along with its score
+type ZMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        string                 `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZMember) Reset() {
+	*x = ZMember{}
+	mi := &file_memora_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZMember) ProtoMessage() {}
+
+func (x *ZMember) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZMember.ProtoReflect.Descriptor instead.
+func (*ZMember) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ZMember) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+func (x *ZMember) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// ZAddRequest sets the scores of members of the sorted set stored under entryKey, creating it
+// without expiration when the key is missing. nx only adds new members and xx only updates existing
+// ones, gt and lt only update members to a greater or lower score. nx cannot be combined with the
+// others, nor gt with lt.
+type ZAddRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Members       []*ZMember             `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	Nx            bool                   `protobuf:"varint,3,opt,name=nx,proto3" json:"nx,omitempty"`
+	Xx            bool                   `protobuf:"varint,4,opt,name=xx,proto3" json:"xx,omitempty"`
+	Gt            bool                   `protobuf:"varint,5,opt,name=gt,proto3" json:"gt,omitempty"`
+	Lt            bool                   `protobuf:"varint,6,opt,name=lt,proto3" json:"lt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZAddRequest) Reset() {
+	*x = ZAddRequest{}
+	mi := &file_memora_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZAddRequest) ProtoMessage() {}
+
+func (x *ZAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZAddRequest.ProtoReflect.Descriptor instead.
+func (*ZAddRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ZAddRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *ZAddRequest) GetMembers() []*ZMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ZAddRequest) GetNx() bool {
+	if x != nil {
+		return x.Nx
+	}
+	return false
+}
+
+func (x *ZAddRequest) GetXx() bool {
+	if x != nil {
+		return x.Xx
+	}
+	return false
+}
+
+func (x *ZAddRequest) GetGt() bool {
+	if x != nil {
+		return x.Gt
+	}
+	return false
+}
+
+func (x *ZAddRequest) GetLt() bool {
+	if x != nil {
+		return x.Lt
+	}
+	return false
+}
+
+type ZAddResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// added is how many of the members were not in the sorted set before
+	Added int64 `protobuf:"varint,1,opt,name=added,proto3" json:"added,omitempty"`
+	// updated is how many of the existing members had their score changed
+	Updated       int64 `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZAddResponse) Reset() {
+	*x = ZAddResponse{}
+	mi := &file_memora_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZAddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZAddResponse) ProtoMessage() {}
+
+func (x *ZAddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZAddResponse.ProtoReflect.Descriptor instead.
+func (*ZAddResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ZAddResponse) GetAdded() int64 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+func (x *ZAddResponse) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+// ZIncrByRequest adds delta to the score of a member of a sorted set, creating the key and the
+// member at 0 when they are missing
+type ZIncrByRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Member        string                 `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	Delta         float64                `protobuf:"fixed64,3,opt,name=delta,proto3" json:"delta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZIncrByRequest) Reset() {
+	*x = ZIncrByRequest{}
+	mi := &file_memora_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZIncrByRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZIncrByRequest) ProtoMessage() {}
+
+func (x *ZIncrByRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZIncrByRequest.ProtoReflect.Descriptor instead.
+func (*ZIncrByRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ZIncrByRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *ZIncrByRequest) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+func (x *ZIncrByRequest) GetDelta() float64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+type ZIncrByResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Score         float64                `protobuf:"fixed64,1,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZIncrByResponse) Reset() {
+	*x = ZIncrByResponse{}
+	mi := &file_memora_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZIncrByResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZIncrByResponse) ProtoMessage() {}
+
+func (x *ZIncrByResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZIncrByResponse.ProtoReflect.Descriptor instead.
+func (*ZIncrByResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ZIncrByResponse) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// ZRemRequest removes members of a sorted set, the key is deleted once its last member is
+type ZRemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Members       []string               `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZRemRequest) Reset() {
+	*x = ZRemRequest{}
+	mi := &file_memora_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZRemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZRemRequest) ProtoMessage() {}
+
+func (x *ZRemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZRemRequest.ProtoReflect.Descriptor instead.
+func (*ZRemRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ZRemRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *ZRemRequest) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type ZRemResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// removed is how many of the members were in the sorted set
+	Removed       int64 `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZRemResponse) Reset() {
+	*x = ZRemResponse{}
+	mi := &file_memora_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZRemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZRemResponse) ProtoMessage() {}
+
+func (x *ZRemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZRemResponse.ProtoReflect.Descriptor instead.
+func (*ZRemResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ZRemResponse) GetRemoved() int64 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+// ZScoreRequest reads the score of a member of a sorted set, fails with NOT_FOUND and reason
+// MEMBER_NOT_FOUND when the sorted set exists without the member
+type ZScoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Member        string                 `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZScoreRequest) Reset() {
+	*x = ZScoreRequest{}
+	mi := &file_memora_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZScoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZScoreRequest) ProtoMessage() {}
+
+func (x *ZScoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZScoreRequest.ProtoReflect.Descriptor instead.
+func (*ZScoreRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ZScoreRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *ZScoreRequest) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+type ZScoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Score         float64                `protobuf:"fixed64,1,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZScoreResponse) Reset() {
+	*x = ZScoreResponse{}
+	mi := &file_memora_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZScoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZScoreResponse) ProtoMessage() {}
+
+func (x *ZScoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZScoreResponse.ProtoReflect.Descriptor instead.
+func (*ZScoreResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ZScoreResponse) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type ZCardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZCardRequest) Reset() {
+	*x = ZCardRequest{}
+	mi := &file_memora_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZCardRequest) ProtoMessage() {}
+
+func (x *ZCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZCardRequest.ProtoReflect.Descriptor instead.
+func (*ZCardRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ZCardRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+type ZCardResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// cardinality is 0 when the key is missing
+	Cardinality   int64 `protobuf:"varint,1,opt,name=cardinality,proto3" json:"cardinality,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZCardResponse) Reset() {
+	*x = ZCardResponse{}
+	mi := &file_memora_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZCardResponse) ProtoMessage() {}
+
+func (x *ZCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZCardResponse.ProtoReflect.Descriptor instead.
+func (*ZCardResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ZCardResponse) GetCardinality() int64 {
+	if x != nil {
+		return x.Cardinality
+	}
+	return 0
+}
+
+// ZRankRequest reads the 0 based rank of a member of a sorted set by ascending score, or
+// descending score when reverse is set. It fails like ZScoreRequest.
+type ZRankRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Member        string                 `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	Reverse       bool                   `protobuf:"varint,3,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZRankRequest) Reset() {
+	*x = ZRankRequest{}
+	mi := &file_memora_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZRankRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZRankRequest) ProtoMessage() {}
+
+func (x *ZRankRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZRankRequest.ProtoReflect.Descriptor instead.
+func (*ZRankRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ZRankRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *ZRankRequest) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+func (x *ZRankRequest) GetReverse() bool {
+	if x != nil {
+		return x.Reverse
+	}
+	return false
+}
+
+type ZRankResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rank          int64                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZRankResponse) Reset() {
+	*x = ZRankResponse{}
+	mi := &file_memora_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZRankResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZRankResponse) ProtoMessage() {}
+
+func (x *ZRankResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZRankResponse.ProtoReflect.Descriptor instead.
+func (*ZRankResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ZRankResponse) GetRank() int64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *ZRankResponse) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// ZRangeRequest reads the members of a sorted set from rank start to stop included, by ascending
+// score or descending score when reverse is set. Negative ranks count from the last member.
+// A missing key is an empty sorted set.
+type ZRangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Start         int64                  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Stop          int64                  `protobuf:"varint,3,opt,name=stop,proto3" json:"stop,omitempty"`
+	Reverse       bool                   `protobuf:"varint,4,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZRangeRequest) Reset() {
+	*x = ZRangeRequest{}
+	mi := &file_memora_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZRangeRequest) ProtoMessage() {}
+
+func (x *ZRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZRangeRequest.ProtoReflect.Descriptor instead.
+func (*ZRangeRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ZRangeRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *ZRangeRequest) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *ZRangeRequest) GetStop() int64 {
+	if x != nil {
+		return x.Stop
+	}
+	return 0
+}
+
+func (x *ZRangeRequest) GetReverse() bool {
+	if x != nil {
+		return x.Reverse
+	}
+	return false
+}
+
+type ZRangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*ZMember             `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZRangeResponse) Reset() {
+	*x = ZRangeResponse{}
+	mi := &file_memora_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZRangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZRangeResponse) ProtoMessage() {}
+
+func (x *ZRangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZRangeResponse.ProtoReflect.Descriptor instead.
+func (*ZRangeResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ZRangeResponse) GetMembers() []*ZMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// ScoreBound is the minimum or maximum score of a range, infinite values leaving it unbounded
+type ScoreBound struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	Exclusive     bool                   `protobuf:"varint,2,opt,name=exclusive,proto3" json:"exclusive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreBound) Reset() {
+	*x = ScoreBound{}
+	mi := &file_memora_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreBound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreBound) ProtoMessage() {}
+
+func (x *ScoreBound) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreBound.ProtoReflect.Descriptor instead.
+func (*ScoreBound) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ScoreBound) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *ScoreBound) GetExclusive() bool {
+	if x != nil {
+		return x.Exclusive
+	}
+	return false
+}
+
+// ZRangeByScoreRequest reads the members of a sorted set with a score from min to max, by
+// ascending score or descending score when reverse is set. A missing min or max leaves the range
+// unbounded on its side. offset members of the range are skipped and up to limit of them
+// returned, all of them when limit is 0.
+type ZRangeByScoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Min           *ScoreBound            `protobuf:"bytes,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max           *ScoreBound            `protobuf:"bytes,3,opt,name=max,proto3" json:"max,omitempty"`
+	Reverse       bool                   `protobuf:"varint,4,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	Offset        int64                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int64                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZRangeByScoreRequest) Reset() {
+	*x = ZRangeByScoreRequest{}
+	mi := &file_memora_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZRangeByScoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZRangeByScoreRequest) ProtoMessage() {}
+
+func (x *ZRangeByScoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZRangeByScoreRequest.ProtoReflect.Descriptor instead.
+func (*ZRangeByScoreRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ZRangeByScoreRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *ZRangeByScoreRequest) GetMin() *ScoreBound {
+	if x != nil {
+		return x.Min
+	}
+	return nil
+}
+
+func (x *ZRangeByScoreRequest) GetMax() *ScoreBound {
+	if x != nil {
+		return x.Max
+	}
+	return nil
+}
+
+func (x *ZRangeByScoreRequest) GetReverse() bool {
+	if x != nil {
+		return x.Reverse
+	}
+	return false
+}
+
+func (x *ZRangeByScoreRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ZRangeByScoreRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// LexBound is the minimum or maximum member of a range, compared byte by byte
+type LexBound struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Value     string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Exclusive bool                   `protobuf:"varint,2,opt,name=exclusive,proto3" json:"exclusive,omitempty"`
+	// unbounded leaves the range open on the side of the bound, value is ignored
+	Unbounded     bool `protobuf:"varint,3,opt,name=unbounded,proto3" json:"unbounded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LexBound) Reset() {
+	*x = LexBound{}
+	mi := &file_memora_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LexBound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LexBound) ProtoMessage() {}
+
+func (x *LexBound) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LexBound.ProtoReflect.Descriptor instead.
+func (*LexBound) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *LexBound) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *LexBound) GetExclusive() bool {
+	if x != nil {
+		return x.Exclusive
+	}
+	return false
+}
+
+func (x *LexBound) GetUnbounded() bool {
+	if x != nil {
+		return x.Unbounded
+	}
+	return false
+}
+
+// ZRangeByLexRequest reads the members of a sorted set from min to max like ZRangeByScoreRequest,
+// comparing members. The result is only meaningful when every member has the same score.
+type ZRangeByLexRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Min           *LexBound              `protobuf:"bytes,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max           *LexBound              `protobuf:"bytes,3,opt,name=max,proto3" json:"max,omitempty"`
+	Reverse       bool                   `protobuf:"varint,4,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	Offset        int64                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int64                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZRangeByLexRequest) Reset() {
+	*x = ZRangeByLexRequest{}
+	mi := &file_memora_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZRangeByLexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZRangeByLexRequest) ProtoMessage() {}
+
+func (x *ZRangeByLexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZRangeByLexRequest.ProtoReflect.Descriptor instead.
+func (*ZRangeByLexRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ZRangeByLexRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *ZRangeByLexRequest) GetMin() *LexBound {
+	if x != nil {
+		return x.Min
+	}
+	return nil
+}
+
+func (x *ZRangeByLexRequest) GetMax() *LexBound {
+	if x != nil {
+		return x.Max
+	}
+	return nil
+}
+
+func (x *ZRangeByLexRequest) GetReverse() bool {
+	if x != nil {
+		return x.Reverse
+	}
+	return false
+}
+
+func (x *ZRangeByLexRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ZRangeByLexRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ZRemRangeByScoreRequest removes the members of a sorted set with a score from min to max like
+// ZRangeByScoreRequest, the key is deleted once its last member is
+type ZRemRangeByScoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Min           *ScoreBound            `protobuf:"bytes,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max           *ScoreBound            `protobuf:"bytes,3,opt,name=max,proto3" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZRemRangeByScoreRequest) Reset() {
+	*x = ZRemRangeByScoreRequest{}
+	mi := &file_memora_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZRemRangeByScoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZRemRangeByScoreRequest) ProtoMessage() {}
+
+func (x *ZRemRangeByScoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZRemRangeByScoreRequest.ProtoReflect.Descriptor instead.
+func (*ZRemRangeByScoreRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ZRemRangeByScoreRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *ZRemRangeByScoreRequest) GetMin() *ScoreBound {
+	if x != nil {
+		return x.Min
+	}
+	return nil
+}
+
+func (x *ZRemRangeByScoreRequest) GetMax() *ScoreBound {
+	if x != nil {
+		return x.Max
+	}
+	return nil
+}
+
+type ZRemRangeByScoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Removed       int64                  `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZRemRangeByScoreResponse) Reset() {
+	*x = ZRemRangeByScoreResponse{}
+	mi := &file_memora_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZRemRangeByScoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZRemRangeByScoreResponse) ProtoMessage() {}
+
+func (x *ZRemRangeByScoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZRemRangeByScoreResponse.ProtoReflect.Descriptor instead.
+func (*ZRemRangeByScoreResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ZRemRangeByScoreResponse) GetRemoved() int64 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+// StreamEntry is an entry of a stream. Its id is formatted as <ms>-<seq>, the unix millisecond time
+// the entry was added at and a sequence number telling apart the entries of the same millisecond.
+type StreamEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Fields        map[string][]byte      `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamEntry) Reset() {
+	*x = StreamEntry{}
+	mi := &file_memora_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEntry) ProtoMessage() {}
+
+func (x *StreamEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEntry.ProtoReflect.Descriptor instead.
+func (*StreamEntry) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *StreamEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StreamEntry) GetFields() map[string][]byte {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// XAddRequest appends an entry to the stream stored under entryKey with an id above every id of
+// the stream, creating it without expiration when the key is missing. A positive maxLen trims the
+// oldest entries to keep at most maxLen of them.
+type XAddRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Fields        map[string][]byte      `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	MaxLen        int64                  `protobuf:"varint,3,opt,name=maxLen,proto3" json:"maxLen,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *XAddRequest) Reset() {
+	*x = XAddRequest{}
+	mi := &file_memora_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *XAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XAddRequest) ProtoMessage() {}
+
+func (x *XAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XAddRequest.ProtoReflect.Descriptor instead.
+func (*XAddRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *XAddRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *XAddRequest) GetFields() map[string][]byte {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *XAddRequest) GetMaxLen() int64 {
+	if x != nil {
+		return x.MaxLen
+	}
+	return 0
+}
+
+type XAddResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *XAddResponse) Reset() {
+	*x = XAddResponse{}
+	mi := &file_memora_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *XAddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XAddResponse) ProtoMessage() {}
+
+func (x *XAddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XAddResponse.ProtoReflect.Descriptor instead.
+func (*XAddResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *XAddResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type XLenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *XLenRequest) Reset() {
+	*x = XLenRequest{}
+	mi := &file_memora_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *XLenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XLenRequest) ProtoMessage() {}
+
+func (x *XLenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XLenRequest.ProtoReflect.Descriptor instead.
+func (*XLenRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *XLenRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+type XLenResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// length is 0 when the key is missing
+	Length        int64 `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *XLenResponse) Reset() {
+	*x = XLenResponse{}
+	mi := &file_memora_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *XLenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XLenResponse) ProtoMessage() {}
+
+func (x *XLenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XLenResponse.ProtoReflect.Descriptor instead.
+func (*XLenResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *XLenResponse) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+// XRangeRequest reads up to count entries of a stream with an id from start to end included, all
+// of them when count is 0, by ascending id or descending id when reverse is set. An empty start
+// or "-" is the first entry and an empty end or "+" the last one, an id without sequence number
+// covers the whole millisecond. A missing key is an empty stream.
+type XRangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Start         string                 `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	Count         int64                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Reverse       bool                   `protobuf:"varint,5,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *XRangeRequest) Reset() {
+	*x = XRangeRequest{}
+	mi := &file_memora_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *XRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XRangeRequest) ProtoMessage() {}
+
+func (x *XRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XRangeRequest.ProtoReflect.Descriptor instead.
+func (*XRangeRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *XRangeRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *XRangeRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *XRangeRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *XRangeRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *XRangeRequest) GetReverse() bool {
+	if x != nil {
+		return x.Reverse
+	}
+	return false
+}
+
+type XRangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*StreamEntry         `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *XRangeResponse) Reset() {
+	*x = XRangeResponse{}
+	mi := &file_memora_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *XRangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XRangeResponse) ProtoMessage() {}
+
+func (x *XRangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XRangeResponse.ProtoReflect.Descriptor instead.
+func (*XRangeResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *XRangeResponse) GetEntries() []*StreamEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// XGroupCreateRequest creates a consumer group of the stream stored under entryKey delivering the
+// entries with an id above start, creating an empty stream without expiration when the key is
+// missing. An empty start or "$" delivers the entries added from now on and "0" every entry.
+// It fails with ALREADY_EXISTS and reason GROUP_EXISTS when the group exists.
+type XGroupCreateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Group         string                 `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Start         string                 `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *XGroupCreateRequest) Reset() {
+	*x = XGroupCreateRequest{}
+	mi := &file_memora_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *XGroupCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XGroupCreateRequest) ProtoMessage() {}
+
+func (x *XGroupCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XGroupCreateRequest.ProtoReflect.Descriptor instead.
+func (*XGroupCreateRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *XGroupCreateRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *XGroupCreateRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *XGroupCreateRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+type XGroupCreateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *XGroupCreateResponse) Reset() {
+	*x = XGroupCreateResponse{}
+	mi := &file_memora_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *XGroupCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XGroupCreateResponse) ProtoMessage() {}
+
+func (x *XGroupCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XGroupCreateResponse.ProtoReflect.Descriptor instead.
+func (*XGroupCreateResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{81}
+}
+
+// XGroupDestroyRequest deletes a consumer group along with its pending entries
+type XGroupDestroyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Group         string                 `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *XGroupDestroyRequest) Reset() {
+	*x = XGroupDestroyRequest{}
+	mi := &file_memora_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *XGroupDestroyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XGroupDestroyRequest) ProtoMessage() {}
+
+func (x *XGroupDestroyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XGroupDestroyRequest.ProtoReflect.Descriptor instead.
+func (*XGroupDestroyRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *XGroupDestroyRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *XGroupDestroyRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+type XGroupDestroyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// destroyed is false when the group did not exist
+	Destroyed     bool `protobuf:"varint,1,opt,name=destroyed,proto3" json:"destroyed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *XGroupDestroyResponse) Reset() {
+	*x = XGroupDestroyResponse{}
+	mi := &file_memora_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *XGroupDestroyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XGroupDestroyResponse) ProtoMessage() {}
+
+func (x *XGroupDestroyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XGroupDestroyResponse.ProtoReflect.Descriptor instead.
+func (*XGroupDestroyResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *XGroupDestroyResponse) GetDestroyed() bool {
+	if x != nil {
+		return x.Destroyed
+	}
+	return false
+}
+
+// XReadGroupRequest streams the entries of a stream the group did not deliver yet to consumer, up
+// to count entries per response, 1 when count is 0. Delivered entries stay pending in the group
+// until they are acknowledged with XAck. The stream waits for new entries until the call is
+// canceled. It fails with NOT_FOUND when the key is missing or deleted, with reason GROUP_NOT_FOUND
+// when the group is, and with UNAVAILABLE and reason SHUTTING_DOWN when the server shuts down.
+type XReadGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Group         string                 `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Consumer      string                 `protobuf:"bytes,3,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Count         int64                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *XReadGroupRequest) Reset() {
+	*x = XReadGroupRequest{}
+	mi := &file_memora_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *XReadGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XReadGroupRequest) ProtoMessage() {}
+
+func (x *XReadGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XReadGroupRequest.ProtoReflect.Descriptor instead.
+func (*XReadGroupRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *XReadGroupRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *XReadGroupRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *XReadGroupRequest) GetConsumer() string {
+	if x != nil {
+		return x.Consumer
+	}
+	return ""
+}
+
+func (x *XReadGroupRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type XReadGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*StreamEntry         `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *XReadGroupResponse) Reset() {
+	*x = XReadGroupResponse{}
+	mi := &file_memora_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *XReadGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XReadGroupResponse) ProtoMessage() {}
+
+func (x *XReadGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XReadGroupResponse.ProtoReflect.Descriptor instead.
+func (*XReadGroupResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *XReadGroupResponse) GetEntries() []*StreamEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// XAckRequest removes ids from the pending entries of a consumer group
+type XAckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Group         string                 `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Ids           []string               `protobuf:"bytes,3,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *XAckRequest) Reset() {
+	*x = XAckRequest{}
+	mi := &file_memora_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *XAckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XAckRequest) ProtoMessage() {}
+
+func (x *XAckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XAckRequest.ProtoReflect.Descriptor instead.
+func (*XAckRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *XAckRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *XAckRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *XAckRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type XAckResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// acknowledged is how many of the ids were pending
+	Acknowledged  int64 `protobuf:"varint,1,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *XAckResponse) Reset() {
+	*x = XAckResponse{}
+	mi := &file_memora_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *XAckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XAckResponse) ProtoMessage() {}
+
+func (x *XAckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XAckResponse.ProtoReflect.Descriptor instead.
+func (*XAckResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *XAckResponse) GetAcknowledged() int64 {
+	if x != nil {
+		return x.Acknowledged
+	}
+	return 0
+}
+
+// PendingEntry is an entry delivered to a consumer of a group and not acknowledged yet
+type PendingEntry struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Consumer string                 `protobuf:"bytes,2,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	// idle is the time in milliseconds since the last delivery
+	Idle int64 `protobuf:"varint,3,opt,name=idle,proto3" json:"idle,omitempty"`
+	// deliveries counts how many times the entry was delivered
+	Deliveries    int64 `protobuf:"varint,4,opt,name=deliveries,proto3" json:"deliveries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PendingEntry) Reset() {
+	*x = PendingEntry{}
+	mi := &file_memora_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PendingEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingEntry) ProtoMessage() {}
+
+func (x *PendingEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingEntry.ProtoReflect.Descriptor instead.
+func (*PendingEntry) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *PendingEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PendingEntry) GetConsumer() string {
+	if x != nil {
+		return x.Consumer
+	}
+	return ""
+}
+
+func (x *PendingEntry) GetIdle() int64 {
+	if x != nil {
+		return x.Idle
+	}
+	return 0
+}
+
+func (x *PendingEntry) GetDeliveries() int64 {
+	if x != nil {
+		return x.Deliveries
+	}
+	return 0
+}
+
+// XPendingRequest reads up to count pending entries of a consumer group by ascending id, all of them
+// when count is 0, only those of consumer unless it is empty
+type XPendingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Group         string                 `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Consumer      string                 `protobuf:"bytes,3,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Count         int64                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *XPendingRequest) Reset() {
+	*x = XPendingRequest{}
+	mi := &file_memora_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *XPendingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XPendingRequest) ProtoMessage() {}
+
+func (x *XPendingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XPendingRequest.ProtoReflect.Descriptor instead.
+func (*XPendingRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *XPendingRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *XPendingRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *XPendingRequest) GetConsumer() string {
+	if x != nil {
+		return x.Consumer
+	}
+	return ""
+}
+
+func (x *XPendingRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type XPendingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*PendingEntry        `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *XPendingResponse) Reset() {
+	*x = XPendingResponse{}
+	mi := &file_memora_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *XPendingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XPendingResponse) ProtoMessage() {}
+
+func (x *XPendingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XPendingResponse.ProtoReflect.Descriptor instead.
+func (*XPendingResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *XPendingResponse) GetEntries() []*PendingEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// XAutoClaimRequest hands to consumer up to count pending entries of a consumer group, 100 when
+// count is 0, with an id from start that were delivered at least minIdle milliseconds ago, e.g. to
+// recover the entries of a consumer that crashed. An empty start is "0".
+type XAutoClaimRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Group         string                 `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Consumer      string                 `protobuf:"bytes,3,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	MinIdle       int64                  `protobuf:"varint,4,opt,name=minIdle,proto3" json:"minIdle,omitempty"`
+	Start         string                 `protobuf:"bytes,5,opt,name=start,proto3" json:"start,omitempty"`
+	Count         int64                  `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *XAutoClaimRequest) Reset() {
+	*x = XAutoClaimRequest{}
+	mi := &file_memora_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *XAutoClaimRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XAutoClaimRequest) ProtoMessage() {}
+
+func (x *XAutoClaimRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XAutoClaimRequest.ProtoReflect.Descriptor instead.
+func (*XAutoClaimRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *XAutoClaimRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *XAutoClaimRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *XAutoClaimRequest) GetConsumer() string {
+	if x != nil {
+		return x.Consumer
+	}
+	return ""
+}
+
+func (x *XAutoClaimRequest) GetMinIdle() int64 {
+	if x != nil {
+		return x.MinIdle
+	}
+	return 0
+}
+
+func (x *XAutoClaimRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *XAutoClaimRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type XAutoClaimResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// next is the start of the next call, "0-0" once every pending entry was scanned
+	Next    string         `protobuf:"bytes,1,opt,name=next,proto3" json:"next,omitempty"`
+	Entries []*StreamEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	// deletedIds are the pending entries trimmed from the stream, they are acknowledged
+	DeletedIds    []string `protobuf:"bytes,3,rep,name=deletedIds,proto3" json:"deletedIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *XAutoClaimResponse) Reset() {
+	*x = XAutoClaimResponse{}
+	mi := &file_memora_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *XAutoClaimResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XAutoClaimResponse) ProtoMessage() {}
+
+func (x *XAutoClaimResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XAutoClaimResponse.ProtoReflect.Descriptor instead.
+func (*XAutoClaimResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *XAutoClaimResponse) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+func (x *XAutoClaimResponse) GetEntries() []*StreamEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *XAutoClaimResponse) GetDeletedIds() []string {
+	if x != nil {
+		return x.DeletedIds
+	}
+	return nil
+}
+
+// PFAddRequest adds elements to the HyperLogLog stored under entryKey, creating it without
+// expiration when the key is missing. A HyperLogLog estimates the number of distinct elements
+// added to it with a standard error of 0.81% in 16 KB.
+type PFAddRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Elements      []string               `protobuf:"bytes,2,rep,name=elements,proto3" json:"elements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PFAddRequest) Reset() {
+	*x = PFAddRequest{}
+	mi := &file_memora_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PFAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PFAddRequest) ProtoMessage() {}
+
+func (x *PFAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PFAddRequest.ProtoReflect.Descriptor instead.
+func (*PFAddRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *PFAddRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *PFAddRequest) GetElements() []string {
+	if x != nil {
+		return x.Elements
+	}
+	return nil
+}
+
+type PFAddResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// changed is true when the estimate may have changed, always for a new key
+	Changed       bool `protobuf:"varint,1,opt,name=changed,proto3" json:"changed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PFAddResponse) Reset() {
+	*x = PFAddResponse{}
+	mi := &file_memora_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PFAddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PFAddResponse) ProtoMessage() {}
+
+func (x *PFAddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PFAddResponse.ProtoReflect.Descriptor instead.
+func (*PFAddResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *PFAddResponse) GetChanged() bool {
+	if x != nil {
+		return x.Changed
+	}
+	return false
+}
+
+// PFCountRequest estimates the number of distinct elements added to the HyperLogLogs stored under
+// entryKeys, counting the elements added to several of them once. Missing keys count no elements.
+type PFCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKeys     []string               `protobuf:"bytes,1,rep,name=entryKeys,proto3" json:"entryKeys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PFCountRequest) Reset() {
+	*x = PFCountRequest{}
+	mi := &file_memora_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PFCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PFCountRequest) ProtoMessage() {}
+
+func (x *PFCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PFCountRequest.ProtoReflect.Descriptor instead.
+func (*PFCountRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *PFCountRequest) GetEntryKeys() []string {
+	if x != nil {
+		return x.EntryKeys
+	}
+	return nil
+}
+
+type PFCountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         uint64                 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PFCountResponse) Reset() {
+	*x = PFCountResponse{}
+	mi := &file_memora_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PFCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PFCountResponse) ProtoMessage() {}
+
+func (x *PFCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PFCountResponse.ProtoReflect.Descriptor instead.
+func (*PFCountResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *PFCountResponse) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// PFMergeRequest stores under destination a HyperLogLog counting the elements of destination and
+// of the HyperLogLogs stored under entryKeys, keeping the expiration of an existing destination
+type PFMergeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Destination   string                 `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	EntryKeys     []string               `protobuf:"bytes,2,rep,name=entryKeys,proto3" json:"entryKeys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PFMergeRequest) Reset() {
+	*x = PFMergeRequest{}
+	mi := &file_memora_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PFMergeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PFMergeRequest) ProtoMessage() {}
+
+func (x *PFMergeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PFMergeRequest.ProtoReflect.Descriptor instead.
+func (*PFMergeRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *PFMergeRequest) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *PFMergeRequest) GetEntryKeys() []string {
+	if x != nil {
+		return x.EntryKeys
+	}
+	return nil
+}
+
+type PFMergeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PFMergeResponse) Reset() {
+	*x = PFMergeResponse{}
+	mi := &file_memora_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PFMergeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PFMergeResponse) ProtoMessage() {}
+
+func (x *PFMergeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PFMergeResponse.ProtoReflect.Descriptor instead.
+func (*PFMergeResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{98}
+}
+
+// BFReserveRequest creates an empty Bloom filter under entryKey without expiration, with a rate of
+// false positives of at most errorRate and room for capacity items before it grows. It fails with
+// ALREADY_EXISTS and reason KEY_EXISTS when the key exists.
+type BFReserveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	ErrorRate     float64                `protobuf:"fixed64,2,opt,name=errorRate,proto3" json:"errorRate,omitempty"`
+	Capacity      int64                  `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BFReserveRequest) Reset() {
+	*x = BFReserveRequest{}
+	mi := &file_memora_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BFReserveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BFReserveRequest) ProtoMessage() {}
+
+func (x *BFReserveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BFReserveRequest.ProtoReflect.Descriptor instead.
+func (*BFReserveRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *BFReserveRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *BFReserveRequest) GetErrorRate() float64 {
+	if x != nil {
+		return x.ErrorRate
+	}
+	return 0
+}
+
+func (x *BFReserveRequest) GetCapacity() int64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+type BFReserveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BFReserveResponse) Reset() {
+	*x = BFReserveResponse{}
+	mi := &file_memora_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BFReserveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BFReserveResponse) ProtoMessage() {}
+
+func (x *BFReserveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BFReserveResponse.ProtoReflect.Descriptor instead.
+func (*BFReserveResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{100}
+}
+
+// BFAddRequest adds items to the Bloom filter stored under entryKey, creating it without expiration
+// with an error rate of 0.01 and a capacity of 100 when the key is missing. A filter never misses
+// an item added to it and grows as needed once it holds its capacity.
+type BFAddRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Items         []string               `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BFAddRequest) Reset() {
+	*x = BFAddRequest{}
+	mi := &file_memora_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BFAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BFAddRequest) ProtoMessage() {}
+
+func (x *BFAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BFAddRequest.ProtoReflect.Descriptor instead.
+func (*BFAddRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *BFAddRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *BFAddRequest) GetItems() []string {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type BFAddResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// added holds for every item whether it was added, false when the filter may already hold it
+	Added         []bool `protobuf:"varint,1,rep,packed,name=added,proto3" json:"added,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BFAddResponse) Reset() {
+	*x = BFAddResponse{}
+	mi := &file_memora_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BFAddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BFAddResponse) ProtoMessage() {}
+
+func (x *BFAddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BFAddResponse.ProtoReflect.Descriptor instead.
+func (*BFAddResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *BFAddResponse) GetAdded() []bool {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+// BFExistsRequest checks whether a Bloom filter may hold items, a missing key holds none
+type BFExistsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Items         []string               `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BFExistsRequest) Reset() {
+	*x = BFExistsRequest{}
+	mi := &file_memora_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BFExistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BFExistsRequest) ProtoMessage() {}
+
+func (x *BFExistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BFExistsRequest.ProtoReflect.Descriptor instead.
+func (*BFExistsRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *BFExistsRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *BFExistsRequest) GetItems() []string {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type BFExistsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// exists holds for every item whether the filter may hold it
+	Exists        []bool `protobuf:"varint,1,rep,packed,name=exists,proto3" json:"exists,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BFExistsResponse) Reset() {
+	*x = BFExistsResponse{}
+	mi := &file_memora_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BFExistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BFExistsResponse) ProtoMessage() {}
+
+func (x *BFExistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BFExistsResponse.ProtoReflect.Descriptor instead.
+func (*BFExistsResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *BFExistsResponse) GetExists() []bool {
+	if x != nil {
+		return x.Exists
+	}
+	return nil
+}
+
+// CMSInitByDimRequest creates a Count-Min Sketch under entryKey without expiration with depth rows of
+// width counters. It fails with ALREADY_EXISTS and reason KEY_EXISTS when the key exists.
+type CMSInitByDimRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Width         uint64                 `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
+	Depth         uint64                 `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CMSInitByDimRequest) Reset() {
+	*x = CMSInitByDimRequest{}
+	mi := &file_memora_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CMSInitByDimRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CMSInitByDimRequest) ProtoMessage() {}
+
+func (x *CMSInitByDimRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CMSInitByDimRequest.ProtoReflect.Descriptor instead.
+func (*CMSInitByDimRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *CMSInitByDimRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *CMSInitByDimRequest) GetWidth() uint64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *CMSInitByDimRequest) GetDepth() uint64 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+// CMSInitByProbRequest creates a Count-Min Sketch like CMSInitByDimRequest, sized to overcount an
+// item by at most errorRate of the total count with a probability of at least 1 - probability
+type CMSInitByProbRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	ErrorRate     float64                `protobuf:"fixed64,2,opt,name=errorRate,proto3" json:"errorRate,omitempty"`
+	Probability   float64                `protobuf:"fixed64,3,opt,name=probability,proto3" json:"probability,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CMSInitByProbRequest) Reset() {
+	*x = CMSInitByProbRequest{}
+	mi := &file_memora_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CMSInitByProbRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CMSInitByProbRequest) ProtoMessage() {}
+
+func (x *CMSInitByProbRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CMSInitByProbRequest.ProtoReflect.Descriptor instead.
+func (*CMSInitByProbRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *CMSInitByProbRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *CMSInitByProbRequest) GetErrorRate() float64 {
+	if x != nil {
+		return x.ErrorRate
+	}
+	return 0
+}
+
+func (x *CMSInitByProbRequest) GetProbability() float64 {
+	if x != nil {
+		return x.Probability
+	}
+	return 0
+}
+
+type CMSInitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CMSInitResponse) Reset() {
+	*x = CMSInitResponse{}
+	mi := &file_memora_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CMSInitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CMSInitResponse) ProtoMessage() {}
+
+func (x *CMSInitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CMSInitResponse.ProtoReflect.Descriptor instead.
+func (*CMSInitResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{107}
+}
+
+// CMSItem is an item of a Count-Min Sketch along with the count to add to it
+type CMSItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          string                 `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Increment     uint64                 `protobuf:"varint,2,opt,name=increment,proto3" json:"increment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CMSItem) Reset() {
+	*x = CMSItem{}
+	mi := &file_memora_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CMSItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CMSItem) ProtoMessage() {}
+
+func (x *CMSItem) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CMSItem.ProtoReflect.Descriptor instead.
+func (*CMSItem) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *CMSItem) GetItem() string {
+	if x != nil {
+		return x.Item
+	}
+	return ""
+}
+
+func (x *CMSItem) GetIncrement() uint64 {
+	if x != nil {
+		return x.Increment
+	}
+	return 0
+}
+
+// CMSIncrByRequest adds to the counts of items in the Count-Min Sketch stored under entryKey, fails
+// with NOT_FOUND when the key is missing and with INVALID_ARGUMENT, counting nothing, when a count
+// would overflow
+type CMSIncrByRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Items         []*CMSItem             `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CMSIncrByRequest) Reset() {
+	*x = CMSIncrByRequest{}
+	mi := &file_memora_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CMSIncrByRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CMSIncrByRequest) ProtoMessage() {}
+
+func (x *CMSIncrByRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CMSIncrByRequest.ProtoReflect.Descriptor instead.
+func (*CMSIncrByRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *CMSIncrByRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *CMSIncrByRequest) GetItems() []*CMSItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CMSIncrByResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// counts are the estimated counts of the items after the increment
+	Counts        []uint64 `protobuf:"varint,1,rep,packed,name=counts,proto3" json:"counts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CMSIncrByResponse) Reset() {
+	*x = CMSIncrByResponse{}
+	mi := &file_memora_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CMSIncrByResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CMSIncrByResponse) ProtoMessage() {}
+
+func (x *CMSIncrByResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CMSIncrByResponse.ProtoReflect.Descriptor instead.
+func (*CMSIncrByResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *CMSIncrByResponse) GetCounts() []uint64 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+// CMSQueryRequest estimates the counts of items in a Count-Min Sketch, which never undercounts,
+// fails with NOT_FOUND when the key is missing
+type CMSQueryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Items         []string               `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CMSQueryRequest) Reset() {
+	*x = CMSQueryRequest{}
+	mi := &file_memora_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CMSQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CMSQueryRequest) ProtoMessage() {}
+
+func (x *CMSQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CMSQueryRequest.ProtoReflect.Descriptor instead.
+func (*CMSQueryRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *CMSQueryRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *CMSQueryRequest) GetItems() []string {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CMSQueryResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Counts []uint64               `protobuf:"varint,1,rep,packed,name=counts,proto3" json:"counts,omitempty"`
+	// total is the sum of every increment of the sketch
+	Total         uint64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CMSQueryResponse) Reset() {
+	*x = CMSQueryResponse{}
+	mi := &file_memora_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CMSQueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CMSQueryResponse) ProtoMessage() {}
+
+func (x *CMSQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CMSQueryResponse.ProtoReflect.Descriptor instead.
+func (*CMSQueryResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *CMSQueryResponse) GetCounts() []uint64 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *CMSQueryResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// EntryResult is the outcome of a single entry of a batch
+type EntryResult struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// code is the canonical status code the entry failed with, e.g. RESOURCE_EXHAUSTED, 0 on success
+	Code    int32  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// reason is the ErrorInfo reason the entry would have failed with on its own, e.g. OUT_OF_MEMORY
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntryResult) Reset() {
+	*x = EntryResult{}
+	mi := &file_memora_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntryResult) ProtoMessage() {}
+
+func (x *EntryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntryResult.ProtoReflect.Descriptor instead.
+func (*EntryResult) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *EntryResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *EntryResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *EntryResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *EntryResult) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type MGetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKeys     []string               `protobuf:"bytes,1,rep,name=entryKeys,proto3" json:"entryKeys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MGetRequest) Reset() {
+	*x = MGetRequest{}
+	mi := &file_memora_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MGetRequest) ProtoMessage() {}
+
+func (x *MGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MGetRequest.ProtoReflect.Descriptor instead.
+func (*MGetRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *MGetRequest) GetEntryKeys() []string {
+	if x != nil {
+		return x.EntryKeys
+	}
+	return nil
+}
+
+type MGetResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// results hold the outcome of every key, in the order of the request
+	Results       []*MGetResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MGetResponse) Reset() {
+	*x = MGetResponse{}
+	mi := &file_memora_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MGetResponse) ProtoMessage() {}
+
+func (x *MGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MGetResponse.ProtoReflect.Descriptor instead.
+func (*MGetResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *MGetResponse) GetResults() []*MGetResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type MGetResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Found bool                   `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
+	Value []byte                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// result is the outcome of reading the key, a missing key is not found but still succeeds
+	Result        *EntryResult `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MGetResult) Reset() {
+	*x = MGetResult{}
+	mi := &file_memora_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MGetResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MGetResult) ProtoMessage() {}
+
+func (x *MGetResult) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MGetResult.ProtoReflect.Descriptor instead.
+func (*MGetResult) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *MGetResult) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *MGetResult) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *MGetResult) GetResult() *EntryResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type MSetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*MSetEntry           `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MSetRequest) Reset() {
+	*x = MSetRequest{}
+	mi := &file_memora_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MSetRequest) ProtoMessage() {}
+
+func (x *MSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MSetRequest.ProtoReflect.Descriptor instead.
+func (*MSetRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *MSetRequest) GetEntries() []*MSetEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type MSetEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKey      string                 `protobuf:"bytes,1,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Value         []byte                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Ttl           int64                  `protobuf:"varint,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	TtlMode       TtlMode                `protobuf:"varint,4,opt,name=ttlMode,proto3,enum=memora.TtlMode" json:"ttlMode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MSetEntry) Reset() {
+	*x = MSetEntry{}
+	mi := &file_memora_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MSetEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MSetEntry) ProtoMessage() {}
+
+func (x *MSetEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MSetEntry.ProtoReflect.Descriptor instead.
+func (*MSetEntry) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *MSetEntry) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *MSetEntry) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *MSetEntry) GetTtl() int64 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+func (x *MSetEntry) GetTtlMode() TtlMode {
+	if x != nil {
+		return x.TtlMode
+	}
+	return TtlMode_ABSOLUTE_SECONDS
+}
+
+type MSetResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// results hold the outcome of every entry, in the order of the request
+	Results []*EntryResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// stored is how many entries were stored
+	Stored        int64 `protobuf:"varint,2,opt,name=stored,proto3" json:"stored,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MSetResponse) Reset() {
+	*x = MSetResponse{}
+	mi := &file_memora_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MSetResponse) ProtoMessage() {}
+
+func (x *MSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MSetResponse.ProtoReflect.Descriptor instead.
+func (*MSetResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *MSetResponse) GetResults() []*EntryResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *MSetResponse) GetStored() int64 {
+	if x != nil {
+		return x.Stored
+	}
+	return 0
+}
+
+type MDeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryKeys     []string               `protobuf:"bytes,1,rep,name=entryKeys,proto3" json:"entryKeys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MDeleteRequest) Reset() {
+	*x = MDeleteRequest{}
+	mi := &file_memora_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MDeleteRequest) ProtoMessage() {}
+
+func (x *MDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MDeleteRequest.ProtoReflect.Descriptor instead.
+func (*MDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *MDeleteRequest) GetEntryKeys() []string {
+	if x != nil {
+		return x.EntryKeys
+	}
+	return nil
+}
+
+type MDeleteResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// found tells for every key, in the order of the request, whether it was found and deleted
+	Found []bool `protobuf:"varint,1,rep,packed,name=found,proto3" json:"found,omitempty"`
+	// deleted is how many keys were deleted
+	Deleted       int64 `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MDeleteResponse) Reset() {
+	*x = MDeleteResponse{}
+	mi := &file_memora_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MDeleteResponse) ProtoMessage() {}
+
+func (x *MDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MDeleteResponse.ProtoReflect.Descriptor instead.
+func (*MDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *MDeleteResponse) GetFound() []bool {
+	if x != nil {
+		return x.Found
+	}
+	return nil
+}
+
+func (x *MDeleteResponse) GetDeleted() int64 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+type ConnectionRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ClientIP string                 `protobuf:"bytes,1,opt,name=clientIP,proto3" json:"clientIP,omitempty"`
+	// username and password, or apiKey, authenticate the client when the server requires it
+	Username      string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	ApiKey        string `protobuf:"bytes,4,opt,name=apiKey,proto3" json:"apiKey,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectionRequest) Reset() {
+	*x = ConnectionRequest{}
+	mi := &file_memora_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionRequest) ProtoMessage() {}
+
+func (x *ConnectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionRequest.ProtoReflect.Descriptor instead.
+func (*ConnectionRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ConnectionRequest) GetClientIP() string {
+	if x != nil {
+		return x.ClientIP
+	}
+	return ""
+}
+
+func (x *ConnectionRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ConnectionRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ConnectionRequest) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+type ConnectionResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Success   bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ClientKey string                 `protobuf:"bytes,2,opt,name=clientKey,proto3" json:"clientKey,omitempty"`
+	Status    string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// expiresAt is when the client key expires in unix milliseconds, 0 when it does not
+	ExpiresAt     int64 `protobuf:"varint,4,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectionResponse) Reset() {
+	*x = ConnectionResponse{}
+	mi := &file_memora_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionResponse) ProtoMessage() {}
+
+func (x *ConnectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionResponse.ProtoReflect.Descriptor instead.
+func (*ConnectionResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *ConnectionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ConnectionResponse) GetClientKey() string {
+	if x != nil {
+		return x.ClientKey
+	}
+	return ""
+}
+
+func (x *ConnectionResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ConnectionResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type DisconnectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in memora.proto.
+	ClientKey     string `protobuf:"bytes,1,opt,name=clientKey,proto3" json:"clientKey,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisconnectRequest) Reset() {
+	*x = DisconnectRequest{}
+	mi := &file_memora_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectRequest) ProtoMessage() {}
+
+func (x *DisconnectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectRequest.ProtoReflect.Descriptor instead.
+func (*DisconnectRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{124}
+}
+
+// Deprecated: Marked as deprecated in memora.proto.
+func (x *DisconnectRequest) GetClientKey() string {
+	if x != nil {
+		return x.ClientKey
+	}
+	return ""
+}
+
+type DisconnectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisconnectResponse) Reset() {
+	*x = DisconnectResponse{}
+	mi := &file_memora_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectResponse) ProtoMessage() {}
+
+func (x *DisconnectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectResponse.ProtoReflect.Descriptor instead.
+func (*DisconnectResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *DisconnectResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DisconnectResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type SnapshotRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in memora.proto.
+	ClientKey     string `protobuf:"bytes,1,opt,name=clientKey,proto3" json:"clientKey,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnapshotRequest) Reset() {
+	*x = SnapshotRequest{}
+	mi := &file_memora_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotRequest) ProtoMessage() {}
+
+func (x *SnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotRequest.ProtoReflect.Descriptor instead.
+func (*SnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{126}
+}
+
+// Deprecated: Marked as deprecated in memora.proto.
+func (x *SnapshotRequest) GetClientKey() string {
+	if x != nil {
+		return x.ClientKey
+	}
+	return ""
+}
+
+type SnapshotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnapshotResponse) Reset() {
+	*x = SnapshotResponse{}
+	mi := &file_memora_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotResponse) ProtoMessage() {}
+
+func (x *SnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotResponse.ProtoReflect.Descriptor instead.
+func (*SnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *SnapshotResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SnapshotResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type RewriteAOFRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in memora.proto.
+	ClientKey     string `protobuf:"bytes,1,opt,name=clientKey,proto3" json:"clientKey,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RewriteAOFRequest) Reset() {
+	*x = RewriteAOFRequest{}
+	mi := &file_memora_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RewriteAOFRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewriteAOFRequest) ProtoMessage() {}
+
+func (x *RewriteAOFRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewriteAOFRequest.ProtoReflect.Descriptor instead.
+func (*RewriteAOFRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{128}
+}
+
+// Deprecated: Marked as deprecated in memora.proto.
+func (x *RewriteAOFRequest) GetClientKey() string {
+	if x != nil {
+		return x.ClientKey
+	}
+	return ""
+}
+
+type RewriteAOFResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RewriteAOFResponse) Reset() {
+	*x = RewriteAOFResponse{}
+	mi := &file_memora_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RewriteAOFResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewriteAOFResponse) ProtoMessage() {}
+
+func (x *RewriteAOFResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewriteAOFResponse.ProtoReflect.Descriptor instead.
+func (*RewriteAOFResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *RewriteAOFResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RewriteAOFResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type StatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in memora.proto.
+	ClientKey     string `protobuf:"bytes,1,opt,name=clientKey,proto3" json:"clientKey,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatsRequest) Reset() {
+	*x = StatsRequest{}
+	mi := &file_memora_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsRequest) ProtoMessage() {}
+
+func (x *StatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsRequest.ProtoReflect.Descriptor instead.
+func (*StatsRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{130}
+}
+
+// Deprecated: Marked as deprecated in memora.proto.
+func (x *StatsRequest) GetClientKey() string {
+	if x != nil {
+		return x.ClientKey
+	}
+	return ""
+}
+
+type StatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Keys          int64                  `protobuf:"varint,3,opt,name=keys,proto3" json:"keys,omitempty"`
+	VolatileKeys  int64                  `protobuf:"varint,4,opt,name=volatileKeys,proto3" json:"volatileKeys,omitempty"`
+	ExpiredKeys   uint64                 `protobuf:"varint,5,opt,name=expiredKeys,proto3" json:"expiredKeys,omitempty"`
+	EvictedKeys   uint64                 `protobuf:"varint,6,opt,name=evictedKeys,proto3" json:"evictedKeys,omitempty"`
+	UsedMemory    int64                  `protobuf:"varint,7,opt,name=usedMemory,proto3" json:"usedMemory,omitempty"`
+	MaxMemory     int64                  `protobuf:"varint,8,opt,name=maxMemory,proto3" json:"maxMemory,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatsResponse) Reset() {
+	*x = StatsResponse{}
+	mi := &file_memora_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse) ProtoMessage() {}
+
+func (x *StatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse.ProtoReflect.Descriptor instead.
+func (*StatsResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *StatsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *StatsResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StatsResponse) GetKeys() int64 {
+	if x != nil {
+		return x.Keys
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetVolatileKeys() int64 {
+	if x != nil {
+		return x.VolatileKeys
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetExpiredKeys() uint64 {
+	if x != nil {
+		return x.ExpiredKeys
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetEvictedKeys() uint64 {
+	if x != nil {
+		return x.EvictedKeys
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetUsedMemory() int64 {
+	if x != nil {
+		return x.UsedMemory
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetMaxMemory() int64 {
+	if x != nil {
+		return x.MaxMemory
+	}
+	return 0
+}
+
+type TTLRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in memora.proto.
+	ClientKey     string `protobuf:"bytes,1,opt,name=clientKey,proto3" json:"clientKey,omitempty"`
+	EntryKey      string `protobuf:"bytes,2,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TTLRequest) Reset() {
+	*x = TTLRequest{}
+	mi := &file_memora_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TTLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TTLRequest) ProtoMessage() {}
+
+func (x *TTLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TTLRequest.ProtoReflect.Descriptor instead.
+func (*TTLRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{132}
+}
+
+// Deprecated: Marked as deprecated in memora.proto.
+func (x *TTLRequest) GetClientKey() string {
+	if x != nil {
+		return x.ClientKey
+	}
+	return ""
+}
+
+func (x *TTLRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+type TTLResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Found  bool                   `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
+	Status string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// expiresAt is the unix timestamp in milliseconds the entry expires at, 0 when it never expires
+	ExpiresAt int64 `protobuf:"varint,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	// ttl is the remaining time to live in milliseconds, 0 when the entry never expires
+	Ttl           int64 `protobuf:"varint,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TTLResponse) Reset() {
+	*x = TTLResponse{}
+	mi := &file_memora_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TTLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TTLResponse) ProtoMessage() {}
+
+func (x *TTLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TTLResponse.ProtoReflect.Descriptor instead.
+func (*TTLResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *TTLResponse) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *TTLResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TTLResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *TTLResponse) GetTtl() int64 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+type ExpireRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in memora.proto.
+	ClientKey     string  `protobuf:"bytes,1,opt,name=clientKey,proto3" json:"clientKey,omitempty"`
+	EntryKey      string  `protobuf:"bytes,2,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	Ttl           int64   `protobuf:"varint,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	TtlMode       TtlMode `protobuf:"varint,4,opt,name=ttlMode,proto3,enum=memora.TtlMode" json:"ttlMode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpireRequest) Reset() {
+	*x = ExpireRequest{}
+	mi := &file_memora_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpireRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireRequest) ProtoMessage() {}
+
+func (x *ExpireRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireRequest.ProtoReflect.Descriptor instead.
+func (*ExpireRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{134}
+}
+
+// Deprecated: Marked as deprecated in memora.proto.
+func (x *ExpireRequest) GetClientKey() string {
+	if x != nil {
+		return x.ClientKey
+	}
+	return ""
+}
+
+func (x *ExpireRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+func (x *ExpireRequest) GetTtl() int64 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+func (x *ExpireRequest) GetTtlMode() TtlMode {
+	if x != nil {
+		return x.TtlMode
+	}
+	return TtlMode_ABSOLUTE_SECONDS
+}
+
+type ExpireResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Found         bool                   `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpireResponse) Reset() {
+	*x = ExpireResponse{}
+	mi := &file_memora_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpireResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireResponse) ProtoMessage() {}
+
+func (x *ExpireResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireResponse.ProtoReflect.Descriptor instead.
+func (*ExpireResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *ExpireResponse) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *ExpireResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type PersistRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in memora.proto.
+	ClientKey     string `protobuf:"bytes,1,opt,name=clientKey,proto3" json:"clientKey,omitempty"`
+	EntryKey      string `protobuf:"bytes,2,opt,name=entryKey,proto3" json:"entryKey,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PersistRequest) Reset() {
+	*x = PersistRequest{}
+	mi := &file_memora_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistRequest) ProtoMessage() {}
+
+func (x *PersistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistRequest.ProtoReflect.Descriptor instead.
+func (*PersistRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{136}
+}
+
+// Deprecated: Marked as deprecated in memora.proto.
+func (x *PersistRequest) GetClientKey() string {
+	if x != nil {
+		return x.ClientKey
+	}
+	return ""
+}
+
+func (x *PersistRequest) GetEntryKey() string {
+	if x != nil {
+		return x.EntryKey
+	}
+	return ""
+}
+
+type PersistResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Found  bool                   `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
+	Status string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// persisted is true when the entry had an expiration that was removed
+	Persisted     bool `protobuf:"varint,3,opt,name=persisted,proto3" json:"persisted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PersistResponse) Reset() {
+	*x = PersistResponse{}
+	mi := &file_memora_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistResponse) ProtoMessage() {}
+
+func (x *PersistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistResponse.ProtoReflect.Descriptor instead.
+func (*PersistResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *PersistResponse) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *PersistResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PersistResponse) GetPersisted() bool {
+	if x != nil {
+		return x.Persisted
+	}
+	return false
+}
+
+type ACLSetUserRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Username string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// rules are applied in order on top of the current rules of the user, e.g. "+@read" or "~cache:*"
+	Rules         []string `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ACLSetUserRequest) Reset() {
+	*x = ACLSetUserRequest{}
+	mi := &file_memora_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ACLSetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ACLSetUserRequest) ProtoMessage() {}
+
+func (x *ACLSetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ACLSetUserRequest.ProtoReflect.Descriptor instead.
+func (*ACLSetUserRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *ACLSetUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ACLSetUserRequest) GetRules() []string {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type ACLSetUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ACLSetUserResponse) Reset() {
+	*x = ACLSetUserResponse{}
+	mi := &file_memora_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ACLSetUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ACLSetUserResponse) ProtoMessage() {}
+
+func (x *ACLSetUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ACLSetUserResponse.ProtoReflect.Descriptor instead.
+func (*ACLSetUserResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *ACLSetUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ACLSetUserResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ACLDelUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ACLDelUserRequest) Reset() {
+	*x = ACLDelUserRequest{}
+	mi := &file_memora_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ACLDelUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ACLDelUserRequest) ProtoMessage() {}
+
+func (x *ACLDelUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ACLDelUserRequest.ProtoReflect.Descriptor instead.
+func (*ACLDelUserRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *ACLDelUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type ACLDelUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Found         bool                   `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ACLDelUserResponse) Reset() {
+	*x = ACLDelUserResponse{}
+	mi := &file_memora_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ACLDelUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ACLDelUserResponse) ProtoMessage() {}
+
+func (x *ACLDelUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ACLDelUserResponse.ProtoReflect.Descriptor instead.
+func (*ACLDelUserResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *ACLDelUserResponse) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *ACLDelUserResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ACLListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ACLListRequest) Reset() {
+	*x = ACLListRequest{}
+	mi := &file_memora_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ACLListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ACLListRequest) ProtoMessage() {}
+
+func (x *ACLListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ACLListRequest.ProtoReflect.Descriptor instead.
+func (*ACLListRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{142}
+}
+
+type ACLListResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Status  string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// users holds the ACL file line of every user
+	Users         []string `protobuf:"bytes,3,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ACLListResponse) Reset() {
+	*x = ACLListResponse{}
+	mi := &file_memora_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ACLListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ACLListResponse) ProtoMessage() {}
+
+func (x *ACLListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ACLListResponse.ProtoReflect.Descriptor instead.
+func (*ACLListResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *ACLListResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ACLListResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ACLListResponse) GetUsers() []string {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type ACLLoadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ACLLoadRequest) Reset() {
+	*x = ACLLoadRequest{}
+	mi := &file_memora_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ACLLoadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ACLLoadRequest) ProtoMessage() {}
+
+func (x *ACLLoadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ACLLoadRequest.ProtoReflect.Descriptor instead.
+func (*ACLLoadRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{144}
+}
+
+type ACLLoadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ACLLoadResponse) Reset() {
+	*x = ACLLoadResponse{}
+	mi := &file_memora_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ACLLoadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ACLLoadResponse) ProtoMessage() {}
+
+func (x *ACLLoadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ACLLoadResponse.ProtoReflect.Descriptor instead.
+func (*ACLLoadResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *ACLLoadResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ACLLoadResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ACLSaveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ACLSaveRequest) Reset() {
+	*x = ACLSaveRequest{}
+	mi := &file_memora_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ACLSaveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ACLSaveRequest) ProtoMessage() {}
+
+func (x *ACLSaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ACLSaveRequest.ProtoReflect.Descriptor instead.
+func (*ACLSaveRequest) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{146}
+}
+
+type ACLSaveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ACLSaveResponse) Reset() {
+	*x = ACLSaveResponse{}
+	mi := &file_memora_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ACLSaveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ACLSaveResponse) ProtoMessage() {}
+
+func (x *ACLSaveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_memora_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ACLSaveResponse.ProtoReflect.Descriptor instead.
+func (*ACLSaveResponse) Descriptor() ([]byte, []int) {
+	return file_memora_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *ACLSaveResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ACLSaveResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+var File_memora_proto protoreflect.FileDescriptor
+
+const file_memora_proto_rawDesc = "" +
+	"\n" +
+	"\fmemora.proto\x12\x06memora\"\xeb\x01\n" +
+	"\n" +
+	"SetRequest\x12 \n" +
+	"\tclientKey\x18\x01 \x01(\tB\x02\x18\x01R\tclientKey\x12\x1a\n" +
+	"\bentryKey\x18\x02 \x01(\tR\bentryKey\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\fR\x05value\x12\x10\n" +
+	"\x03ttl\x18\x04 \x01(\x03R\x03ttl\x12)\n" +
+	"\attlMode\x18\x05 \x01(\x0e2\x0f.memora.TtlModeR\attlMode\x122\n" +
+	"\tcondition\x18\x06 \x01(\x0e2\x14.memora.SetConditionR\tcondition\x12\x18\n" +
+	"\aversion\x18\a \x01(\x04R\aversion\"Y\n" +
+	"\vSetResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x04R\aversion\"J\n" +
+	"\n" +
+	"GetRequest\x12 \n" +
+	"\tclientKey\x18\x01 \x01(\tB\x02\x18\x01R\tclientKey\x12\x1a\n" +
+	"\bentryKey\x18\x02 \x01(\tR\bentryKey\"U\n" +
+	"\vGetResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x04R\aversion\"~\n" +
+	"\rGetSetRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value\x12\x10\n" +
+	"\x03ttl\x18\x03 \x01(\x03R\x03ttl\x12)\n" +
+	"\attlMode\x18\x04 \x01(\x0e2\x0f.memora.TtlModeR\attlMode\"\\\n" +
+	"\x0eGetSetResponse\x12\x14\n" +
+	"\x05found\x18\x01 \x01(\bR\x05found\x12\x1a\n" +
+	"\boldValue\x18\x02 \x01(\fR\boldValue\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x04R\aversion\"+\n" +
+	"\rGetDelRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\"@\n" +
+	"\x0eGetDelResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\fR\x05value\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x04R\aversion\"M\n" +
+	"\rDeleteRequest\x12 \n" +
+	"\tclientKey\x18\x01 \x01(\tB\x02\x18\x01R\tclientKey\x12\x1a\n" +
+	"\bentryKey\x18\x02 \x01(\tR\bentryKey\">\n" +
+	"\x0eDeleteResponse\x12\x14\n" +
+	"\x05found\x18\x01 \x01(\bR\x05found\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"\x9e\x01\n" +
+	"\rIncrByRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x14\n" +
+	"\x05delta\x18\x02 \x01(\x12R\x05delta\x12\x10\n" +
+	"\x03ttl\x18\x03 \x01(\x03R\x03ttl\x12)\n" +
+	"\attlMode\x18\x04 \x01(\x0e2\x0f.memora.TtlModeR\attlMode\x12\x1e\n" +
+	"\n" +
+	"replaceTtl\x18\x05 \x01(\bR\n" +
+	"replaceTtl\"@\n" +
+	"\x0eIncrByResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x03R\x05value\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x04R\aversion\"\xa3\x01\n" +
+	"\x12IncrByFloatRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x14\n" +
+	"\x05delta\x18\x02 \x01(\x01R\x05delta\x12\x10\n" +
+	"\x03ttl\x18\x03 \x01(\x03R\x03ttl\x12)\n" +
+	"\attlMode\x18\x04 \x01(\x0e2\x0f.memora.TtlModeR\attlMode\x12\x1e\n" +
+	"\n" +
+	"replaceTtl\x18\x05 \x01(\bR\n" +
+	"replaceTtl\"E\n" +
+	"\x13IncrByFloatResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x01R\x05value\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x04R\aversion\"\x9d\x01\n" +
+	"\vHSetRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x127\n" +
+	"\x06fields\x18\x02 \x03(\v2\x1f.memora.HSetRequest.FieldsEntryR\x06fields\x1a9\n" +
+	"\vFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\">\n" +
+	"\fHSetResponse\x12\x14\n" +
+	"\x05added\x18\x01 \x01(\x03R\x05added\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x04R\aversion\"?\n" +
+	"\vHGetRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\">\n" +
+	"\fHGetResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\fR\x05value\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x04R\aversion\",\n" +
+	"\x0eHGetAllRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\"\xa3\x01\n" +
+	"\x0fHGetAllResponse\x12;\n" +
+	"\x06fields\x18\x01 \x03(\v2#.memora.HGetAllResponse.FieldsEntryR\x06fields\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x04R\aversion\x1a9\n" +
+	"\vFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"A\n" +
+	"\vHDelRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x16\n" +
+	"\x06fields\x18\x02 \x03(\tR\x06fields\"(\n" +
+	"\fHDelResponse\x12\x18\n" +
+	"\adeleted\x18\x01 \x01(\x03R\adeleted\"X\n" +
+	"\x0eHIncrByRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12\x14\n" +
+	"\x05delta\x18\x03 \x01(\x12R\x05delta\"A\n" +
+	"\x0fHIncrByResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x03R\x05value\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x04R\aversion\"A\n" +
+	"\vPushRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x16\n" +
+	"\x06values\x18\x02 \x03(\fR\x06values\"&\n" +
+	"\fPushResponse\x12\x16\n" +
+	"\x06length\x18\x01 \x01(\x03R\x06length\">\n" +
+	"\n" +
+	"PopRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"%\n" +
+	"\vPopResponse\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\fR\x06values\"L\n" +
+	"\x12BlockingPopRequest\x12\x1c\n" +
+	"\tentryKeys\x18\x01 \x03(\tR\tentryKeys\x12\x18\n" +
+	"\atimeout\x18\x02 \x01(\x03R\atimeout\"G\n" +
+	"\x13BlockingPopResponse\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value\"U\n" +
+	"\rLRangeRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\x03R\x05start\x12\x12\n" +
+	"\x04stop\x18\x03 \x01(\x03R\x04stop\"(\n" +
+	"\x0eLRangeResponse\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\fR\x06values\"T\n" +
+	"\fLTrimRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\x03R\x05start\x12\x12\n" +
+	"\x04stop\x18\x03 \x01(\x03R\x04stop\"\x0f\n" +
+	"\rLTrimResponse\")\n" +
+	"\vLLenRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\"&\n" +
+	"\fLLenResponse\x12\x16\n" +
+	"\x06length\x18\x01 \x01(\x03R\x06length\"C\n" +
+	"\vSAddRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x18\n" +
+	"\amembers\x18\x02 \x03(\tR\amembers\"$\n" +
+	"\fSAddResponse\x12\x14\n" +
+	"\x05added\x18\x01 \x01(\x03R\x05added\"C\n" +
+	"\vSRemRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x18\n" +
+	"\amembers\x18\x02 \x03(\tR\amembers\"(\n" +
+	"\fSRemResponse\x12\x18\n" +
+	"\aremoved\x18\x01 \x01(\x03R\aremoved\"F\n" +
+	"\x10SIsMemberRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x16\n" +
+	"\x06member\x18\x02 \x01(\tR\x06member\"/\n" +
+	"\x11SIsMemberResponse\x12\x1a\n" +
+	"\bisMember\x18\x01 \x01(\bR\bisMember\"*\n" +
+	"\fSCardRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\"1\n" +
+	"\rSCardResponse\x12 \n" +
+	"\vcardinality\x18\x01 \x01(\x03R\vcardinality\"-\n" +
+	"\x0fSMembersRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\",\n" +
+	"\x10SMembersResponse\x12\x18\n" +
+	"\amembers\x18\x01 \x03(\tR\amembers\"F\n" +
+	"\x12SRandMemberRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"/\n" +
+	"\x13SRandMemberResponse\x12\x18\n" +
+	"\amembers\x18\x01 \x03(\tR\amembers\"1\n" +
+	"\x11SetAlgebraRequest\x12\x1c\n" +
+	"\tentryKeys\x18\x01 \x03(\tR\tentryKeys\".\n" +
+	"\x12SetAlgebraResponse\x12\x18\n" +
+	"\amembers\x18\x01 \x03(\tR\amembers\"X\n" +
+	"\x16SetAlgebraStoreRequest\x12 \n" +
+	"\vdestination\x18\x01 \x01(\tR\vdestination\x12\x1c\n" +
+	"\tentryKeys\x18\x02 \x03(\tR\tentryKeys\";\n" +
+	"\x17SetAlgebraStoreResponse\x12 \n" +
+	"\vcardinality\x18\x01 \x01(\x03R\vcardinality\"7\n" +
+	"\aZMember\x12\x16\n" +
+	"\x06member\x18\x01 \x01(\tR\x06member\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\"\x94\x01\n" +
+	"\vZAddRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12)\n" +
+	"\amembers\x18\x02 \x03(\v2\x0f.memora.ZMemberR\amembers\x12\x0e\n" +
+	"\x02nx\x18\x03 \x01(\bR\x02nx\x12\x0e\n" +
+	"\x02xx\x18\x04 \x01(\bR\x02xx\x12\x0e\n" +
+	"\x02gt\x18\x05 \x01(\bR\x02gt\x12\x0e\n" +
+	"\x02lt\x18\x06 \x01(\bR\x02lt\">\n" +
+	"\fZAddResponse\x12\x14\n" +
+	"\x05added\x18\x01 \x01(\x03R\x05added\x12\x18\n" +
+	"\aupdated\x18\x02 \x01(\x03R\aupdated\"Z\n" +
+	"\x0eZIncrByRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x16\n" +
+	"\x06member\x18\x02 \x01(\tR\x06member\x12\x14\n" +
+	"\x05delta\x18\x03 \x01(\x01R\x05delta\"'\n" +
+	"\x0fZIncrByResponse\x12\x14\n" +
+	"\x05score\x18\x01 \x01(\x01R\x05score\"C\n" +
+	"\vZRemRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x18\n" +
+	"\amembers\x18\x02 \x03(\tR\amembers\"(\n" +
+	"\fZRemResponse\x12\x18\n" +
+	"\aremoved\x18\x01 \x01(\x03R\aremoved\"C\n" +
+	"\rZScoreRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x16\n" +
+	"\x06member\x18\x02 \x01(\tR\x06member\"&\n" +
+	"\x0eZScoreResponse\x12\x14\n" +
+	"\x05score\x18\x01 \x01(\x01R\x05score\"*\n" +
+	"\fZCardRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\"1\n" +
+	"\rZCardResponse\x12 \n" +
+	"\vcardinality\x18\x01 \x01(\x03R\vcardinality\"\\\n" +
+	"\fZRankRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x16\n" +
+	"\x06member\x18\x02 \x01(\tR\x06member\x12\x18\n" +
+	"\areverse\x18\x03 \x01(\bR\areverse\"9\n" +
+	"\rZRankResponse\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x03R\x04rank\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\"o\n" +
+	"\rZRangeRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\x03R\x05start\x12\x12\n" +
+	"\x04stop\x18\x03 \x01(\x03R\x04stop\x12\x18\n" +
+	"\areverse\x18\x04 \x01(\bR\areverse\";\n" +
+	"\x0eZRangeResponse\x12)\n" +
+	"\amembers\x18\x01 \x03(\v2\x0f.memora.ZMemberR\amembers\"@\n" +
+	"\n" +
+	"ScoreBound\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x01R\x05value\x12\x1c\n" +
+	"\texclusive\x18\x02 \x01(\bR\texclusive\"\xc6\x01\n" +
+	"\x14ZRangeByScoreRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12$\n" +
+	"\x03min\x18\x02 \x01(\v2\x12.memora.ScoreBoundR\x03min\x12$\n" +
+	"\x03max\x18\x03 \x01(\v2\x12.memora.ScoreBoundR\x03max\x12\x18\n" +
+	"\areverse\x18\x04 \x01(\bR\areverse\x12\x16\n" +
+	"\x06offset\x18\x05 \x01(\x03R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x03R\x05limit\"\\\n" +
+	"\bLexBound\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
+	"\texclusive\x18\x02 \x01(\bR\texclusive\x12\x1c\n" +
+	"\tunbounded\x18\x03 \x01(\bR\tunbounded\"\xc0\x01\n" +
+	"\x12ZRangeByLexRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\"\n" +
+	"\x03min\x18\x02 \x01(\v2\x10.memora.LexBoundR\x03min\x12\"\n" +
+	"\x03max\x18\x03 \x01(\v2\x10.memora.LexBoundR\x03max\x12\x18\n" +
+	"\areverse\x18\x04 \x01(\bR\areverse\x12\x16\n" +
+	"\x06offset\x18\x05 \x01(\x03R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x03R\x05limit\"\x81\x01\n" +
+	"\x17ZRemRangeByScoreRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12$\n" +
+	"\x03min\x18\x02 \x01(\v2\x12.memora.ScoreBoundR\x03min\x12$\n" +
+	"\x03max\x18\x03 \x01(\v2\x12.memora.ScoreBoundR\x03max\"4\n" +
+	"\x18ZRemRangeByScoreResponse\x12\x18\n" +
+	"\aremoved\x18\x01 \x01(\x03R\aremoved\"\x91\x01\n" +
+	"\vStreamEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x127\n" +
+	"\x06fields\x18\x02 \x03(\v2\x1f.memora.StreamEntry.FieldsEntryR\x06fields\x1a9\n" +
+	"\vFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"\xb5\x01\n" +
+	"\vXAddRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x127\n" +
+	"\x06fields\x18\x02 \x03(\v2\x1f.memora.XAddRequest.FieldsEntryR\x06fields\x12\x16\n" +
+	"\x06maxLen\x18\x03 \x01(\x03R\x06maxLen\x1a9\n" +
+	"\vFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"\x1e\n" +
+	"\fXAddResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\")\n" +
+	"\vXLenRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\"&\n" +
+	"\fXLenResponse\x12\x16\n" +
+	"\x06length\x18\x01 \x01(\x03R\x06length\"\x83\x01\n" +
+	"\rXRangeRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\tR\x03end\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x03R\x05count\x12\x18\n" +
+	"\areverse\x18\x05 \x01(\bR\areverse\"?\n" +
+	"\x0eXRangeResponse\x12-\n" +
+	"\aentries\x18\x01 \x03(\v2\x13.memora.StreamEntryR\aentries\"]\n" +
+	"\x13XGroupCreateRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x14\n" +
+	"\x05group\x18\x02 \x01(\tR\x05group\x12\x14\n" +
+	"\x05start\x18\x03 \x01(\tR\x05start\"\x16\n" +
+	"\x14XGroupCreateResponse\"H\n" +
+	"\x14XGroupDestroyRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x14\n" +
+	"\x05group\x18\x02 \x01(\tR\x05group\"5\n" +
+	"\x15XGroupDestroyResponse\x12\x1c\n" +
+	"\tdestroyed\x18\x01 \x01(\bR\tdestroyed\"w\n" +
+	"\x11XReadGroupRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x14\n" +
+	"\x05group\x18\x02 \x01(\tR\x05group\x12\x1a\n" +
+	"\bconsumer\x18\x03 \x01(\tR\bconsumer\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x03R\x05count\"C\n" +
+	"\x12XReadGroupResponse\x12-\n" +
+	"\aentries\x18\x01 \x03(\v2\x13.memora.StreamEntryR\aentries\"Q\n" +
+	"\vXAckRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x14\n" +
+	"\x05group\x18\x02 \x01(\tR\x05group\x12\x10\n" +
+	"\x03ids\x18\x03 \x03(\tR\x03ids\"2\n" +
+	"\fXAckResponse\x12\"\n" +
+	"\facknowledged\x18\x01 \x01(\x03R\facknowledged\"n\n" +
+	"\fPendingEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bconsumer\x18\x02 \x01(\tR\bconsumer\x12\x12\n" +
+	"\x04idle\x18\x03 \x01(\x03R\x04idle\x12\x1e\n" +
+	"\n" +
+	"deliveries\x18\x04 \x01(\x03R\n" +
+	"deliveries\"u\n" +
+	"\x0fXPendingRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x14\n" +
+	"\x05group\x18\x02 \x01(\tR\x05group\x12\x1a\n" +
+	"\bconsumer\x18\x03 \x01(\tR\bconsumer\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x03R\x05count\"B\n" +
+	"\x10XPendingResponse\x12.\n" +
+	"\aentries\x18\x01 \x03(\v2\x14.memora.PendingEntryR\aentries\"\xa7\x01\n" +
+	"\x11XAutoClaimRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x14\n" +
+	"\x05group\x18\x02 \x01(\tR\x05group\x12\x1a\n" +
+	"\bconsumer\x18\x03 \x01(\tR\bconsumer\x12\x18\n" +
+	"\aminIdle\x18\x04 \x01(\x03R\aminIdle\x12\x14\n" +
+	"\x05start\x18\x05 \x01(\tR\x05start\x12\x14\n" +
+	"\x05count\x18\x06 \x01(\x03R\x05count\"w\n" +
+	"\x12XAutoClaimResponse\x12\x12\n" +
+	"\x04next\x18\x01 \x01(\tR\x04next\x12-\n" +
+	"\aentries\x18\x02 \x03(\v2\x13.memora.StreamEntryR\aentries\x12\x1e\n" +
+	"\n" +
+	"deletedIds\x18\x03 \x03(\tR\n" +
+	"deletedIds\"F\n" +
+	"\fPFAddRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x1a\n" +
+	"\belements\x18\x02 \x03(\tR\belements\")\n" +
+	"\rPFAddResponse\x12\x18\n" +
+	"\achanged\x18\x01 \x01(\bR\achanged\".\n" +
+	"\x0ePFCountRequest\x12\x1c\n" +
+	"\tentryKeys\x18\x01 \x03(\tR\tentryKeys\"'\n" +
+	"\x0fPFCountResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x04R\x05count\"P\n" +
+	"\x0ePFMergeRequest\x12 \n" +
+	"\vdestination\x18\x01 \x01(\tR\vdestination\x12\x1c\n" +
+	"\tentryKeys\x18\x02 \x03(\tR\tentryKeys\"\x11\n" +
+	"\x0fPFMergeResponse\"h\n" +
+	"\x10BFReserveRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x1c\n" +
+	"\terrorRate\x18\x02 \x01(\x01R\terrorRate\x12\x1a\n" +
+	"\bcapacity\x18\x03 \x01(\x03R\bcapacity\"\x13\n" +
+	"\x11BFReserveResponse\"@\n" +
+	"\fBFAddRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x14\n" +
+	"\x05items\x18\x02 \x03(\tR\x05items\"%\n" +
+	"\rBFAddResponse\x12\x14\n" +
+	"\x05added\x18\x01 \x03(\bR\x05added\"C\n" +
+	"\x0fBFExistsRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x14\n" +
+	"\x05items\x18\x02 \x03(\tR\x05items\"*\n" +
+	"\x10BFExistsResponse\x12\x16\n" +
+	"\x06exists\x18\x01 \x03(\bR\x06exists\"]\n" +
+	"\x13CMSInitByDimRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x14\n" +
+	"\x05width\x18\x02 \x01(\x04R\x05width\x12\x14\n" +
+	"\x05depth\x18\x03 \x01(\x04R\x05depth\"r\n" +
+	"\x14CMSInitByProbRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x1c\n" +
+	"\terrorRate\x18\x02 \x01(\x01R\terrorRate\x12 \n" +
+	"\vprobability\x18\x03 \x01(\x01R\vprobability\"\x11\n" +
+	"\x0fCMSInitResponse\";\n" +
+	"\aCMSItem\x12\x12\n" +
+	"\x04item\x18\x01 \x01(\tR\x04item\x12\x1c\n" +
+	"\tincrement\x18\x02 \x01(\x04R\tincrement\"U\n" +
+	"\x10CMSIncrByRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12%\n" +
+	"\x05items\x18\x02 \x03(\v2\x0f.memora.CMSItemR\x05items\"+\n" +
+	"\x11CMSIncrByResponse\x12\x16\n" +
+	"\x06counts\x18\x01 \x03(\x04R\x06counts\"C\n" +
+	"\x0fCMSQueryRequest\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x14\n" +
+	"\x05items\x18\x02 \x03(\tR\x05items\"@\n" +
+	"\x10CMSQueryResponse\x12\x16\n" +
+	"\x06counts\x18\x01 \x03(\x04R\x06counts\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x04R\x05total\"m\n" +
+	"\vEntryResult\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"+\n" +
+	"\vMGetRequest\x12\x1c\n" +
+	"\tentryKeys\x18\x01 \x03(\tR\tentryKeys\"<\n" +
+	"\fMGetResponse\x12,\n" +
+	"\aresults\x18\x01 \x03(\v2\x12.memora.MGetResultR\aresults\"e\n" +
+	"\n" +
+	"MGetResult\x12\x14\n" +
+	"\x05found\x18\x01 \x01(\bR\x05found\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value\x12+\n" +
+	"\x06result\x18\x03 \x01(\v2\x13.memora.EntryResultR\x06result\":\n" +
+	"\vMSetRequest\x12+\n" +
+	"\aentries\x18\x01 \x03(\v2\x11.memora.MSetEntryR\aentries\"z\n" +
+	"\tMSetEntry\x12\x1a\n" +
+	"\bentryKey\x18\x01 \x01(\tR\bentryKey\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value\x12\x10\n" +
+	"\x03ttl\x18\x03 \x01(\x03R\x03ttl\x12)\n" +
+	"\attlMode\x18\x04 \x01(\x0e2\x0f.memora.TtlModeR\attlMode\"U\n" +
+	"\fMSetResponse\x12-\n" +
+	"\aresults\x18\x01 \x03(\v2\x13.memora.EntryResultR\aresults\x12\x16\n" +
+	"\x06stored\x18\x02 \x01(\x03R\x06stored\".\n" +
+	"\x0eMDeleteRequest\x12\x1c\n" +
+	"\tentryKeys\x18\x01 \x03(\tR\tentryKeys\"A\n" +
+	"\x0fMDeleteResponse\x12\x14\n" +
+	"\x05found\x18\x01 \x03(\bR\x05found\x12\x18\n" +
+	"\adeleted\x18\x02 \x01(\x03R\adeleted\"\x7f\n" +
+	"\x11ConnectionRequest\x12\x1a\n" +
+	"\bclientIP\x18\x01 \x01(\tR\bclientIP\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x16\n" +
+	"\x06apiKey\x18\x04 \x01(\tR\x06apiKey\"\x82\x01\n" +
+	"\x12ConnectionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1c\n" +
+	"\tclientKey\x18\x02 \x01(\tR\tclientKey\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1c\n" +
+	"\texpiresAt\x18\x04 \x01(\x03R\texpiresAt\"5\n" +
+	"\x11DisconnectRequest\x12 \n" +
+	"\tclientKey\x18\x01 \x01(\tB\x02\x18\x01R\tclientKey\"F\n" +
+	"\x12DisconnectResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"3\n" +
+	"\x0fSnapshotRequest\x12 \n" +
+	"\tclientKey\x18\x01 \x01(\tB\x02\x18\x01R\tclientKey\"D\n" +
+	"\x10SnapshotResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"5\n" +
+	"\x11RewriteAOFRequest\x12 \n" +
+	"\tclientKey\x18\x01 \x01(\tB\x02\x18\x01R\tclientKey\"F\n" +
+	"\x12RewriteAOFResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"0\n" +
+	"\fStatsRequest\x12 \n" +
+	"\tclientKey\x18\x01 \x01(\tB\x02\x18\x01R\tclientKey\"\xfb\x01\n" +
+	"\rStatsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x12\n" +
+	"\x04keys\x18\x03 \x01(\x03R\x04keys\x12\"\n" +
+	"\fvolatileKeys\x18\x04 \x01(\x03R\fvolatileKeys\x12 \n" +
+	"\vexpiredKeys\x18\x05 \x01(\x04R\vexpiredKeys\x12 \n" +
+	"\vevictedKeys\x18\x06 \x01(\x04R\vevictedKeys\x12\x1e\n" +
+	"\n" +
+	"usedMemory\x18\a \x01(\x03R\n" +
+	"usedMemory\x12\x1c\n" +
+	"\tmaxMemory\x18\b \x01(\x03R\tmaxMemory\"J\n" +
+	"\n" +
+	"TTLRequest\x12 \n" +
+	"\tclientKey\x18\x01 \x01(\tB\x02\x18\x01R\tclientKey\x12\x1a\n" +
+	"\bentryKey\x18\x02 \x01(\tR\bentryKey\"k\n" +
+	"\vTTLResponse\x12\x14\n" +
+	"\x05found\x18\x01 \x01(\bR\x05found\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1c\n" +
+	"\texpiresAt\x18\x03 \x01(\x03R\texpiresAt\x12\x10\n" +
+	"\x03ttl\x18\x04 \x01(\x03R\x03ttl\"\x8a\x01\n" +
+	"\rExpireRequest\x12 \n" +
+	"\tclientKey\x18\x01 \x01(\tB\x02\x18\x01R\tclientKey\x12\x1a\n" +
+	"\bentryKey\x18\x02 \x01(\tR\bentryKey\x12\x10\n" +
+	"\x03ttl\x18\x03 \x01(\x03R\x03ttl\x12)\n" +
+	"\attlMode\x18\x04 \x01(\x0e2\x0f.memora.TtlModeR\attlMode\">\n" +
+	"\x0eExpireResponse\x12\x14\n" +
+	"\x05found\x18\x01 \x01(\bR\x05found\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"N\n" +
+	"\x0ePersistRequest\x12 \n" +
+	"\tclientKey\x18\x01 \x01(\tB\x02\x18\x01R\tclientKey\x12\x1a\n" +
+	"\bentryKey\x18\x02 \x01(\tR\bentryKey\"]\n" +
+	"\x0fPersistResponse\x12\x14\n" +
+	"\x05found\x18\x01 \x01(\bR\x05found\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1c\n" +
+	"\tpersisted\x18\x03 \x01(\bR\tpersisted\"E\n" +
+	"\x11ACLSetUserRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x14\n" +
+	"\x05rules\x18\x02 \x03(\tR\x05rules\"F\n" +
+	"\x12ACLSetUserResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"/\n" +
+	"\x11ACLDelUserRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"B\n" +
+	"\x12ACLDelUserResponse\x12\x14\n" +
+	"\x05found\x18\x01 \x01(\bR\x05found\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"\x10\n" +
+	"\x0eACLListRequest\"Y\n" +
+	"\x0fACLListResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05users\x18\x03 \x03(\tR\x05users\"\x10\n" +
+	"\x0eACLLoadRequest\"C\n" +
+	"\x0fACLLoadResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"\x10\n" +
+	"\x0eACLSaveRequest\"C\n" +
+	"\x0fACLSaveResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status*:\n" +
+	"\aTtlMode\x12\x14\n" +
+	"\x10ABSOLUTE_SECONDS\x10\x00\x12\x19\n" +
+	"\x15RELATIVE_MILLISECONDS\x10\x01*I\n" +
+	"\fSetCondition\x12\n" +
+	"\n" +
+	"\x06ALWAYS\x10\x00\x12\r\n" +
+	"\tIF_ABSENT\x10\x01\x12\x0e\n" +
+	"\n" +
+	"IF_PRESENT\x10\x02\x12\x0e\n" +
+	"\n" +
+	"IF_VERSION\x10\x032\x83%\n" +
+	"\rMemoraService\x12.\n" +
+	"\x03Set\x12\x12.memora.SetRequest\x1a\x13.memora.SetResponse\x12.\n" +
+	"\x03Get\x12\x12.memora.GetRequest\x1a\x13.memora.GetResponse\x127\n" +
+	"\x06Delete\x12\x15.memora.DeleteRequest\x1a\x16.memora.DeleteResponse\x127\n" +
+	"\x06GetSet\x12\x15.memora.GetSetRequest\x1a\x16.memora.GetSetResponse\x127\n" +
+	"\x06GetDel\x12\x15.memora.GetDelRequest\x1a\x16.memora.GetDelResponse\x127\n" +
+	"\x06IncrBy\x12\x15.memora.IncrByRequest\x1a\x16.memora.IncrByResponse\x12F\n" +
+	"\vIncrByFloat\x12\x1a.memora.IncrByFloatRequest\x1a\x1b.memora.IncrByFloatResponse\x121\n" +
+	"\x04HSet\x12\x13.memora.HSetRequest\x1a\x14.memora.HSetResponse\x121\n" +
+	"\x04HGet\x12\x13.memora.HGetRequest\x1a\x14.memora.HGetResponse\x12:\n" +
+	"\aHGetAll\x12\x16.memora.HGetAllRequest\x1a\x17.memora.HGetAllResponse\x121\n" +
+	"\x04HDel\x12\x13.memora.HDelRequest\x1a\x14.memora.HDelResponse\x12:\n" +
+	"\aHIncrBy\x12\x16.memora.HIncrByRequest\x1a\x17.memora.HIncrByResponse\x122\n" +
+	"\x05LPush\x12\x13.memora.PushRequest\x1a\x14.memora.PushResponse\x122\n" +
+	"\x05RPush\x12\x13.memora.PushRequest\x1a\x14.memora.PushResponse\x12/\n" +
+	"\x04LPop\x12\x12.memora.PopRequest\x1a\x13.memora.PopResponse\x12/\n" +
+	"\x04RPop\x12\x12.memora.PopRequest\x1a\x13.memora.PopResponse\x12@\n" +
+	"\x05BLPop\x12\x1a.memora.BlockingPopRequest\x1a\x1b.memora.BlockingPopResponse\x12@\n" +
+	"\x05BRPop\x12\x1a.memora.BlockingPopRequest\x1a\x1b.memora.BlockingPopResponse\x127\n" +
+	"\x06LRange\x12\x15.memora.LRangeRequest\x1a\x16.memora.LRangeResponse\x124\n" +
+	"\x05LTrim\x12\x14.memora.LTrimRequest\x1a\x15.memora.LTrimResponse\x121\n" +
+	"\x04LLen\x12\x13.memora.LLenRequest\x1a\x14.memora.LLenResponse\x121\n" +
+	"\x04SAdd\x12\x13.memora.SAddRequest\x1a\x14.memora.SAddResponse\x121\n" +
+	"\x04SRem\x12\x13.memora.SRemRequest\x1a\x14.memora.SRemResponse\x12@\n" +
+	"\tSIsMember\x12\x18.memora.SIsMemberRequest\x1a\x19.memora.SIsMemberResponse\x124\n" +
+	"\x05SCard\x12\x14.memora.SCardRequest\x1a\x15.memora.SCardResponse\x12=\n" +
+	"\bSMembers\x12\x17.memora.SMembersRequest\x1a\x18.memora.SMembersResponse\x12F\n" +
+	"\vSRandMember\x12\x1a.memora.SRandMemberRequest\x1a\x1b.memora.SRandMemberResponse\x12?\n" +
+	"\x06SInter\x12\x19.memora.SetAlgebraRequest\x1a\x1a.memora.SetAlgebraResponse\x12?\n" +
+	"\x06SUnion\x12\x19.memora.SetAlgebraRequest\x1a\x1a.memora.SetAlgebraResponse\x12>\n" +
+	"\x05SDiff\x12\x19.memora.SetAlgebraRequest\x1a\x1a.memora.SetAlgebraResponse\x12N\n" +
+	"\vSInterStore\x12\x1e.memora.SetAlgebraStoreRequest\x1a\x1f.memora.SetAlgebraStoreResponse\x12N\n" +
+	"\vSUnionStore\x12\x1e.memora.SetAlgebraStoreRequest\x1a\x1f.memora.SetAlgebraStoreResponse\x12M\n" +
+	"\n" +
+	"SDiffStore\x12\x1e.memora.SetAlgebraStoreRequest\x1a\x1f.memora.SetAlgebraStoreResponse\x121\n" +
+	"\x04ZAdd\x12\x13.memora.ZAddRequest\x1a\x14.memora.ZAddResponse\x12:\n" +
+	"\aZIncrBy\x12\x16.memora.ZIncrByRequest\x1a\x17.memora.ZIncrByResponse\x121\n" +
+	"\x04ZRem\x12\x13.memora.ZRemRequest\x1a\x14.memora.ZRemResponse\x127\n" +
+	"\x06ZScore\x12\x15.memora.ZScoreRequest\x1a\x16.memora.ZScoreResponse\x124\n" +
+	"\x05ZCard\x12\x14.memora.ZCardRequest\x1a\x15.memora.ZCardResponse\x124\n" +
+	"\x05ZRank\x12\x14.memora.ZRankRequest\x1a\x15.memora.ZRankResponse\x127\n" +
+	"\x06ZRange\x12\x15.memora.ZRangeRequest\x1a\x16.memora.ZRangeResponse\x12E\n" +
+	"\rZRangeByScore\x12\x1c.memora.ZRangeByScoreRequest\x1a\x16.memora.ZRangeResponse\x12A\n" +
+	"\vZRangeByLex\x12\x1a.memora.ZRangeByLexRequest\x1a\x16.memora.ZRangeResponse\x12U\n" +
+	"\x10ZRemRangeByScore\x12\x1f.memora.ZRemRangeByScoreRequest\x1a .memora.ZRemRangeByScoreResponse\x121\n" +
+	"\x04XAdd\x12\x13.memora.XAddRequest\x1a\x14.memora.XAddResponse\x121\n" +
+	"\x04XLen\x12\x13.memora.XLenRequest\x1a\x14.memora.XLenResponse\x127\n" +
+	"\x06XRange\x12\x15.memora.XRangeRequest\x1a\x16.memora.XRangeResponse\x12I\n" +
+	"\fXGroupCreate\x12\x1b.memora.XGroupCreateRequest\x1a\x1c.memora.XGroupCreateResponse\x12L\n" +
+	"\rXGroupDestroy\x12\x1c.memora.XGroupDestroyRequest\x1a\x1d.memora.XGroupDestroyResponse\x12E\n" +
+	"\n" +
+	"XReadGroup\x12\x19.memora.XReadGroupRequest\x1a\x1a.memora.XReadGroupResponse0\x01\x121\n" +
+	"\x04XAck\x12\x13.memora.XAckRequest\x1a\x14.memora.XAckResponse\x12=\n" +
+	"\bXPending\x12\x17.memora.XPendingRequest\x1a\x18.memora.XPendingResponse\x12C\n" +
+	"\n" +
+	"XAutoClaim\x12\x19.memora.XAutoClaimRequest\x1a\x1a.memora.XAutoClaimResponse\x124\n" +
+	"\x05PFAdd\x12\x14.memora.PFAddRequest\x1a\x15.memora.PFAddResponse\x12:\n" +
+	"\aPFCount\x12\x16.memora.PFCountRequest\x1a\x17.memora.PFCountResponse\x12:\n" +
+	"\aPFMerge\x12\x16.memora.PFMergeRequest\x1a\x17.memora.PFMergeResponse\x12@\n" +
+	"\tBFReserve\x12\x18.memora.BFReserveRequest\x1a\x19.memora.BFReserveResponse\x124\n" +
+	"\x05BFAdd\x12\x14.memora.BFAddRequest\x1a\x15.memora.BFAddResponse\x12=\n" +
+	"\bBFExists\x12\x17.memora.BFExistsRequest\x1a\x18.memora.BFExistsResponse\x12D\n" +
+	"\fCMSInitByDim\x12\x1b.memora.CMSInitByDimRequest\x1a\x17.memora.CMSInitResponse\x12F\n" +
+	"\rCMSInitByProb\x12\x1c.memora.CMSInitByProbRequest\x1a\x17.memora.CMSInitResponse\x12@\n" +
+	"\tCMSIncrBy\x12\x18.memora.CMSIncrByRequest\x1a\x19.memora.CMSIncrByResponse\x12=\n" +
+	"\bCMSQuery\x12\x17.memora.CMSQueryRequest\x1a\x18.memora.CMSQueryResponse\x121\n" +
+	"\x04MGet\x12\x13.memora.MGetRequest\x1a\x14.memora.MGetResponse\x121\n" +
+	"\x04MSet\x12\x13.memora.MSetRequest\x1a\x14.memora.MSetResponse\x12:\n" +
+	"\aMDelete\x12\x16.memora.MDeleteRequest\x1a\x17.memora.MDeleteResponse\x12@\n" +
+	"\aConnect\x12\x19.memora.ConnectionRequest\x1a\x1a.memora.ConnectionResponse\x12C\n" +
+	"\n" +
+	"Disconnect\x12\x19.memora.DisconnectRequest\x1a\x1a.memora.DisconnectResponse\x12=\n" +
+	"\bSnapshot\x12\x17.memora.SnapshotRequest\x1a\x18.memora.SnapshotResponse\x12C\n" +
+	"\n" +
+	"RewriteAOF\x12\x19.memora.RewriteAOFRequest\x1a\x1a.memora.RewriteAOFResponse\x124\n" +
+	"\x05Stats\x12\x14.memora.StatsRequest\x1a\x15.memora.StatsResponse\x12.\n" +
+	"\x03TTL\x12\x12.memora.TTLRequest\x1a\x13.memora.TTLResponse\x127\n" +
+	"\x06Expire\x12\x15.memora.ExpireRequest\x1a\x16.memora.ExpireResponse\x12:\n" +
+	"\aPersist\x12\x16.memora.PersistRequest\x1a\x17.memora.PersistResponse\x12C\n" +
+	"\n" +
+	"ACLSetUser\x12\x19.memora.ACLSetUserRequest\x1a\x1a.memora.ACLSetUserResponse\x12C\n" +
+	"\n" +
+	"ACLDelUser\x12\x19.memora.ACLDelUserRequest\x1a\x1a.memora.ACLDelUserResponse\x12:\n" +
+	"\aACLList\x12\x16.memora.ACLListRequest\x1a\x17.memora.ACLListResponse\x12:\n" +
+	"\aACLLoad\x12\x16.memora.ACLLoadRequest\x1a\x17.memora.ACLLoadResponse\x12:\n" +
+	"\aACLSave\x12\x16.memora.ACLSaveRequest\x1a\x17.memora.ACLSaveResponseB.Z,github.com/Lucascluz/memora/proto/gen;memorab\x06proto3"
 
 var (
 	file_memora_proto_rawDescOnce sync.Once
@@ -495,31 +8710,354 @@ func file_memora_proto_rawDescGZIP() []byte {
 	return file_memora_proto_rawDescData
 }
 
-var file_memora_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_memora_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_memora_proto_msgTypes = make([]protoimpl.MessageInfo, 152)
 var file_memora_proto_goTypes = []any{
-	(*SetRequest)(nil),         // 0: memora.SetRequest
-	(*SetResponse)(nil),        // 1: memora.SetResponse
-	(*GetRequest)(nil),         // 2: memora.GetRequest
-	(*GetResponse)(nil),        // 3: memora.GetResponse
-	(*DeleteRequest)(nil),      // 4: memora.DeleteRequest
-	(*DeleteResponse)(nil),     // 5: memora.DeleteResponse
-	(*ConnectionRequest)(nil),  // 6: memora.ConnectionRequest
-	(*ConnectionResponse)(nil), // 7: memora.ConnectionResponse
+	(TtlMode)(0),                     // 0: memora.TtlMode
+	(SetCondition)(0),                // 1: memora.SetCondition
+	(*SetRequest)(nil),               // 2: memora.SetRequest
+	(*SetResponse)(nil),              // 3: memora.SetResponse
+	(*GetRequest)(nil),               // 4: memora.GetRequest
+	(*GetResponse)(nil),              // 5: memora.GetResponse
+	(*GetSetRequest)(nil),            // 6: memora.GetSetRequest
+	(*GetSetResponse)(nil),           // 7: memora.GetSetResponse
+	(*GetDelRequest)(nil),            // 8: memora.GetDelRequest
+	(*GetDelResponse)(nil),           // 9: memora.GetDelResponse
+	(*DeleteRequest)(nil),            // 10: memora.DeleteRequest
+	(*DeleteResponse)(nil),           // 11: memora.DeleteResponse
+	(*IncrByRequest)(nil),            // 12: memora.IncrByRequest
+	(*IncrByResponse)(nil),           // 13: memora.IncrByResponse
+	(*IncrByFloatRequest)(nil),       // 14: memora.IncrByFloatRequest
+	(*IncrByFloatResponse)(nil),      // 15: memora.IncrByFloatResponse
+	(*HSetRequest)(nil),              // 16: memora.HSetRequest
+	(*HSetResponse)(nil),             // 17: memora.HSetResponse
+	(*HGetRequest)(nil),              // 18: memora.HGetRequest
+	(*HGetResponse)(nil),             // 19: memora.HGetResponse
+	(*HGetAllRequest)(nil),           // 20: memora.HGetAllRequest
+	(*HGetAllResponse)(nil),          // 21: memora.HGetAllResponse
+	(*HDelRequest)(nil),              // 22: memora.HDelRequest
+	(*HDelResponse)(nil),             // 23: memora.HDelResponse
+	(*HIncrByRequest)(nil),           // 24: memora.HIncrByRequest
+	(*HIncrByResponse)(nil),          // 25: memora.HIncrByResponse
+	(*PushRequest)(nil),              // 26: memora.PushRequest
+	(*PushResponse)(nil),             // 27: memora.PushResponse
+	(*PopRequest)(nil),               // 28: memora.PopRequest
+	(*PopResponse)(nil),              // 29: memora.PopResponse
+	(*BlockingPopRequest)(nil),       // 30: memora.BlockingPopRequest
+	(*BlockingPopResponse)(nil),      // 31: memora.BlockingPopResponse
+	(*LRangeRequest)(nil),            // 32: memora.LRangeRequest
+	(*LRangeResponse)(nil),           // 33: memora.LRangeResponse
+	(*LTrimRequest)(nil),             // 34: memora.LTrimRequest
+	(*LTrimResponse)(nil),            // 35: memora.LTrimResponse
+	(*LLenRequest)(nil),              // 36: memora.LLenRequest
+	(*LLenResponse)(nil),             // 37: memora.LLenResponse
+	(*SAddRequest)(nil),              // 38: memora.SAddRequest
+	(*SAddResponse)(nil),             // 39: memora.SAddResponse
+	(*SRemRequest)(nil),              // 40: memora.SRemRequest
+	(*SRemResponse)(nil),             // 41: memora.SRemResponse
+	(*SIsMemberRequest)(nil),         // 42: memora.SIsMemberRequest
+	(*SIsMemberResponse)(nil),        // 43: memora.SIsMemberResponse
+	(*SCardRequest)(nil),             // 44: memora.SCardRequest
+	(*SCardResponse)(nil),            // 45: memora.SCardResponse
+	(*SMembersRequest)(nil),          // 46: memora.SMembersRequest
+	(*SMembersResponse)(nil),         // 47: memora.SMembersResponse
+	(*SRandMemberRequest)(nil),       // 48: memora.SRandMemberRequest
+	(*SRandMemberResponse)(nil),      // 49: memora.SRandMemberResponse
+	(*SetAlgebraRequest)(nil),        // 50: memora.SetAlgebraRequest
+	(*SetAlgebraResponse)(nil),       // 51: memora.SetAlgebraResponse
+	(*SetAlgebraStoreRequest)(nil),   // 52: memora.SetAlgebraStoreRequest
+	(*SetAlgebraStoreResponse)(nil),  // 53: memora.SetAlgebraStoreResponse
+	(*ZMember)(nil),                  // 54: memora.ZMember
+	(*ZAddRequest)(nil),              // 55: memora.ZAddRequest
+	(*ZAddResponse)(nil),             // 56: memora.ZAddResponse
+	(*ZIncrByRequest)(nil),           // 57: memora.ZIncrByRequest
+	(*ZIncrByResponse)(nil),          // 58: memora.ZIncrByResponse
+	(*ZRemRequest)(nil),              // 59: memora.ZRemRequest
+	(*ZRemResponse)(nil),             // 60: memora.ZRemResponse
+	(*ZScoreRequest)(nil),            // 61: memora.ZScoreRequest
+	(*ZScoreResponse)(nil),           // 62: memora.ZScoreResponse
+	(*ZCardRequest)(nil),             // 63: memora.ZCardRequest
+	(*ZCardResponse)(nil),            // 64: memora.ZCardResponse
+	(*ZRankRequest)(nil),             // 65: memora.ZRankRequest
+	(*ZRankResponse)(nil),            // 66: memora.ZRankResponse
+	(*ZRangeRequest)(nil),            // 67: memora.ZRangeRequest
+	(*ZRangeResponse)(nil),           // 68: memora.ZRangeResponse
+	(*ScoreBound)(nil),               // 69: memora.ScoreBound
+	(*ZRangeByScoreRequest)(nil),     // 70: memora.ZRangeByScoreRequest
+	(*LexBound)(nil),                 // 71: memora.LexBound
+	(*ZRangeByLexRequest)(nil),       // 72: memora.ZRangeByLexRequest
+	(*ZRemRangeByScoreRequest)(nil),  // 73: memora.ZRemRangeByScoreRequest
+	(*ZRemRangeByScoreResponse)(nil), // 74: memora.ZRemRangeByScoreResponse
+	(*StreamEntry)(nil),              // 75: memora.StreamEntry
+	(*XAddRequest)(nil),              // 76: memora.XAddRequest
+	(*XAddResponse)(nil),             // 77: memora.XAddResponse
+	(*XLenRequest)(nil),              // 78: memora.XLenRequest
+	(*XLenResponse)(nil),             // 79: memora.XLenResponse
+	(*XRangeRequest)(nil),            // 80: memora.XRangeRequest
+	(*XRangeResponse)(nil),           // 81: memora.XRangeResponse
+	(*XGroupCreateRequest)(nil),      // 82: memora.XGroupCreateRequest
+	(*XGroupCreateResponse)(nil),     // 83: memora.XGroupCreateResponse
+	(*XGroupDestroyRequest)(nil),     // 84: memora.XGroupDestroyRequest
+	(*XGroupDestroyResponse)(nil),    // 85: memora.XGroupDestroyResponse
+	(*XReadGroupRequest)(nil),        // 86: memora.XReadGroupRequest
+	(*XReadGroupResponse)(nil),       // 87: memora.XReadGroupResponse
+	(*XAckRequest)(nil),              // 88: memora.XAckRequest
+	(*XAckResponse)(nil),             // 89: memora.XAckResponse
+	(*PendingEntry)(nil),             // 90: memora.PendingEntry
+	(*XPendingRequest)(nil),          // 91: memora.XPendingRequest
+	(*XPendingResponse)(nil),         // 92: memora.XPendingResponse
+	(*XAutoClaimRequest)(nil),        // 93: memora.XAutoClaimRequest
+	(*XAutoClaimResponse)(nil),       // 94: memora.XAutoClaimResponse
+	(*PFAddRequest)(nil),             // 95: memora.PFAddRequest
+	(*PFAddResponse)(nil),            // 96: memora.PFAddResponse
+	(*PFCountRequest)(nil),           // 97: memora.PFCountRequest
+	(*PFCountResponse)(nil),          // 98: memora.PFCountResponse
+	(*PFMergeRequest)(nil),           // 99: memora.PFMergeRequest
+	(*PFMergeResponse)(nil),          // 100: memora.PFMergeResponse
+	(*BFReserveRequest)(nil),         // 101: memora.BFReserveRequest
+	(*BFReserveResponse)(nil),        // 102: memora.BFReserveResponse
+	(*BFAddRequest)(nil),             // 103: memora.BFAddRequest
+	(*BFAddResponse)(nil),            // 104: memora.BFAddResponse
+	(*BFExistsRequest)(nil),          // 105: memora.BFExistsRequest
+	(*BFExistsResponse)(nil),         // 106: memora.BFExistsResponse
+	(*CMSInitByDimRequest)(nil),      // 107: memora.CMSInitByDimRequest
+	(*CMSInitByProbRequest)(nil),     // 108: memora.CMSInitByProbRequest
+	(*CMSInitResponse)(nil),          // 109: memora.CMSInitResponse
+	(*CMSItem)(nil),                  // 110: memora.CMSItem
+	(*CMSIncrByRequest)(nil),         // 111: memora.CMSIncrByRequest
+	(*CMSIncrByResponse)(nil),        // 112: memora.CMSIncrByResponse
+	(*CMSQueryRequest)(nil),          // 113: memora.CMSQueryRequest
+	(*CMSQueryResponse)(nil),         // 114: memora.CMSQueryResponse
+	(*EntryResult)(nil),              // 115: memora.EntryResult
+	(*MGetRequest)(nil),              // 116: memora.MGetRequest
+	(*MGetResponse)(nil),             // 117: memora.MGetResponse
+	(*MGetResult)(nil),               // 118: memora.MGetResult
+	(*MSetRequest)(nil),              // 119: memora.MSetRequest
+	(*MSetEntry)(nil),                // 120: memora.MSetEntry
+	(*MSetResponse)(nil),             // 121: memora.MSetResponse
+	(*MDeleteRequest)(nil),           // 122: memora.MDeleteRequest
+	(*MDeleteResponse)(nil),          // 123: memora.MDeleteResponse
+	(*ConnectionRequest)(nil),        // 124: memora.ConnectionRequest
+	(*ConnectionResponse)(nil),       // 125: memora.ConnectionResponse
+	(*DisconnectRequest)(nil),        // 126: memora.DisconnectRequest
+	(*DisconnectResponse)(nil),       // 127: memora.DisconnectResponse
+	(*SnapshotRequest)(nil),          // 128: memora.SnapshotRequest
+	(*SnapshotResponse)(nil),         // 129: memora.SnapshotResponse
+	(*RewriteAOFRequest)(nil),        // 130: memora.RewriteAOFRequest
+	(*RewriteAOFResponse)(nil),       // 131: memora.RewriteAOFResponse
+	(*StatsRequest)(nil),             // 132: memora.StatsRequest
+	(*StatsResponse)(nil),            // 133: memora.StatsResponse
+	(*TTLRequest)(nil),               // 134: memora.TTLRequest
+	(*TTLResponse)(nil),              // 135: memora.TTLResponse
+	(*ExpireRequest)(nil),            // 136: memora.ExpireRequest
+	(*ExpireResponse)(nil),           // 137: memora.ExpireResponse
+	(*PersistRequest)(nil),           // 138: memora.PersistRequest
+	(*PersistResponse)(nil),          // 139: memora.PersistResponse
+	(*ACLSetUserRequest)(nil),        // 140: memora.ACLSetUserRequest
+	(*ACLSetUserResponse)(nil),       // 141: memora.ACLSetUserResponse
+	(*ACLDelUserRequest)(nil),        // 142: memora.ACLDelUserRequest
+	(*ACLDelUserResponse)(nil),       // 143: memora.ACLDelUserResponse
+	(*ACLListRequest)(nil),           // 144: memora.ACLListRequest
+	(*ACLListResponse)(nil),          // 145: memora.ACLListResponse
+	(*ACLLoadRequest)(nil),           // 146: memora.ACLLoadRequest
+	(*ACLLoadResponse)(nil),          // 147: memora.ACLLoadResponse
+	(*ACLSaveRequest)(nil),           // 148: memora.ACLSaveRequest
+	(*ACLSaveResponse)(nil),          // 149: memora.ACLSaveResponse
+	nil,                              // 150: memora.HSetRequest.FieldsEntry
+	nil,                              // 151: memora.HGetAllResponse.FieldsEntry
+	nil,                              // 152: memora.StreamEntry.FieldsEntry
+	nil,                              // 153: memora.XAddRequest.FieldsEntry
 }
 var file_memora_proto_depIdxs = []int32{
-	0, // 0: memora.MemoraService.Set:input_type -> memora.SetRequest
-	2, // 1: memora.MemoraService.Get:input_type -> memora.GetRequest
-	4, // 2: memora.MemoraService.Delete:input_type -> memora.DeleteRequest
-	6, // 3: memora.MemoraService.Connect:input_type -> memora.ConnectionRequest
-	1, // 4: memora.MemoraService.Set:output_type -> memora.SetResponse
-	3, // 5: memora.MemoraService.Get:output_type -> memora.GetResponse
-	5, // 6: memora.MemoraService.Delete:output_type -> memora.DeleteResponse
-	7, // 7: memora.MemoraService.Connect:output_type -> memora.ConnectionResponse
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,   // 0: memora.SetRequest.ttlMode:type_name -> memora.TtlMode
+	1,   // 1: memora.SetRequest.condition:type_name -> memora.SetCondition
+	0,   // 2: memora.GetSetRequest.ttlMode:type_name -> memora.TtlMode
+	0,   // 3: memora.IncrByRequest.ttlMode:type_name -> memora.TtlMode
+	0,   // 4: memora.IncrByFloatRequest.ttlMode:type_name -> memora.TtlMode
+	150, // 5: memora.HSetRequest.fields:type_name -> memora.HSetRequest.FieldsEntry
+	151, // 6: memora.HGetAllResponse.fields:type_name -> memora.HGetAllResponse.FieldsEntry
+	54,  // 7: memora.ZAddRequest.members:type_name -> memora.ZMember
+	54,  // 8: memora.ZRangeResponse.members:type_name -> memora.ZMember
+	69,  // 9: memora.ZRangeByScoreRequest.min:type_name -> memora.ScoreBound
+	69,  // 10: memora.ZRangeByScoreRequest.max:type_name -> memora.ScoreBound
+	71,  // 11: memora.ZRangeByLexRequest.min:type_name -> memora.LexBound
+	71,  // 12: memora.ZRangeByLexRequest.max:type_name -> memora.LexBound
+	69,  // 13: memora.ZRemRangeByScoreRequest.min:type_name -> memora.ScoreBound
+	69,  // 14: memora.ZRemRangeByScoreRequest.max:type_name -> memora.ScoreBound
+	152, // 15: memora.StreamEntry.fields:type_name -> memora.StreamEntry.FieldsEntry
+	153, // 16: memora.XAddRequest.fields:type_name -> memora.XAddRequest.FieldsEntry
+	75,  // 17: memora.XRangeResponse.entries:type_name -> memora.StreamEntry
+	75,  // 18: memora.XReadGroupResponse.entries:type_name -> memora.StreamEntry
+	90,  // 19: memora.XPendingResponse.entries:type_name -> memora.PendingEntry
+	75,  // 20: memora.XAutoClaimResponse.entries:type_name -> memora.StreamEntry
+	110, // 21: memora.CMSIncrByRequest.items:type_name -> memora.CMSItem
+	118, // 22: memora.MGetResponse.results:type_name -> memora.MGetResult
+	115, // 23: memora.MGetResult.result:type_name -> memora.EntryResult
+	120, // 24: memora.MSetRequest.entries:type_name -> memora.MSetEntry
+	0,   // 25: memora.MSetEntry.ttlMode:type_name -> memora.TtlMode
+	115, // 26: memora.MSetResponse.results:type_name -> memora.EntryResult
+	0,   // 27: memora.ExpireRequest.ttlMode:type_name -> memora.TtlMode
+	2,   // 28: memora.MemoraService.Set:input_type -> memora.SetRequest
+	4,   // 29: memora.MemoraService.Get:input_type -> memora.GetRequest
+	10,  // 30: memora.MemoraService.Delete:input_type -> memora.DeleteRequest
+	6,   // 31: memora.MemoraService.GetSet:input_type -> memora.GetSetRequest
+	8,   // 32: memora.MemoraService.GetDel:input_type -> memora.GetDelRequest
+	12,  // 33: memora.MemoraService.IncrBy:input_type -> memora.IncrByRequest
+	14,  // 34: memora.MemoraService.IncrByFloat:input_type -> memora.IncrByFloatRequest
+	16,  // 35: memora.MemoraService.HSet:input_type -> memora.HSetRequest
+	18,  // 36: memora.MemoraService.HGet:input_type -> memora.HGetRequest
+	20,  // 37: memora.MemoraService.HGetAll:input_type -> memora.HGetAllRequest
+	22,  // 38: memora.MemoraService.HDel:input_type -> memora.HDelRequest
+	24,  // 39: memora.MemoraService.HIncrBy:input_type -> memora.HIncrByRequest
+	26,  // 40: memora.MemoraService.LPush:input_type -> memora.PushRequest
+	26,  // 41: memora.MemoraService.RPush:input_type -> memora.PushRequest
+	28,  // 42: memora.MemoraService.LPop:input_type -> memora.PopRequest
+	28,  // 43: memora.MemoraService.RPop:input_type -> memora.PopRequest
+	30,  // 44: memora.MemoraService.BLPop:input_type -> memora.BlockingPopRequest
+	30,  // 45: memora.MemoraService.BRPop:input_type -> memora.BlockingPopRequest
+	32,  // 46: memora.MemoraService.LRange:input_type -> memora.LRangeRequest
+	34,  // 47: memora.MemoraService.LTrim:input_type -> memora.LTrimRequest
+	36,  // 48: memora.MemoraService.LLen:input_type -> memora.LLenRequest
+	38,  // 49: memora.MemoraService.SAdd:input_type -> memora.SAddRequest
+	40,  // 50: memora.MemoraService.SRem:input_type -> memora.SRemRequest
+	42,  // 51: memora.MemoraService.SIsMember:input_type -> memora.SIsMemberRequest
+	44,  // 52: memora.MemoraService.SCard:input_type -> memora.SCardRequest
+	46,  // 53: memora.MemoraService.SMembers:input_type -> memora.SMembersRequest
+	48,  // 54: memora.MemoraService.SRandMember:input_type -> memora.SRandMemberRequest
+	50,  // 55: memora.MemoraService.SInter:input_type -> memora.SetAlgebraRequest
+	50,  // 56: memora.MemoraService.SUnion:input_type -> memora.SetAlgebraRequest
+	50,  // 57: memora.MemoraService.SDiff:input_type -> memora.SetAlgebraRequest
+	52,  // 58: memora.MemoraService.SInterStore:input_type -> memora.SetAlgebraStoreRequest
+	52,  // 59: memora.MemoraService.SUnionStore:input_type -> memora.SetAlgebraStoreRequest
+	52,  // 60: memora.MemoraService.SDiffStore:input_type -> memora.SetAlgebraStoreRequest
+	55,  // 61: memora.MemoraService.ZAdd:input_type -> memora.ZAddRequest
+	57,  // 62: memora.MemoraService.ZIncrBy:input_type -> memora.ZIncrByRequest
+	59,  // 63: memora.MemoraService.ZRem:input_type -> memora.ZRemRequest
+	61,  // 64: memora.MemoraService.ZScore:input_type -> memora.ZScoreRequest
+	63,  // 65: memora.MemoraService.ZCard:input_type -> memora.ZCardRequest
+	65,  // 66: memora.MemoraService.ZRank:input_type -> memora.ZRankRequest
+	67,  // 67: memora.MemoraService.ZRange:input_type -> memora.ZRangeRequest
+	70,  // 68: memora.MemoraService.ZRangeByScore:input_type -> memora.ZRangeByScoreRequest
+	72,  // 69: memora.MemoraService.ZRangeByLex:input_type -> memora.ZRangeByLexRequest
+	73,  // 70: memora.MemoraService.ZRemRangeByScore:input_type -> memora.ZRemRangeByScoreRequest
+	76,  // 71: memora.MemoraService.XAdd:input_type -> memora.XAddRequest
+	78,  // 72: memora.MemoraService.XLen:input_type -> memora.XLenRequest
+	80,  // 73: memora.MemoraService.XRange:input_type -> memora.XRangeRequest
+	82,  // 74: memora.MemoraService.XGroupCreate:input_type -> memora.XGroupCreateRequest
+	84,  // 75: memora.MemoraService.XGroupDestroy:input_type -> memora.XGroupDestroyRequest
+	86,  // 76: memora.MemoraService.XReadGroup:input_type -> memora.XReadGroupRequest
+	88,  // 77: memora.MemoraService.XAck:input_type -> memora.XAckRequest
+	91,  // 78: memora.MemoraService.XPending:input_type -> memora.XPendingRequest
+	93,  // 79: memora.MemoraService.XAutoClaim:input_type -> memora.XAutoClaimRequest
+	95,  // 80: memora.MemoraService.PFAdd:input_type -> memora.PFAddRequest
+	97,  // 81: memora.MemoraService.PFCount:input_type -> memora.PFCountRequest
+	99,  // 82: memora.MemoraService.PFMerge:input_type -> memora.PFMergeRequest
+	101, // 83: memora.MemoraService.BFReserve:input_type -> memora.BFReserveRequest
+	103, // 84: memora.MemoraService.BFAdd:input_type -> memora.BFAddRequest
+	105, // 85: memora.MemoraService.BFExists:input_type -> memora.BFExistsRequest
+	107, // 86: memora.MemoraService.CMSInitByDim:input_type -> memora.CMSInitByDimRequest
+	108, // 87: memora.MemoraService.CMSInitByProb:input_type -> memora.CMSInitByProbRequest
+	111, // 88: memora.MemoraService.CMSIncrBy:input_type -> memora.CMSIncrByRequest
+	113, // 89: memora.MemoraService.CMSQuery:input_type -> memora.CMSQueryRequest
+	116, // 90: memora.MemoraService.MGet:input_type -> memora.MGetRequest
+	119, // 91: memora.MemoraService.MSet:input_type -> memora.MSetRequest
+	122, // 92: memora.MemoraService.MDelete:input_type -> memora.MDeleteRequest
+	124, // 93: memora.MemoraService.Connect:input_type -> memora.ConnectionRequest
+	126, // 94: memora.MemoraService.Disconnect:input_type -> memora.DisconnectRequest
+	128, // 95: memora.MemoraService.Snapshot:input_type -> memora.SnapshotRequest
+	130, // 96: memora.MemoraService.RewriteAOF:input_type -> memora.RewriteAOFRequest
+	132, // 97: memora.MemoraService.Stats:input_type -> memora.StatsRequest
+	134, // 98: memora.MemoraService.TTL:input_type -> memora.TTLRequest
+	136, // 99: memora.MemoraService.Expire:input_type -> memora.ExpireRequest
+	138, // 100: memora.MemoraService.Persist:input_type -> memora.PersistRequest
+	140, // 101: memora.MemoraService.ACLSetUser:input_type -> memora.ACLSetUserRequest
+	142, // 102: memora.MemoraService.ACLDelUser:input_type -> memora.ACLDelUserRequest
+	144, // 103: memora.MemoraService.ACLList:input_type -> memora.ACLListRequest
+	146, // 104: memora.MemoraService.ACLLoad:input_type -> memora.ACLLoadRequest
+	148, // 105: memora.MemoraService.ACLSave:input_type -> memora.ACLSaveRequest
+	3,   // 106: memora.MemoraService.Set:output_type -> memora.SetResponse
+	5,   // 107: memora.MemoraService.Get:output_type -> memora.GetResponse
+	11,  // 108: memora.MemoraService.Delete:output_type -> memora.DeleteResponse
+	7,   // 109: memora.MemoraService.GetSet:output_type -> memora.GetSetResponse
+	9,   // 110: memora.MemoraService.GetDel:output_type -> memora.GetDelResponse
+	13,  // 111: memora.MemoraService.IncrBy:output_type -> memora.IncrByResponse
+	15,  // 112: memora.MemoraService.IncrByFloat:output_type -> memora.IncrByFloatResponse
+	17,  // 113: memora.MemoraService.HSet:output_type -> memora.HSetResponse
+	19,  // 114: memora.MemoraService.HGet:output_type -> memora.HGetResponse
+	21,  // 115: memora.MemoraService.HGetAll:output_type -> memora.HGetAllResponse
+	23,  // 116: memora.MemoraService.HDel:output_type -> memora.HDelResponse
+	25,  // 117: memora.MemoraService.HIncrBy:output_type -> memora.HIncrByResponse
+	27,  // 118: memora.MemoraService.LPush:output_type -> memora.PushResponse
+	27,  // 119: memora.MemoraService.RPush:output_type -> memora.PushResponse
+	29,  // 120: memora.MemoraService.LPop:output_type -> memora.PopResponse
+	29,  // 121: memora.MemoraService.RPop:output_type -> memora.PopResponse
+	31,  // 122: memora.MemoraService.BLPop:output_type -> memora.BlockingPopResponse
+	31,  // 123: memora.MemoraService.BRPop:output_type -> memora.BlockingPopResponse
+	33,  // 124: memora.MemoraService.LRange:output_type -> memora.LRangeResponse
+	35,  // 125: memora.MemoraService.LTrim:output_type -> memora.LTrimResponse
+	37,  // 126: memora.MemoraService.LLen:output_type -> memora.LLenResponse
+	39,  // 127: memora.MemoraService.SAdd:output_type -> memora.SAddResponse
+	41,  // 128: memora.MemoraService.SRem:output_type -> memora.SRemResponse
+	43,  // 129: memora.MemoraService.SIsMember:output_type -> memora.SIsMemberResponse
+	45,  // 130: memora.MemoraService.SCard:output_type -> memora.SCardResponse
+	47,  // 131: memora.MemoraService.SMembers:output_type -> memora.SMembersResponse
+	49,  // 132: memora.MemoraService.SRandMember:output_type -> memora.SRandMemberResponse
+	51,  // 133: memora.MemoraService.SInter:output_type -> memora.SetAlgebraResponse
+	51,  // 134: memora.MemoraService.SUnion:output_type -> memora.SetAlgebraResponse
+	51,  // 135: memora.MemoraService.SDiff:output_type -> memora.SetAlgebraResponse
+	53,  // 136: memora.MemoraService.SInterStore:output_type -> memora.SetAlgebraStoreResponse
+	53,  // 137: memora.MemoraService.SUnionStore:output_type -> memora.SetAlgebraStoreResponse
+	53,  // 138: memora.MemoraService.SDiffStore:output_type -> memora.SetAlgebraStoreResponse
+	56,  // 139: memora.MemoraService.ZAdd:output_type -> memora.ZAddResponse
+	58,  // 140: memora.MemoraService.ZIncrBy:output_type -> memora.ZIncrByResponse
+	60,  // 141: memora.MemoraService.ZRem:output_type -> memora.ZRemResponse
+	62,  // 142: memora.MemoraService.ZScore:output_type -> memora.ZScoreResponse
+	64,  // 143: memora.MemoraService.ZCard:output_type -> memora.ZCardResponse
+	66,  // 144: memora.MemoraService.ZRank:output_type -> memora.ZRankResponse
+	68,  // 145: memora.MemoraService.ZRange:output_type -> memora.ZRangeResponse
+	68,  // 146: memora.MemoraService.ZRangeByScore:output_type -> memora.ZRangeResponse
+	68,  // 147: memora.MemoraService.ZRangeByLex:output_type -> memora.ZRangeResponse
+	74,  // 148: memora.MemoraService.ZRemRangeByScore:output_type -> memora.ZRemRangeByScoreResponse
+	77,  // 149: memora.MemoraService.XAdd:output_type -> memora.XAddResponse
+	79,  // 150: memora.MemoraService.XLen:output_type -> memora.XLenResponse
+	81,  // 151: memora.MemoraService.XRange:output_type -> memora.XRangeResponse
+	83,  // 152: memora.MemoraService.XGroupCreate:output_type -> memora.XGroupCreateResponse
+	85,  // 153: memora.MemoraService.XGroupDestroy:output_type -> memora.XGroupDestroyResponse
+	87,  // 154: memora.MemoraService.XReadGroup:output_type -> memora.XReadGroupResponse
+	89,  // 155: memora.MemoraService.XAck:output_type -> memora.XAckResponse
+	92,  // 156: memora.MemoraService.XPending:output_type -> memora.XPendingResponse
+	94,  // 157: memora.MemoraService.XAutoClaim:output_type -> memora.XAutoClaimResponse
+	96,  // 158: memora.MemoraService.PFAdd:output_type -> memora.PFAddResponse
+	98,  // 159: memora.MemoraService.PFCount:output_type -> memora.PFCountResponse
+	100, // 160: memora.MemoraService.PFMerge:output_type -> memora.PFMergeResponse
+	102, // 161: memora.MemoraService.BFReserve:output_type -> memora.BFReserveResponse
+	104, // 162: memora.MemoraService.BFAdd:output_type -> memora.BFAddResponse
+	106, // 163: memora.MemoraService.BFExists:output_type -> memora.BFExistsResponse
+	109, // 164: memora.MemoraService.CMSInitByDim:output_type -> memora.CMSInitResponse
+	109, // 165: memora.MemoraService.CMSInitByProb:output_type -> memora.CMSInitResponse
+	112, // 166: memora.MemoraService.CMSIncrBy:output_type -> memora.CMSIncrByResponse
+	114, // 167: memora.MemoraService.CMSQuery:output_type -> memora.CMSQueryResponse
+	117, // 168: memora.MemoraService.MGet:output_type -> memora.MGetResponse
+	121, // 169: memora.MemoraService.MSet:output_type -> memora.MSetResponse
+	123, // 170: memora.MemoraService.MDelete:output_type -> memora.MDeleteResponse
+	125, // 171: memora.MemoraService.Connect:output_type -> memora.ConnectionResponse
+	127, // 172: memora.MemoraService.Disconnect:output_type -> memora.DisconnectResponse
+	129, // 173: memora.MemoraService.Snapshot:output_type -> memora.SnapshotResponse
+	131, // 174: memora.MemoraService.RewriteAOF:output_type -> memora.RewriteAOFResponse
+	133, // 175: memora.MemoraService.Stats:output_type -> memora.StatsResponse
+	135, // 176: memora.MemoraService.TTL:output_type -> memora.TTLResponse
+	137, // 177: memora.MemoraService.Expire:output_type -> memora.ExpireResponse
+	139, // 178: memora.MemoraService.Persist:output_type -> memora.PersistResponse
+	141, // 179: memora.MemoraService.ACLSetUser:output_type -> memora.ACLSetUserResponse
+	143, // 180: memora.MemoraService.ACLDelUser:output_type -> memora.ACLDelUserResponse
+	145, // 181: memora.MemoraService.ACLList:output_type -> memora.ACLListResponse
+	147, // 182: memora.MemoraService.ACLLoad:output_type -> memora.ACLLoadResponse
+	149, // 183: memora.MemoraService.ACLSave:output_type -> memora.ACLSaveResponse
+	106, // [106:184] is the sub-list for method output_type
+	28,  // [28:106] is the sub-list for method input_type
+	28,  // [28:28] is the sub-list for extension type_name
+	28,  // [28:28] is the sub-list for extension extendee
+	0,   // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_memora_proto_init() }
@@ -532,13 +9070,14 @@ func file_memora_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_memora_proto_rawDesc), len(file_memora_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      2,
+			NumMessages:   152,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_memora_proto_goTypes,
 		DependencyIndexes: file_memora_proto_depIdxs,
+		EnumInfos:         file_memora_proto_enumTypes,
 		MessageInfos:      file_memora_proto_msgTypes,
 	}.Build()
 	File_memora_proto = out.File
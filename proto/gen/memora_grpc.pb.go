@@ -19,20 +19,179 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MemoraService_Set_FullMethodName     = "/memora.MemoraService/Set"
-	MemoraService_Get_FullMethodName     = "/memora.MemoraService/Get"
-	MemoraService_Delete_FullMethodName  = "/memora.MemoraService/Delete"
-	MemoraService_Connect_FullMethodName = "/memora.MemoraService/Connect"
+	MemoraService_Set_FullMethodName              = "/memora.MemoraService/Set"
+	MemoraService_Get_FullMethodName              = "/memora.MemoraService/Get"
+	MemoraService_Delete_FullMethodName           = "/memora.MemoraService/Delete"
+	MemoraService_GetSet_FullMethodName           = "/memora.MemoraService/GetSet"
+	MemoraService_GetDel_FullMethodName           = "/memora.MemoraService/GetDel"
+	MemoraService_IncrBy_FullMethodName           = "/memora.MemoraService/IncrBy"
+	MemoraService_IncrByFloat_FullMethodName      = "/memora.MemoraService/IncrByFloat"
+	MemoraService_HSet_FullMethodName             = "/memora.MemoraService/HSet"
+	MemoraService_HGet_FullMethodName             = "/memora.MemoraService/HGet"
+	MemoraService_HGetAll_FullMethodName          = "/memora.MemoraService/HGetAll"
+	MemoraService_HDel_FullMethodName             = "/memora.MemoraService/HDel"
+	MemoraService_HIncrBy_FullMethodName          = "/memora.MemoraService/HIncrBy"
+	MemoraService_LPush_FullMethodName            = "/memora.MemoraService/LPush"
+	MemoraService_RPush_FullMethodName            = "/memora.MemoraService/RPush"
+	MemoraService_LPop_FullMethodName             = "/memora.MemoraService/LPop"
+	MemoraService_RPop_FullMethodName             = "/memora.MemoraService/RPop"
+	MemoraService_BLPop_FullMethodName            = "/memora.MemoraService/BLPop"
+	MemoraService_BRPop_FullMethodName            = "/memora.MemoraService/BRPop"
+	MemoraService_LRange_FullMethodName           = "/memora.MemoraService/LRange"
+	MemoraService_LTrim_FullMethodName            = "/memora.MemoraService/LTrim"
+	MemoraService_LLen_FullMethodName             = "/memora.MemoraService/LLen"
+	MemoraService_SAdd_FullMethodName             = "/memora.MemoraService/SAdd"
+	MemoraService_SRem_FullMethodName             = "/memora.MemoraService/SRem"
+	MemoraService_SIsMember_FullMethodName        = "/memora.MemoraService/SIsMember"
+	MemoraService_SCard_FullMethodName            = "/memora.MemoraService/SCard"
+	MemoraService_SMembers_FullMethodName         = "/memora.MemoraService/SMembers"
+	MemoraService_SRandMember_FullMethodName      = "/memora.MemoraService/SRandMember"
+	MemoraService_SInter_FullMethodName           = "/memora.MemoraService/SInter"
+	MemoraService_SUnion_FullMethodName           = "/memora.MemoraService/SUnion"
+	MemoraService_SDiff_FullMethodName            = "/memora.MemoraService/SDiff"
+	MemoraService_SInterStore_FullMethodName      = "/memora.MemoraService/SInterStore"
+	MemoraService_SUnionStore_FullMethodName      = "/memora.MemoraService/SUnionStore"
+	MemoraService_SDiffStore_FullMethodName       = "/memora.MemoraService/SDiffStore"
+	MemoraService_ZAdd_FullMethodName             = "/memora.MemoraService/ZAdd"
+	MemoraService_ZIncrBy_FullMethodName          = "/memora.MemoraService/ZIncrBy"
+	MemoraService_ZRem_FullMethodName             = "/memora.MemoraService/ZRem"
+	MemoraService_ZScore_FullMethodName           = "/memora.MemoraService/ZScore"
+	MemoraService_ZCard_FullMethodName            = "/memora.MemoraService/ZCard"
+	MemoraService_ZRank_FullMethodName            = "/memora.MemoraService/ZRank"
+	MemoraService_ZRange_FullMethodName           = "/memora.MemoraService/ZRange"
+	MemoraService_ZRangeByScore_FullMethodName    = "/memora.MemoraService/ZRangeByScore"
+	MemoraService_ZRangeByLex_FullMethodName      = "/memora.MemoraService/ZRangeByLex"
+	MemoraService_ZRemRangeByScore_FullMethodName = "/memora.MemoraService/ZRemRangeByScore"
+	MemoraService_XAdd_FullMethodName             = "/memora.MemoraService/XAdd"
+	MemoraService_XLen_FullMethodName             = "/memora.MemoraService/XLen"
+	MemoraService_XRange_FullMethodName           = "/memora.MemoraService/XRange"
+	MemoraService_XGroupCreate_FullMethodName     = "/memora.MemoraService/XGroupCreate"
+	MemoraService_XGroupDestroy_FullMethodName    = "/memora.MemoraService/XGroupDestroy"
+	MemoraService_XReadGroup_FullMethodName       = "/memora.MemoraService/XReadGroup"
+	MemoraService_XAck_FullMethodName             = "/memora.MemoraService/XAck"
+	MemoraService_XPending_FullMethodName         = "/memora.MemoraService/XPending"
+	MemoraService_XAutoClaim_FullMethodName       = "/memora.MemoraService/XAutoClaim"
+	MemoraService_PFAdd_FullMethodName            = "/memora.MemoraService/PFAdd"
+	MemoraService_PFCount_FullMethodName          = "/memora.MemoraService/PFCount"
+	MemoraService_PFMerge_FullMethodName          = "/memora.MemoraService/PFMerge"
+	MemoraService_BFReserve_FullMethodName        = "/memora.MemoraService/BFReserve"
+	MemoraService_BFAdd_FullMethodName            = "/memora.MemoraService/BFAdd"
+	MemoraService_BFExists_FullMethodName         = "/memora.MemoraService/BFExists"
+	MemoraService_CMSInitByDim_FullMethodName     = "/memora.MemoraService/CMSInitByDim"
+	MemoraService_CMSInitByProb_FullMethodName    = "/memora.MemoraService/CMSInitByProb"
+	MemoraService_CMSIncrBy_FullMethodName        = "/memora.MemoraService/CMSIncrBy"
+	MemoraService_CMSQuery_FullMethodName         = "/memora.MemoraService/CMSQuery"
+	MemoraService_MGet_FullMethodName             = "/memora.MemoraService/MGet"
+	MemoraService_MSet_FullMethodName             = "/memora.MemoraService/MSet"
+	MemoraService_MDelete_FullMethodName          = "/memora.MemoraService/MDelete"
+	MemoraService_Connect_FullMethodName          = "/memora.MemoraService/Connect"
+	MemoraService_Disconnect_FullMethodName       = "/memora.MemoraService/Disconnect"
+	MemoraService_Snapshot_FullMethodName         = "/memora.MemoraService/Snapshot"
+	MemoraService_RewriteAOF_FullMethodName       = "/memora.MemoraService/RewriteAOF"
+	MemoraService_Stats_FullMethodName            = "/memora.MemoraService/Stats"
+	MemoraService_TTL_FullMethodName              = "/memora.MemoraService/TTL"
+	MemoraService_Expire_FullMethodName           = "/memora.MemoraService/Expire"
+	MemoraService_Persist_FullMethodName          = "/memora.MemoraService/Persist"
+	MemoraService_ACLSetUser_FullMethodName       = "/memora.MemoraService/ACLSetUser"
+	MemoraService_ACLDelUser_FullMethodName       = "/memora.MemoraService/ACLDelUser"
+	MemoraService_ACLList_FullMethodName          = "/memora.MemoraService/ACLList"
+	MemoraService_ACLLoad_FullMethodName          = "/memora.MemoraService/ACLLoad"
+	MemoraService_ACLSave_FullMethodName          = "/memora.MemoraService/ACLSave"
 )
 
 // MemoraServiceClient is the client API for MemoraService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Every rpc but Connect is authenticated with the client key returned by Connect, sent as the
+// "authorization: Bearer <clientKey>" metadata. The deprecated clientKey request fields are only
+// read when the metadata is missing.
+//
+// Failed requests return a canonical status code, e.g. NOT_FOUND for a missing key, UNAUTHENTICATED
+// for an unknown or expired client key or PERMISSION_DENIED for a request the ACL rules deny, with a
+// google.rpc.ErrorInfo detail in the "memora" domain whose reason tells errors sharing a code apart.
+// The success and found fields of the responses are true whenever no error is returned. Commands
+// on a key holding another type of value, e.g. HGet on a string, fail with FAILED_PRECONDITION and
+// reason WRONG_TYPE.
 type MemoraServiceClient interface {
 	Set(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*SetResponse, error)
 	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	GetSet(ctx context.Context, in *GetSetRequest, opts ...grpc.CallOption) (*GetSetResponse, error)
+	GetDel(ctx context.Context, in *GetDelRequest, opts ...grpc.CallOption) (*GetDelResponse, error)
+	IncrBy(ctx context.Context, in *IncrByRequest, opts ...grpc.CallOption) (*IncrByResponse, error)
+	IncrByFloat(ctx context.Context, in *IncrByFloatRequest, opts ...grpc.CallOption) (*IncrByFloatResponse, error)
+	HSet(ctx context.Context, in *HSetRequest, opts ...grpc.CallOption) (*HSetResponse, error)
+	HGet(ctx context.Context, in *HGetRequest, opts ...grpc.CallOption) (*HGetResponse, error)
+	HGetAll(ctx context.Context, in *HGetAllRequest, opts ...grpc.CallOption) (*HGetAllResponse, error)
+	HDel(ctx context.Context, in *HDelRequest, opts ...grpc.CallOption) (*HDelResponse, error)
+	HIncrBy(ctx context.Context, in *HIncrByRequest, opts ...grpc.CallOption) (*HIncrByResponse, error)
+	LPush(ctx context.Context, in *PushRequest, opts ...grpc.CallOption) (*PushResponse, error)
+	RPush(ctx context.Context, in *PushRequest, opts ...grpc.CallOption) (*PushResponse, error)
+	LPop(ctx context.Context, in *PopRequest, opts ...grpc.CallOption) (*PopResponse, error)
+	RPop(ctx context.Context, in *PopRequest, opts ...grpc.CallOption) (*PopResponse, error)
+	BLPop(ctx context.Context, in *BlockingPopRequest, opts ...grpc.CallOption) (*BlockingPopResponse, error)
+	BRPop(ctx context.Context, in *BlockingPopRequest, opts ...grpc.CallOption) (*BlockingPopResponse, error)
+	LRange(ctx context.Context, in *LRangeRequest, opts ...grpc.CallOption) (*LRangeResponse, error)
+	LTrim(ctx context.Context, in *LTrimRequest, opts ...grpc.CallOption) (*LTrimResponse, error)
+	LLen(ctx context.Context, in *LLenRequest, opts ...grpc.CallOption) (*LLenResponse, error)
+	SAdd(ctx context.Context, in *SAddRequest, opts ...grpc.CallOption) (*SAddResponse, error)
+	SRem(ctx context.Context, in *SRemRequest, opts ...grpc.CallOption) (*SRemResponse, error)
+	SIsMember(ctx context.Context, in *SIsMemberRequest, opts ...grpc.CallOption) (*SIsMemberResponse, error)
+	SCard(ctx context.Context, in *SCardRequest, opts ...grpc.CallOption) (*SCardResponse, error)
+	SMembers(ctx context.Context, in *SMembersRequest, opts ...grpc.CallOption) (*SMembersResponse, error)
+	SRandMember(ctx context.Context, in *SRandMemberRequest, opts ...grpc.CallOption) (*SRandMemberResponse, error)
+	SInter(ctx context.Context, in *SetAlgebraRequest, opts ...grpc.CallOption) (*SetAlgebraResponse, error)
+	SUnion(ctx context.Context, in *SetAlgebraRequest, opts ...grpc.CallOption) (*SetAlgebraResponse, error)
+	SDiff(ctx context.Context, in *SetAlgebraRequest, opts ...grpc.CallOption) (*SetAlgebraResponse, error)
+	SInterStore(ctx context.Context, in *SetAlgebraStoreRequest, opts ...grpc.CallOption) (*SetAlgebraStoreResponse, error)
+	SUnionStore(ctx context.Context, in *SetAlgebraStoreRequest, opts ...grpc.CallOption) (*SetAlgebraStoreResponse, error)
+	SDiffStore(ctx context.Context, in *SetAlgebraStoreRequest, opts ...grpc.CallOption) (*SetAlgebraStoreResponse, error)
+	ZAdd(ctx context.Context, in *ZAddRequest, opts ...grpc.CallOption) (*ZAddResponse, error)
+	ZIncrBy(ctx context.Context, in *ZIncrByRequest, opts ...grpc.CallOption) (*ZIncrByResponse, error)
+	ZRem(ctx context.Context, in *ZRemRequest, opts ...grpc.CallOption) (*ZRemResponse, error)
+	ZScore(ctx context.Context, in *ZScoreRequest, opts ...grpc.CallOption) (*ZScoreResponse, error)
+	ZCard(ctx context.Context, in *ZCardRequest, opts ...grpc.CallOption) (*ZCardResponse, error)
+	ZRank(ctx context.Context, in *ZRankRequest, opts ...grpc.CallOption) (*ZRankResponse, error)
+	ZRange(ctx context.Context, in *ZRangeRequest, opts ...grpc.CallOption) (*ZRangeResponse, error)
+	ZRangeByScore(ctx context.Context, in *ZRangeByScoreRequest, opts ...grpc.CallOption) (*ZRangeResponse, error)
+	ZRangeByLex(ctx context.Context, in *ZRangeByLexRequest, opts ...grpc.CallOption) (*ZRangeResponse, error)
+	ZRemRangeByScore(ctx context.Context, in *ZRemRangeByScoreRequest, opts ...grpc.CallOption) (*ZRemRangeByScoreResponse, error)
+	XAdd(ctx context.Context, in *XAddRequest, opts ...grpc.CallOption) (*XAddResponse, error)
+	XLen(ctx context.Context, in *XLenRequest, opts ...grpc.CallOption) (*XLenResponse, error)
+	XRange(ctx context.Context, in *XRangeRequest, opts ...grpc.CallOption) (*XRangeResponse, error)
+	XGroupCreate(ctx context.Context, in *XGroupCreateRequest, opts ...grpc.CallOption) (*XGroupCreateResponse, error)
+	XGroupDestroy(ctx context.Context, in *XGroupDestroyRequest, opts ...grpc.CallOption) (*XGroupDestroyResponse, error)
+	XReadGroup(ctx context.Context, in *XReadGroupRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[XReadGroupResponse], error)
+	XAck(ctx context.Context, in *XAckRequest, opts ...grpc.CallOption) (*XAckResponse, error)
+	XPending(ctx context.Context, in *XPendingRequest, opts ...grpc.CallOption) (*XPendingResponse, error)
+	XAutoClaim(ctx context.Context, in *XAutoClaimRequest, opts ...grpc.CallOption) (*XAutoClaimResponse, error)
+	PFAdd(ctx context.Context, in *PFAddRequest, opts ...grpc.CallOption) (*PFAddResponse, error)
+	PFCount(ctx context.Context, in *PFCountRequest, opts ...grpc.CallOption) (*PFCountResponse, error)
+	PFMerge(ctx context.Context, in *PFMergeRequest, opts ...grpc.CallOption) (*PFMergeResponse, error)
+	BFReserve(ctx context.Context, in *BFReserveRequest, opts ...grpc.CallOption) (*BFReserveResponse, error)
+	BFAdd(ctx context.Context, in *BFAddRequest, opts ...grpc.CallOption) (*BFAddResponse, error)
+	BFExists(ctx context.Context, in *BFExistsRequest, opts ...grpc.CallOption) (*BFExistsResponse, error)
+	CMSInitByDim(ctx context.Context, in *CMSInitByDimRequest, opts ...grpc.CallOption) (*CMSInitResponse, error)
+	CMSInitByProb(ctx context.Context, in *CMSInitByProbRequest, opts ...grpc.CallOption) (*CMSInitResponse, error)
+	CMSIncrBy(ctx context.Context, in *CMSIncrByRequest, opts ...grpc.CallOption) (*CMSIncrByResponse, error)
+	CMSQuery(ctx context.Context, in *CMSQueryRequest, opts ...grpc.CallOption) (*CMSQueryResponse, error)
+	MGet(ctx context.Context, in *MGetRequest, opts ...grpc.CallOption) (*MGetResponse, error)
+	MSet(ctx context.Context, in *MSetRequest, opts ...grpc.CallOption) (*MSetResponse, error)
+	MDelete(ctx context.Context, in *MDeleteRequest, opts ...grpc.CallOption) (*MDeleteResponse, error)
 	Connect(ctx context.Context, in *ConnectionRequest, opts ...grpc.CallOption) (*ConnectionResponse, error)
+	Disconnect(ctx context.Context, in *DisconnectRequest, opts ...grpc.CallOption) (*DisconnectResponse, error)
+	Snapshot(ctx context.Context, in *SnapshotRequest, opts ...grpc.CallOption) (*SnapshotResponse, error)
+	RewriteAOF(ctx context.Context, in *RewriteAOFRequest, opts ...grpc.CallOption) (*RewriteAOFResponse, error)
+	Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error)
+	TTL(ctx context.Context, in *TTLRequest, opts ...grpc.CallOption) (*TTLResponse, error)
+	Expire(ctx context.Context, in *ExpireRequest, opts ...grpc.CallOption) (*ExpireResponse, error)
+	Persist(ctx context.Context, in *PersistRequest, opts ...grpc.CallOption) (*PersistResponse, error)
+	ACLSetUser(ctx context.Context, in *ACLSetUserRequest, opts ...grpc.CallOption) (*ACLSetUserResponse, error)
+	ACLDelUser(ctx context.Context, in *ACLDelUserRequest, opts ...grpc.CallOption) (*ACLDelUserResponse, error)
+	ACLList(ctx context.Context, in *ACLListRequest, opts ...grpc.CallOption) (*ACLListResponse, error)
+	ACLLoad(ctx context.Context, in *ACLLoadRequest, opts ...grpc.CallOption) (*ACLLoadResponse, error)
+	ACLSave(ctx context.Context, in *ACLSaveRequest, opts ...grpc.CallOption) (*ACLSaveResponse, error)
 }
 
 type memoraServiceClient struct {
@@ -73,163 +232,2842 @@ func (c *memoraServiceClient) Delete(ctx context.Context, in *DeleteRequest, opt
 	return out, nil
 }
 
-func (c *memoraServiceClient) Connect(ctx context.Context, in *ConnectionRequest, opts ...grpc.CallOption) (*ConnectionResponse, error) {
+func (c *memoraServiceClient) GetSet(ctx context.Context, in *GetSetRequest, opts ...grpc.CallOption) (*GetSetResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ConnectionResponse)
-	err := c.cc.Invoke(ctx, MemoraService_Connect_FullMethodName, in, out, cOpts...)
+	out := new(GetSetResponse)
+	err := c.cc.Invoke(ctx, MemoraService_GetSet_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// MemoraServiceServer is the server API for MemoraService service.
-// All implementations must embed UnimplementedMemoraServiceServer
-// for forward compatibility.
-type MemoraServiceServer interface {
-	Set(context.Context, *SetRequest) (*SetResponse, error)
-	Get(context.Context, *GetRequest) (*GetResponse, error)
-	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
-	Connect(context.Context, *ConnectionRequest) (*ConnectionResponse, error)
-	mustEmbedUnimplementedMemoraServiceServer()
+func (c *memoraServiceClient) GetDel(ctx context.Context, in *GetDelRequest, opts ...grpc.CallOption) (*GetDelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDelResponse)
+	err := c.cc.Invoke(ctx, MemoraService_GetDel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedMemoraServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedMemoraServiceServer struct{}
+func (c *memoraServiceClient) IncrBy(ctx context.Context, in *IncrByRequest, opts ...grpc.CallOption) (*IncrByResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IncrByResponse)
+	err := c.cc.Invoke(ctx, MemoraService_IncrBy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedMemoraServiceServer) Set(context.Context, *SetRequest) (*SetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+func (c *memoraServiceClient) IncrByFloat(ctx context.Context, in *IncrByFloatRequest, opts ...grpc.CallOption) (*IncrByFloatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IncrByFloatResponse)
+	err := c.cc.Invoke(ctx, MemoraService_IncrByFloat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMemoraServiceServer) Get(context.Context, *GetRequest) (*GetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+
+func (c *memoraServiceClient) HSet(ctx context.Context, in *HSetRequest, opts ...grpc.CallOption) (*HSetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HSetResponse)
+	err := c.cc.Invoke(ctx, MemoraService_HSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMemoraServiceServer) Delete(context.Context, *DeleteRequest) (*DeleteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+
+func (c *memoraServiceClient) HGet(ctx context.Context, in *HGetRequest, opts ...grpc.CallOption) (*HGetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HGetResponse)
+	err := c.cc.Invoke(ctx, MemoraService_HGet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMemoraServiceServer) Connect(context.Context, *ConnectionRequest) (*ConnectionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Connect not implemented")
+
+func (c *memoraServiceClient) HGetAll(ctx context.Context, in *HGetAllRequest, opts ...grpc.CallOption) (*HGetAllResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HGetAllResponse)
+	err := c.cc.Invoke(ctx, MemoraService_HGetAll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMemoraServiceServer) mustEmbedUnimplementedMemoraServiceServer() {}
-func (UnimplementedMemoraServiceServer) testEmbeddedByValue()                       {}
 
-// UnsafeMemoraServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to MemoraServiceServer will
-// result in compilation errors.
-type UnsafeMemoraServiceServer interface {
-	mustEmbedUnimplementedMemoraServiceServer()
+func (c *memoraServiceClient) HDel(ctx context.Context, in *HDelRequest, opts ...grpc.CallOption) (*HDelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HDelResponse)
+	err := c.cc.Invoke(ctx, MemoraService_HDel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func RegisterMemoraServiceServer(s grpc.ServiceRegistrar, srv MemoraServiceServer) {
-	// If the following call pancis, it indicates UnimplementedMemoraServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func (c *memoraServiceClient) HIncrBy(ctx context.Context, in *HIncrByRequest, opts ...grpc.CallOption) (*HIncrByResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HIncrByResponse)
+	err := c.cc.Invoke(ctx, MemoraService_HIncrBy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	s.RegisterService(&MemoraService_ServiceDesc, srv)
+	return out, nil
 }
 
-func _MemoraService_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetRequest)
-	if err := dec(in); err != nil {
+func (c *memoraServiceClient) LPush(ctx context.Context, in *PushRequest, opts ...grpc.CallOption) (*PushResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PushResponse)
+	err := c.cc.Invoke(ctx, MemoraService_LPush_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MemoraServiceServer).Set(ctx, in)
+	return out, nil
+}
+
+func (c *memoraServiceClient) RPush(ctx context.Context, in *PushRequest, opts ...grpc.CallOption) (*PushResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PushResponse)
+	err := c.cc.Invoke(ctx, MemoraService_RPush_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: MemoraService_Set_FullMethodName,
+	return out, nil
+}
+
+func (c *memoraServiceClient) LPop(ctx context.Context, in *PopRequest, opts ...grpc.CallOption) (*PopResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PopResponse)
+	err := c.cc.Invoke(ctx, MemoraService_LPop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MemoraServiceServer).Set(ctx, req.(*SetRequest))
+	return out, nil
+}
+
+func (c *memoraServiceClient) RPop(ctx context.Context, in *PopRequest, opts ...grpc.CallOption) (*PopResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PopResponse)
+	err := c.cc.Invoke(ctx, MemoraService_RPop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _MemoraService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRequest)
-	if err := dec(in); err != nil {
+func (c *memoraServiceClient) BLPop(ctx context.Context, in *BlockingPopRequest, opts ...grpc.CallOption) (*BlockingPopResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockingPopResponse)
+	err := c.cc.Invoke(ctx, MemoraService_BLPop_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MemoraServiceServer).Get(ctx, in)
+	return out, nil
+}
+
+func (c *memoraServiceClient) BRPop(ctx context.Context, in *BlockingPopRequest, opts ...grpc.CallOption) (*BlockingPopResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockingPopResponse)
+	err := c.cc.Invoke(ctx, MemoraService_BRPop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: MemoraService_Get_FullMethodName,
+	return out, nil
+}
+
+func (c *memoraServiceClient) LRange(ctx context.Context, in *LRangeRequest, opts ...grpc.CallOption) (*LRangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LRangeResponse)
+	err := c.cc.Invoke(ctx, MemoraService_LRange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MemoraServiceServer).Get(ctx, req.(*GetRequest))
+	return out, nil
+}
+
+func (c *memoraServiceClient) LTrim(ctx context.Context, in *LTrimRequest, opts ...grpc.CallOption) (*LTrimResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LTrimResponse)
+	err := c.cc.Invoke(ctx, MemoraService_LTrim_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _MemoraService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRequest)
-	if err := dec(in); err != nil {
+func (c *memoraServiceClient) LLen(ctx context.Context, in *LLenRequest, opts ...grpc.CallOption) (*LLenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LLenResponse)
+	err := c.cc.Invoke(ctx, MemoraService_LLen_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MemoraServiceServer).Delete(ctx, in)
+	return out, nil
+}
+
+func (c *memoraServiceClient) SAdd(ctx context.Context, in *SAddRequest, opts ...grpc.CallOption) (*SAddResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SAddResponse)
+	err := c.cc.Invoke(ctx, MemoraService_SAdd_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: MemoraService_Delete_FullMethodName,
+	return out, nil
+}
+
+func (c *memoraServiceClient) SRem(ctx context.Context, in *SRemRequest, opts ...grpc.CallOption) (*SRemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SRemResponse)
+	err := c.cc.Invoke(ctx, MemoraService_SRem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MemoraServiceServer).Delete(ctx, req.(*DeleteRequest))
+	return out, nil
+}
+
+func (c *memoraServiceClient) SIsMember(ctx context.Context, in *SIsMemberRequest, opts ...grpc.CallOption) (*SIsMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SIsMemberResponse)
+	err := c.cc.Invoke(ctx, MemoraService_SIsMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _MemoraService_Connect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConnectionRequest)
-	if err := dec(in); err != nil {
+func (c *memoraServiceClient) SCard(ctx context.Context, in *SCardRequest, opts ...grpc.CallOption) (*SCardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SCardResponse)
+	err := c.cc.Invoke(ctx, MemoraService_SCard_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MemoraServiceServer).Connect(ctx, in)
+	return out, nil
+}
+
+func (c *memoraServiceClient) SMembers(ctx context.Context, in *SMembersRequest, opts ...grpc.CallOption) (*SMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SMembersResponse)
+	err := c.cc.Invoke(ctx, MemoraService_SMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: MemoraService_Connect_FullMethodName,
+	return out, nil
+}
+
+func (c *memoraServiceClient) SRandMember(ctx context.Context, in *SRandMemberRequest, opts ...grpc.CallOption) (*SRandMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SRandMemberResponse)
+	err := c.cc.Invoke(ctx, MemoraService_SRandMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MemoraServiceServer).Connect(ctx, req.(*ConnectionRequest))
+	return out, nil
+}
+
+func (c *memoraServiceClient) SInter(ctx context.Context, in *SetAlgebraRequest, opts ...grpc.CallOption) (*SetAlgebraResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAlgebraResponse)
+	err := c.cc.Invoke(ctx, MemoraService_SInter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-// MemoraService_ServiceDesc is the grpc.ServiceDesc for MemoraService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var MemoraService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "memora.MemoraService",
-	HandlerType: (*MemoraServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Set",
-			Handler:    _MemoraService_Set_Handler,
-		},
-		{
-			MethodName: "Get",
-			Handler:    _MemoraService_Get_Handler,
-		},
-		{
-			MethodName: "Delete",
-			Handler:    _MemoraService_Delete_Handler,
-		},
-		{
-			MethodName: "Connect",
-			Handler:    _MemoraService_Connect_Handler,
+func (c *memoraServiceClient) SUnion(ctx context.Context, in *SetAlgebraRequest, opts ...grpc.CallOption) (*SetAlgebraResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAlgebraResponse)
+	err := c.cc.Invoke(ctx, MemoraService_SUnion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) SDiff(ctx context.Context, in *SetAlgebraRequest, opts ...grpc.CallOption) (*SetAlgebraResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAlgebraResponse)
+	err := c.cc.Invoke(ctx, MemoraService_SDiff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) SInterStore(ctx context.Context, in *SetAlgebraStoreRequest, opts ...grpc.CallOption) (*SetAlgebraStoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAlgebraStoreResponse)
+	err := c.cc.Invoke(ctx, MemoraService_SInterStore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) SUnionStore(ctx context.Context, in *SetAlgebraStoreRequest, opts ...grpc.CallOption) (*SetAlgebraStoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAlgebraStoreResponse)
+	err := c.cc.Invoke(ctx, MemoraService_SUnionStore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) SDiffStore(ctx context.Context, in *SetAlgebraStoreRequest, opts ...grpc.CallOption) (*SetAlgebraStoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAlgebraStoreResponse)
+	err := c.cc.Invoke(ctx, MemoraService_SDiffStore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) ZAdd(ctx context.Context, in *ZAddRequest, opts ...grpc.CallOption) (*ZAddResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ZAddResponse)
+	err := c.cc.Invoke(ctx, MemoraService_ZAdd_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) ZIncrBy(ctx context.Context, in *ZIncrByRequest, opts ...grpc.CallOption) (*ZIncrByResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ZIncrByResponse)
+	err := c.cc.Invoke(ctx, MemoraService_ZIncrBy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) ZRem(ctx context.Context, in *ZRemRequest, opts ...grpc.CallOption) (*ZRemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ZRemResponse)
+	err := c.cc.Invoke(ctx, MemoraService_ZRem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) ZScore(ctx context.Context, in *ZScoreRequest, opts ...grpc.CallOption) (*ZScoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ZScoreResponse)
+	err := c.cc.Invoke(ctx, MemoraService_ZScore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) ZCard(ctx context.Context, in *ZCardRequest, opts ...grpc.CallOption) (*ZCardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ZCardResponse)
+	err := c.cc.Invoke(ctx, MemoraService_ZCard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) ZRank(ctx context.Context, in *ZRankRequest, opts ...grpc.CallOption) (*ZRankResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ZRankResponse)
+	err := c.cc.Invoke(ctx, MemoraService_ZRank_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) ZRange(ctx context.Context, in *ZRangeRequest, opts ...grpc.CallOption) (*ZRangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ZRangeResponse)
+	err := c.cc.Invoke(ctx, MemoraService_ZRange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) ZRangeByScore(ctx context.Context, in *ZRangeByScoreRequest, opts ...grpc.CallOption) (*ZRangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ZRangeResponse)
+	err := c.cc.Invoke(ctx, MemoraService_ZRangeByScore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) ZRangeByLex(ctx context.Context, in *ZRangeByLexRequest, opts ...grpc.CallOption) (*ZRangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ZRangeResponse)
+	err := c.cc.Invoke(ctx, MemoraService_ZRangeByLex_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) ZRemRangeByScore(ctx context.Context, in *ZRemRangeByScoreRequest, opts ...grpc.CallOption) (*ZRemRangeByScoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ZRemRangeByScoreResponse)
+	err := c.cc.Invoke(ctx, MemoraService_ZRemRangeByScore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) XAdd(ctx context.Context, in *XAddRequest, opts ...grpc.CallOption) (*XAddResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(XAddResponse)
+	err := c.cc.Invoke(ctx, MemoraService_XAdd_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) XLen(ctx context.Context, in *XLenRequest, opts ...grpc.CallOption) (*XLenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(XLenResponse)
+	err := c.cc.Invoke(ctx, MemoraService_XLen_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) XRange(ctx context.Context, in *XRangeRequest, opts ...grpc.CallOption) (*XRangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(XRangeResponse)
+	err := c.cc.Invoke(ctx, MemoraService_XRange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) XGroupCreate(ctx context.Context, in *XGroupCreateRequest, opts ...grpc.CallOption) (*XGroupCreateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(XGroupCreateResponse)
+	err := c.cc.Invoke(ctx, MemoraService_XGroupCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) XGroupDestroy(ctx context.Context, in *XGroupDestroyRequest, opts ...grpc.CallOption) (*XGroupDestroyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(XGroupDestroyResponse)
+	err := c.cc.Invoke(ctx, MemoraService_XGroupDestroy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) XReadGroup(ctx context.Context, in *XReadGroupRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[XReadGroupResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MemoraService_ServiceDesc.Streams[0], MemoraService_XReadGroup_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[XReadGroupRequest, XReadGroupResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MemoraService_XReadGroupClient = grpc.ServerStreamingClient[XReadGroupResponse]
+
+func (c *memoraServiceClient) XAck(ctx context.Context, in *XAckRequest, opts ...grpc.CallOption) (*XAckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(XAckResponse)
+	err := c.cc.Invoke(ctx, MemoraService_XAck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) XPending(ctx context.Context, in *XPendingRequest, opts ...grpc.CallOption) (*XPendingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(XPendingResponse)
+	err := c.cc.Invoke(ctx, MemoraService_XPending_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) XAutoClaim(ctx context.Context, in *XAutoClaimRequest, opts ...grpc.CallOption) (*XAutoClaimResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(XAutoClaimResponse)
+	err := c.cc.Invoke(ctx, MemoraService_XAutoClaim_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) PFAdd(ctx context.Context, in *PFAddRequest, opts ...grpc.CallOption) (*PFAddResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PFAddResponse)
+	err := c.cc.Invoke(ctx, MemoraService_PFAdd_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) PFCount(ctx context.Context, in *PFCountRequest, opts ...grpc.CallOption) (*PFCountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PFCountResponse)
+	err := c.cc.Invoke(ctx, MemoraService_PFCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) PFMerge(ctx context.Context, in *PFMergeRequest, opts ...grpc.CallOption) (*PFMergeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PFMergeResponse)
+	err := c.cc.Invoke(ctx, MemoraService_PFMerge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) BFReserve(ctx context.Context, in *BFReserveRequest, opts ...grpc.CallOption) (*BFReserveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BFReserveResponse)
+	err := c.cc.Invoke(ctx, MemoraService_BFReserve_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) BFAdd(ctx context.Context, in *BFAddRequest, opts ...grpc.CallOption) (*BFAddResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BFAddResponse)
+	err := c.cc.Invoke(ctx, MemoraService_BFAdd_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) BFExists(ctx context.Context, in *BFExistsRequest, opts ...grpc.CallOption) (*BFExistsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BFExistsResponse)
+	err := c.cc.Invoke(ctx, MemoraService_BFExists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) CMSInitByDim(ctx context.Context, in *CMSInitByDimRequest, opts ...grpc.CallOption) (*CMSInitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CMSInitResponse)
+	err := c.cc.Invoke(ctx, MemoraService_CMSInitByDim_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) CMSInitByProb(ctx context.Context, in *CMSInitByProbRequest, opts ...grpc.CallOption) (*CMSInitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CMSInitResponse)
+	err := c.cc.Invoke(ctx, MemoraService_CMSInitByProb_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) CMSIncrBy(ctx context.Context, in *CMSIncrByRequest, opts ...grpc.CallOption) (*CMSIncrByResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CMSIncrByResponse)
+	err := c.cc.Invoke(ctx, MemoraService_CMSIncrBy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) CMSQuery(ctx context.Context, in *CMSQueryRequest, opts ...grpc.CallOption) (*CMSQueryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CMSQueryResponse)
+	err := c.cc.Invoke(ctx, MemoraService_CMSQuery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) MGet(ctx context.Context, in *MGetRequest, opts ...grpc.CallOption) (*MGetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MGetResponse)
+	err := c.cc.Invoke(ctx, MemoraService_MGet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) MSet(ctx context.Context, in *MSetRequest, opts ...grpc.CallOption) (*MSetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MSetResponse)
+	err := c.cc.Invoke(ctx, MemoraService_MSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) MDelete(ctx context.Context, in *MDeleteRequest, opts ...grpc.CallOption) (*MDeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MDeleteResponse)
+	err := c.cc.Invoke(ctx, MemoraService_MDelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) Connect(ctx context.Context, in *ConnectionRequest, opts ...grpc.CallOption) (*ConnectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConnectionResponse)
+	err := c.cc.Invoke(ctx, MemoraService_Connect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) Disconnect(ctx context.Context, in *DisconnectRequest, opts ...grpc.CallOption) (*DisconnectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisconnectResponse)
+	err := c.cc.Invoke(ctx, MemoraService_Disconnect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) Snapshot(ctx context.Context, in *SnapshotRequest, opts ...grpc.CallOption) (*SnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SnapshotResponse)
+	err := c.cc.Invoke(ctx, MemoraService_Snapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) RewriteAOF(ctx context.Context, in *RewriteAOFRequest, opts ...grpc.CallOption) (*RewriteAOFResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RewriteAOFResponse)
+	err := c.cc.Invoke(ctx, MemoraService_RewriteAOF_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatsResponse)
+	err := c.cc.Invoke(ctx, MemoraService_Stats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) TTL(ctx context.Context, in *TTLRequest, opts ...grpc.CallOption) (*TTLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TTLResponse)
+	err := c.cc.Invoke(ctx, MemoraService_TTL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) Expire(ctx context.Context, in *ExpireRequest, opts ...grpc.CallOption) (*ExpireResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExpireResponse)
+	err := c.cc.Invoke(ctx, MemoraService_Expire_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) Persist(ctx context.Context, in *PersistRequest, opts ...grpc.CallOption) (*PersistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PersistResponse)
+	err := c.cc.Invoke(ctx, MemoraService_Persist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) ACLSetUser(ctx context.Context, in *ACLSetUserRequest, opts ...grpc.CallOption) (*ACLSetUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ACLSetUserResponse)
+	err := c.cc.Invoke(ctx, MemoraService_ACLSetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) ACLDelUser(ctx context.Context, in *ACLDelUserRequest, opts ...grpc.CallOption) (*ACLDelUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ACLDelUserResponse)
+	err := c.cc.Invoke(ctx, MemoraService_ACLDelUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) ACLList(ctx context.Context, in *ACLListRequest, opts ...grpc.CallOption) (*ACLListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ACLListResponse)
+	err := c.cc.Invoke(ctx, MemoraService_ACLList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) ACLLoad(ctx context.Context, in *ACLLoadRequest, opts ...grpc.CallOption) (*ACLLoadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ACLLoadResponse)
+	err := c.cc.Invoke(ctx, MemoraService_ACLLoad_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoraServiceClient) ACLSave(ctx context.Context, in *ACLSaveRequest, opts ...grpc.CallOption) (*ACLSaveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ACLSaveResponse)
+	err := c.cc.Invoke(ctx, MemoraService_ACLSave_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MemoraServiceServer is the server API for MemoraService service.
+// All implementations must embed UnimplementedMemoraServiceServer
+// for forward compatibility.
+//
+// Every rpc but Connect is authenticated with the client key returned by Connect, sent as the
+// "authorization: Bearer <clientKey>" metadata. The deprecated clientKey request fields are only
+// read when the metadata is missing.
+//
+// Failed requests return a canonical status code, e.g. NOT_FOUND for a missing key, UNAUTHENTICATED
+// for an unknown or expired client key or PERMISSION_DENIED for a request the ACL rules deny, with a
+// google.rpc.ErrorInfo detail in the "memora" domain whose reason tells errors sharing a code apart.
+// The success and found fields of the responses are true whenever no error is returned. Commands
+// on a key holding another type of value, e.g. HGet on a string, fail with FAILED_PRECONDITION and
+// reason WRONG_TYPE.
+type MemoraServiceServer interface {
+	Set(context.Context, *SetRequest) (*SetResponse, error)
+	Get(context.Context, *GetRequest) (*GetResponse, error)
+	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+	GetSet(context.Context, *GetSetRequest) (*GetSetResponse, error)
+	GetDel(context.Context, *GetDelRequest) (*GetDelResponse, error)
+	IncrBy(context.Context, *IncrByRequest) (*IncrByResponse, error)
+	IncrByFloat(context.Context, *IncrByFloatRequest) (*IncrByFloatResponse, error)
+	HSet(context.Context, *HSetRequest) (*HSetResponse, error)
+	HGet(context.Context, *HGetRequest) (*HGetResponse, error)
+	HGetAll(context.Context, *HGetAllRequest) (*HGetAllResponse, error)
+	HDel(context.Context, *HDelRequest) (*HDelResponse, error)
+	HIncrBy(context.Context, *HIncrByRequest) (*HIncrByResponse, error)
+	LPush(context.Context, *PushRequest) (*PushResponse, error)
+	RPush(context.Context, *PushRequest) (*PushResponse, error)
+	LPop(context.Context, *PopRequest) (*PopResponse, error)
+	RPop(context.Context, *PopRequest) (*PopResponse, error)
+	BLPop(context.Context, *BlockingPopRequest) (*BlockingPopResponse, error)
+	BRPop(context.Context, *BlockingPopRequest) (*BlockingPopResponse, error)
+	LRange(context.Context, *LRangeRequest) (*LRangeResponse, error)
+	LTrim(context.Context, *LTrimRequest) (*LTrimResponse, error)
+	LLen(context.Context, *LLenRequest) (*LLenResponse, error)
+	SAdd(context.Context, *SAddRequest) (*SAddResponse, error)
+	SRem(context.Context, *SRemRequest) (*SRemResponse, error)
+	SIsMember(context.Context, *SIsMemberRequest) (*SIsMemberResponse, error)
+	SCard(context.Context, *SCardRequest) (*SCardResponse, error)
+	SMembers(context.Context, *SMembersRequest) (*SMembersResponse, error)
+	SRandMember(context.Context, *SRandMemberRequest) (*SRandMemberResponse, error)
+	SInter(context.Context, *SetAlgebraRequest) (*SetAlgebraResponse, error)
+	SUnion(context.Context, *SetAlgebraRequest) (*SetAlgebraResponse, error)
+	SDiff(context.Context, *SetAlgebraRequest) (*SetAlgebraResponse, error)
+	SInterStore(context.Context, *SetAlgebraStoreRequest) (*SetAlgebraStoreResponse, error)
+	SUnionStore(context.Context, *SetAlgebraStoreRequest) (*SetAlgebraStoreResponse, error)
+	SDiffStore(context.Context, *SetAlgebraStoreRequest) (*SetAlgebraStoreResponse, error)
+	ZAdd(context.Context, *ZAddRequest) (*ZAddResponse, error)
+	ZIncrBy(context.Context, *ZIncrByRequest) (*ZIncrByResponse, error)
+	ZRem(context.Context, *ZRemRequest) (*ZRemResponse, error)
+	ZScore(context.Context, *ZScoreRequest) (*ZScoreResponse, error)
+	ZCard(context.Context, *ZCardRequest) (*ZCardResponse, error)
+	ZRank(context.Context, *ZRankRequest) (*ZRankResponse, error)
+	ZRange(context.Context, *ZRangeRequest) (*ZRangeResponse, error)
+	ZRangeByScore(context.Context, *ZRangeByScoreRequest) (*ZRangeResponse, error)
+	ZRangeByLex(context.Context, *ZRangeByLexRequest) (*ZRangeResponse, error)
+	ZRemRangeByScore(context.Context, *ZRemRangeByScoreRequest) (*ZRemRangeByScoreResponse, error)
+	XAdd(context.Context, *XAddRequest) (*XAddResponse, error)
+	XLen(context.Context, *XLenRequest) (*XLenResponse, error)
+	XRange(context.Context, *XRangeRequest) (*XRangeResponse, error)
+	XGroupCreate(context.Context, *XGroupCreateRequest) (*XGroupCreateResponse, error)
+	XGroupDestroy(context.Context, *XGroupDestroyRequest) (*XGroupDestroyResponse, error)
+	XReadGroup(*XReadGroupRequest, grpc.ServerStreamingServer[XReadGroupResponse]) error
+	XAck(context.Context, *XAckRequest) (*XAckResponse, error)
+	XPending(context.Context, *XPendingRequest) (*XPendingResponse, error)
+	XAutoClaim(context.Context, *XAutoClaimRequest) (*XAutoClaimResponse, error)
+	PFAdd(context.Context, *PFAddRequest) (*PFAddResponse, error)
+	PFCount(context.Context, *PFCountRequest) (*PFCountResponse, error)
+	PFMerge(context.Context, *PFMergeRequest) (*PFMergeResponse, error)
+	BFReserve(context.Context, *BFReserveRequest) (*BFReserveResponse, error)
+	BFAdd(context.Context, *BFAddRequest) (*BFAddResponse, error)
+	BFExists(context.Context, *BFExistsRequest) (*BFExistsResponse, error)
+	CMSInitByDim(context.Context, *CMSInitByDimRequest) (*CMSInitResponse, error)
+	CMSInitByProb(context.Context, *CMSInitByProbRequest) (*CMSInitResponse, error)
+	CMSIncrBy(context.Context, *CMSIncrByRequest) (*CMSIncrByResponse, error)
+	CMSQuery(context.Context, *CMSQueryRequest) (*CMSQueryResponse, error)
+	MGet(context.Context, *MGetRequest) (*MGetResponse, error)
+	MSet(context.Context, *MSetRequest) (*MSetResponse, error)
+	MDelete(context.Context, *MDeleteRequest) (*MDeleteResponse, error)
+	Connect(context.Context, *ConnectionRequest) (*ConnectionResponse, error)
+	Disconnect(context.Context, *DisconnectRequest) (*DisconnectResponse, error)
+	Snapshot(context.Context, *SnapshotRequest) (*SnapshotResponse, error)
+	RewriteAOF(context.Context, *RewriteAOFRequest) (*RewriteAOFResponse, error)
+	Stats(context.Context, *StatsRequest) (*StatsResponse, error)
+	TTL(context.Context, *TTLRequest) (*TTLResponse, error)
+	Expire(context.Context, *ExpireRequest) (*ExpireResponse, error)
+	Persist(context.Context, *PersistRequest) (*PersistResponse, error)
+	ACLSetUser(context.Context, *ACLSetUserRequest) (*ACLSetUserResponse, error)
+	ACLDelUser(context.Context, *ACLDelUserRequest) (*ACLDelUserResponse, error)
+	ACLList(context.Context, *ACLListRequest) (*ACLListResponse, error)
+	ACLLoad(context.Context, *ACLLoadRequest) (*ACLLoadResponse, error)
+	ACLSave(context.Context, *ACLSaveRequest) (*ACLSaveResponse, error)
+	mustEmbedUnimplementedMemoraServiceServer()
+}
+
+// UnimplementedMemoraServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMemoraServiceServer struct{}
+
+func (UnimplementedMemoraServiceServer) Set(context.Context, *SetRequest) (*SetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+}
+func (UnimplementedMemoraServiceServer) Get(context.Context, *GetRequest) (*GetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedMemoraServiceServer) Delete(context.Context, *DeleteRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedMemoraServiceServer) GetSet(context.Context, *GetSetRequest) (*GetSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSet not implemented")
+}
+func (UnimplementedMemoraServiceServer) GetDel(context.Context, *GetDelRequest) (*GetDelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDel not implemented")
+}
+func (UnimplementedMemoraServiceServer) IncrBy(context.Context, *IncrByRequest) (*IncrByResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncrBy not implemented")
+}
+func (UnimplementedMemoraServiceServer) IncrByFloat(context.Context, *IncrByFloatRequest) (*IncrByFloatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncrByFloat not implemented")
+}
+func (UnimplementedMemoraServiceServer) HSet(context.Context, *HSetRequest) (*HSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HSet not implemented")
+}
+func (UnimplementedMemoraServiceServer) HGet(context.Context, *HGetRequest) (*HGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HGet not implemented")
+}
+func (UnimplementedMemoraServiceServer) HGetAll(context.Context, *HGetAllRequest) (*HGetAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HGetAll not implemented")
+}
+func (UnimplementedMemoraServiceServer) HDel(context.Context, *HDelRequest) (*HDelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HDel not implemented")
+}
+func (UnimplementedMemoraServiceServer) HIncrBy(context.Context, *HIncrByRequest) (*HIncrByResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HIncrBy not implemented")
+}
+func (UnimplementedMemoraServiceServer) LPush(context.Context, *PushRequest) (*PushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LPush not implemented")
+}
+func (UnimplementedMemoraServiceServer) RPush(context.Context, *PushRequest) (*PushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RPush not implemented")
+}
+func (UnimplementedMemoraServiceServer) LPop(context.Context, *PopRequest) (*PopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LPop not implemented")
+}
+func (UnimplementedMemoraServiceServer) RPop(context.Context, *PopRequest) (*PopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RPop not implemented")
+}
+func (UnimplementedMemoraServiceServer) BLPop(context.Context, *BlockingPopRequest) (*BlockingPopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BLPop not implemented")
+}
+func (UnimplementedMemoraServiceServer) BRPop(context.Context, *BlockingPopRequest) (*BlockingPopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BRPop not implemented")
+}
+func (UnimplementedMemoraServiceServer) LRange(context.Context, *LRangeRequest) (*LRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LRange not implemented")
+}
+func (UnimplementedMemoraServiceServer) LTrim(context.Context, *LTrimRequest) (*LTrimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LTrim not implemented")
+}
+func (UnimplementedMemoraServiceServer) LLen(context.Context, *LLenRequest) (*LLenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LLen not implemented")
+}
+func (UnimplementedMemoraServiceServer) SAdd(context.Context, *SAddRequest) (*SAddResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SAdd not implemented")
+}
+func (UnimplementedMemoraServiceServer) SRem(context.Context, *SRemRequest) (*SRemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SRem not implemented")
+}
+func (UnimplementedMemoraServiceServer) SIsMember(context.Context, *SIsMemberRequest) (*SIsMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SIsMember not implemented")
+}
+func (UnimplementedMemoraServiceServer) SCard(context.Context, *SCardRequest) (*SCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SCard not implemented")
+}
+func (UnimplementedMemoraServiceServer) SMembers(context.Context, *SMembersRequest) (*SMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SMembers not implemented")
+}
+func (UnimplementedMemoraServiceServer) SRandMember(context.Context, *SRandMemberRequest) (*SRandMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SRandMember not implemented")
+}
+func (UnimplementedMemoraServiceServer) SInter(context.Context, *SetAlgebraRequest) (*SetAlgebraResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SInter not implemented")
+}
+func (UnimplementedMemoraServiceServer) SUnion(context.Context, *SetAlgebraRequest) (*SetAlgebraResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SUnion not implemented")
+}
+func (UnimplementedMemoraServiceServer) SDiff(context.Context, *SetAlgebraRequest) (*SetAlgebraResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SDiff not implemented")
+}
+func (UnimplementedMemoraServiceServer) SInterStore(context.Context, *SetAlgebraStoreRequest) (*SetAlgebraStoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SInterStore not implemented")
+}
+func (UnimplementedMemoraServiceServer) SUnionStore(context.Context, *SetAlgebraStoreRequest) (*SetAlgebraStoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SUnionStore not implemented")
+}
+func (UnimplementedMemoraServiceServer) SDiffStore(context.Context, *SetAlgebraStoreRequest) (*SetAlgebraStoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SDiffStore not implemented")
+}
+func (UnimplementedMemoraServiceServer) ZAdd(context.Context, *ZAddRequest) (*ZAddResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZAdd not implemented")
+}
+func (UnimplementedMemoraServiceServer) ZIncrBy(context.Context, *ZIncrByRequest) (*ZIncrByResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZIncrBy not implemented")
+}
+func (UnimplementedMemoraServiceServer) ZRem(context.Context, *ZRemRequest) (*ZRemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZRem not implemented")
+}
+func (UnimplementedMemoraServiceServer) ZScore(context.Context, *ZScoreRequest) (*ZScoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZScore not implemented")
+}
+func (UnimplementedMemoraServiceServer) ZCard(context.Context, *ZCardRequest) (*ZCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZCard not implemented")
+}
+func (UnimplementedMemoraServiceServer) ZRank(context.Context, *ZRankRequest) (*ZRankResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZRank not implemented")
+}
+func (UnimplementedMemoraServiceServer) ZRange(context.Context, *ZRangeRequest) (*ZRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZRange not implemented")
+}
+func (UnimplementedMemoraServiceServer) ZRangeByScore(context.Context, *ZRangeByScoreRequest) (*ZRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZRangeByScore not implemented")
+}
+func (UnimplementedMemoraServiceServer) ZRangeByLex(context.Context, *ZRangeByLexRequest) (*ZRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZRangeByLex not implemented")
+}
+func (UnimplementedMemoraServiceServer) ZRemRangeByScore(context.Context, *ZRemRangeByScoreRequest) (*ZRemRangeByScoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZRemRangeByScore not implemented")
+}
+func (UnimplementedMemoraServiceServer) XAdd(context.Context, *XAddRequest) (*XAddResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XAdd not implemented")
+}
+func (UnimplementedMemoraServiceServer) XLen(context.Context, *XLenRequest) (*XLenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XLen not implemented")
+}
+func (UnimplementedMemoraServiceServer) XRange(context.Context, *XRangeRequest) (*XRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XRange not implemented")
+}
+func (UnimplementedMemoraServiceServer) XGroupCreate(context.Context, *XGroupCreateRequest) (*XGroupCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XGroupCreate not implemented")
+}
+func (UnimplementedMemoraServiceServer) XGroupDestroy(context.Context, *XGroupDestroyRequest) (*XGroupDestroyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XGroupDestroy not implemented")
+}
+func (UnimplementedMemoraServiceServer) XReadGroup(*XReadGroupRequest, grpc.ServerStreamingServer[XReadGroupResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method XReadGroup not implemented")
+}
+func (UnimplementedMemoraServiceServer) XAck(context.Context, *XAckRequest) (*XAckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XAck not implemented")
+}
+func (UnimplementedMemoraServiceServer) XPending(context.Context, *XPendingRequest) (*XPendingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XPending not implemented")
+}
+func (UnimplementedMemoraServiceServer) XAutoClaim(context.Context, *XAutoClaimRequest) (*XAutoClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XAutoClaim not implemented")
+}
+func (UnimplementedMemoraServiceServer) PFAdd(context.Context, *PFAddRequest) (*PFAddResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PFAdd not implemented")
+}
+func (UnimplementedMemoraServiceServer) PFCount(context.Context, *PFCountRequest) (*PFCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PFCount not implemented")
+}
+func (UnimplementedMemoraServiceServer) PFMerge(context.Context, *PFMergeRequest) (*PFMergeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PFMerge not implemented")
+}
+func (UnimplementedMemoraServiceServer) BFReserve(context.Context, *BFReserveRequest) (*BFReserveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BFReserve not implemented")
+}
+func (UnimplementedMemoraServiceServer) BFAdd(context.Context, *BFAddRequest) (*BFAddResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BFAdd not implemented")
+}
+func (UnimplementedMemoraServiceServer) BFExists(context.Context, *BFExistsRequest) (*BFExistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BFExists not implemented")
+}
+func (UnimplementedMemoraServiceServer) CMSInitByDim(context.Context, *CMSInitByDimRequest) (*CMSInitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CMSInitByDim not implemented")
+}
+func (UnimplementedMemoraServiceServer) CMSInitByProb(context.Context, *CMSInitByProbRequest) (*CMSInitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CMSInitByProb not implemented")
+}
+func (UnimplementedMemoraServiceServer) CMSIncrBy(context.Context, *CMSIncrByRequest) (*CMSIncrByResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CMSIncrBy not implemented")
+}
+func (UnimplementedMemoraServiceServer) CMSQuery(context.Context, *CMSQueryRequest) (*CMSQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CMSQuery not implemented")
+}
+func (UnimplementedMemoraServiceServer) MGet(context.Context, *MGetRequest) (*MGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MGet not implemented")
+}
+func (UnimplementedMemoraServiceServer) MSet(context.Context, *MSetRequest) (*MSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MSet not implemented")
+}
+func (UnimplementedMemoraServiceServer) MDelete(context.Context, *MDeleteRequest) (*MDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MDelete not implemented")
+}
+func (UnimplementedMemoraServiceServer) Connect(context.Context, *ConnectionRequest) (*ConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Connect not implemented")
+}
+func (UnimplementedMemoraServiceServer) Disconnect(context.Context, *DisconnectRequest) (*DisconnectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Disconnect not implemented")
+}
+func (UnimplementedMemoraServiceServer) Snapshot(context.Context, *SnapshotRequest) (*SnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Snapshot not implemented")
+}
+func (UnimplementedMemoraServiceServer) RewriteAOF(context.Context, *RewriteAOFRequest) (*RewriteAOFResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewriteAOF not implemented")
+}
+func (UnimplementedMemoraServiceServer) Stats(context.Context, *StatsRequest) (*StatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
+func (UnimplementedMemoraServiceServer) TTL(context.Context, *TTLRequest) (*TTLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TTL not implemented")
+}
+func (UnimplementedMemoraServiceServer) Expire(context.Context, *ExpireRequest) (*ExpireResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Expire not implemented")
+}
+func (UnimplementedMemoraServiceServer) Persist(context.Context, *PersistRequest) (*PersistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Persist not implemented")
+}
+func (UnimplementedMemoraServiceServer) ACLSetUser(context.Context, *ACLSetUserRequest) (*ACLSetUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ACLSetUser not implemented")
+}
+func (UnimplementedMemoraServiceServer) ACLDelUser(context.Context, *ACLDelUserRequest) (*ACLDelUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ACLDelUser not implemented")
+}
+func (UnimplementedMemoraServiceServer) ACLList(context.Context, *ACLListRequest) (*ACLListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ACLList not implemented")
+}
+func (UnimplementedMemoraServiceServer) ACLLoad(context.Context, *ACLLoadRequest) (*ACLLoadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ACLLoad not implemented")
+}
+func (UnimplementedMemoraServiceServer) ACLSave(context.Context, *ACLSaveRequest) (*ACLSaveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ACLSave not implemented")
+}
+func (UnimplementedMemoraServiceServer) mustEmbedUnimplementedMemoraServiceServer() {}
+func (UnimplementedMemoraServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeMemoraServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MemoraServiceServer will
+// result in compilation errors.
+type UnsafeMemoraServiceServer interface {
+	mustEmbedUnimplementedMemoraServiceServer()
+}
+
+func RegisterMemoraServiceServer(s grpc.ServiceRegistrar, srv MemoraServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMemoraServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MemoraService_ServiceDesc, srv)
+}
+
+func _MemoraService_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).Set(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_Set_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).Set(ctx, req.(*SetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_GetSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).GetSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_GetSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).GetSet(ctx, req.(*GetSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_GetDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).GetDel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_GetDel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).GetDel(ctx, req.(*GetDelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_IncrBy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncrByRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).IncrBy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_IncrBy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).IncrBy(ctx, req.(*IncrByRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_IncrByFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncrByFloatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).IncrByFloat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_IncrByFloat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).IncrByFloat(ctx, req.(*IncrByFloatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_HSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).HSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_HSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).HSet(ctx, req.(*HSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_HGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).HGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_HGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).HGet(ctx, req.(*HGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_HGetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HGetAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).HGetAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_HGetAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).HGetAll(ctx, req.(*HGetAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_HDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HDelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).HDel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_HDel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).HDel(ctx, req.(*HDelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_HIncrBy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HIncrByRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).HIncrBy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_HIncrBy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).HIncrBy(ctx, req.(*HIncrByRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_LPush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).LPush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_LPush_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).LPush(ctx, req.(*PushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_RPush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).RPush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_RPush_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).RPush(ctx, req.(*PushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_LPop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).LPop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_LPop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).LPop(ctx, req.(*PopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_RPop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).RPop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_RPop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).RPop(ctx, req.(*PopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_BLPop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockingPopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).BLPop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_BLPop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).BLPop(ctx, req.(*BlockingPopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_BRPop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockingPopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).BRPop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_BRPop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).BRPop(ctx, req.(*BlockingPopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_LRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).LRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_LRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).LRange(ctx, req.(*LRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_LTrim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LTrimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).LTrim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_LTrim_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).LTrim(ctx, req.(*LTrimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_LLen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LLenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).LLen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_LLen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).LLen(ctx, req.(*LLenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_SAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).SAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_SAdd_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).SAdd(ctx, req.(*SAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_SRem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SRemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).SRem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_SRem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).SRem(ctx, req.(*SRemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_SIsMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SIsMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).SIsMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_SIsMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).SIsMember(ctx, req.(*SIsMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_SCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).SCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_SCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).SCard(ctx, req.(*SCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_SMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).SMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_SMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).SMembers(ctx, req.(*SMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_SRandMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SRandMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).SRandMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_SRandMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).SRandMember(ctx, req.(*SRandMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_SInter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAlgebraRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).SInter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_SInter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).SInter(ctx, req.(*SetAlgebraRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_SUnion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAlgebraRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).SUnion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_SUnion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).SUnion(ctx, req.(*SetAlgebraRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_SDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAlgebraRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).SDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_SDiff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).SDiff(ctx, req.(*SetAlgebraRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_SInterStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAlgebraStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).SInterStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_SInterStore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).SInterStore(ctx, req.(*SetAlgebraStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_SUnionStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAlgebraStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).SUnionStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_SUnionStore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).SUnionStore(ctx, req.(*SetAlgebraStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_SDiffStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAlgebraStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).SDiffStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_SDiffStore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).SDiffStore(ctx, req.(*SetAlgebraStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_ZAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ZAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).ZAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_ZAdd_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).ZAdd(ctx, req.(*ZAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_ZIncrBy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ZIncrByRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).ZIncrBy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_ZIncrBy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).ZIncrBy(ctx, req.(*ZIncrByRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_ZRem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ZRemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).ZRem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_ZRem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).ZRem(ctx, req.(*ZRemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_ZScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ZScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).ZScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_ZScore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).ZScore(ctx, req.(*ZScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_ZCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ZCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).ZCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_ZCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).ZCard(ctx, req.(*ZCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_ZRank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ZRankRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).ZRank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_ZRank_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).ZRank(ctx, req.(*ZRankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_ZRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ZRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).ZRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_ZRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).ZRange(ctx, req.(*ZRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_ZRangeByScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ZRangeByScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).ZRangeByScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_ZRangeByScore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).ZRangeByScore(ctx, req.(*ZRangeByScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_ZRangeByLex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ZRangeByLexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).ZRangeByLex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_ZRangeByLex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).ZRangeByLex(ctx, req.(*ZRangeByLexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_ZRemRangeByScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ZRemRangeByScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).ZRemRangeByScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_ZRemRangeByScore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).ZRemRangeByScore(ctx, req.(*ZRemRangeByScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_XAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(XAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).XAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_XAdd_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).XAdd(ctx, req.(*XAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_XLen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(XLenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).XLen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_XLen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).XLen(ctx, req.(*XLenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_XRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(XRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).XRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_XRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).XRange(ctx, req.(*XRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_XGroupCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(XGroupCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).XGroupCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_XGroupCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).XGroupCreate(ctx, req.(*XGroupCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_XGroupDestroy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(XGroupDestroyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).XGroupDestroy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_XGroupDestroy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).XGroupDestroy(ctx, req.(*XGroupDestroyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_XReadGroup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(XReadGroupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MemoraServiceServer).XReadGroup(m, &grpc.GenericServerStream[XReadGroupRequest, XReadGroupResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MemoraService_XReadGroupServer = grpc.ServerStreamingServer[XReadGroupResponse]
+
+func _MemoraService_XAck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(XAckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).XAck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_XAck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).XAck(ctx, req.(*XAckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_XPending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(XPendingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).XPending(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_XPending_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).XPending(ctx, req.(*XPendingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_XAutoClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(XAutoClaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).XAutoClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_XAutoClaim_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).XAutoClaim(ctx, req.(*XAutoClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_PFAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PFAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).PFAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_PFAdd_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).PFAdd(ctx, req.(*PFAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_PFCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PFCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).PFCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_PFCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).PFCount(ctx, req.(*PFCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_PFMerge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PFMergeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).PFMerge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_PFMerge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).PFMerge(ctx, req.(*PFMergeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_BFReserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BFReserveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).BFReserve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_BFReserve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).BFReserve(ctx, req.(*BFReserveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_BFAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BFAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).BFAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_BFAdd_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).BFAdd(ctx, req.(*BFAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_BFExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BFExistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).BFExists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_BFExists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).BFExists(ctx, req.(*BFExistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_CMSInitByDim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CMSInitByDimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).CMSInitByDim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_CMSInitByDim_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).CMSInitByDim(ctx, req.(*CMSInitByDimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_CMSInitByProb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CMSInitByProbRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).CMSInitByProb(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_CMSInitByProb_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).CMSInitByProb(ctx, req.(*CMSInitByProbRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_CMSIncrBy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CMSIncrByRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).CMSIncrBy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_CMSIncrBy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).CMSIncrBy(ctx, req.(*CMSIncrByRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_CMSQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CMSQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).CMSQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_CMSQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).CMSQuery(ctx, req.(*CMSQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_MGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).MGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_MGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).MGet(ctx, req.(*MGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_MSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).MSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_MSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).MSet(ctx, req.(*MSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_MDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).MDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_MDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).MDelete(ctx, req.(*MDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_Connect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).Connect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_Connect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).Connect(ctx, req.(*ConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_Disconnect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisconnectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).Disconnect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_Disconnect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).Disconnect(ctx, req.(*DisconnectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_Snapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).Snapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_Snapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).Snapshot(ctx, req.(*SnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_RewriteAOF_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RewriteAOFRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).RewriteAOF(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_RewriteAOF_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).RewriteAOF(ctx, req.(*RewriteAOFRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_Stats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).Stats(ctx, req.(*StatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_TTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TTLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).TTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_TTL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).TTL(ctx, req.(*TTLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_Expire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpireRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).Expire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_Expire_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).Expire(ctx, req.(*ExpireRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_Persist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PersistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).Persist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_Persist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).Persist(ctx, req.(*PersistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_ACLSetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ACLSetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).ACLSetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_ACLSetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).ACLSetUser(ctx, req.(*ACLSetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_ACLDelUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ACLDelUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).ACLDelUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_ACLDelUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).ACLDelUser(ctx, req.(*ACLDelUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_ACLList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ACLListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).ACLList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_ACLList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).ACLList(ctx, req.(*ACLListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_ACLLoad_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ACLLoadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).ACLLoad(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_ACLLoad_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).ACLLoad(ctx, req.(*ACLLoadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoraService_ACLSave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ACLSaveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoraServiceServer).ACLSave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoraService_ACLSave_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoraServiceServer).ACLSave(ctx, req.(*ACLSaveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MemoraService_ServiceDesc is the grpc.ServiceDesc for MemoraService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MemoraService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "memora.MemoraService",
+	HandlerType: (*MemoraServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Set",
+			Handler:    _MemoraService_Set_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _MemoraService_Get_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _MemoraService_Delete_Handler,
+		},
+		{
+			MethodName: "GetSet",
+			Handler:    _MemoraService_GetSet_Handler,
+		},
+		{
+			MethodName: "GetDel",
+			Handler:    _MemoraService_GetDel_Handler,
+		},
+		{
+			MethodName: "IncrBy",
+			Handler:    _MemoraService_IncrBy_Handler,
+		},
+		{
+			MethodName: "IncrByFloat",
+			Handler:    _MemoraService_IncrByFloat_Handler,
+		},
+		{
+			MethodName: "HSet",
+			Handler:    _MemoraService_HSet_Handler,
+		},
+		{
+			MethodName: "HGet",
+			Handler:    _MemoraService_HGet_Handler,
+		},
+		{
+			MethodName: "HGetAll",
+			Handler:    _MemoraService_HGetAll_Handler,
+		},
+		{
+			MethodName: "HDel",
+			Handler:    _MemoraService_HDel_Handler,
+		},
+		{
+			MethodName: "HIncrBy",
+			Handler:    _MemoraService_HIncrBy_Handler,
+		},
+		{
+			MethodName: "LPush",
+			Handler:    _MemoraService_LPush_Handler,
+		},
+		{
+			MethodName: "RPush",
+			Handler:    _MemoraService_RPush_Handler,
+		},
+		{
+			MethodName: "LPop",
+			Handler:    _MemoraService_LPop_Handler,
+		},
+		{
+			MethodName: "RPop",
+			Handler:    _MemoraService_RPop_Handler,
+		},
+		{
+			MethodName: "BLPop",
+			Handler:    _MemoraService_BLPop_Handler,
+		},
+		{
+			MethodName: "BRPop",
+			Handler:    _MemoraService_BRPop_Handler,
+		},
+		{
+			MethodName: "LRange",
+			Handler:    _MemoraService_LRange_Handler,
+		},
+		{
+			MethodName: "LTrim",
+			Handler:    _MemoraService_LTrim_Handler,
+		},
+		{
+			MethodName: "LLen",
+			Handler:    _MemoraService_LLen_Handler,
+		},
+		{
+			MethodName: "SAdd",
+			Handler:    _MemoraService_SAdd_Handler,
+		},
+		{
+			MethodName: "SRem",
+			Handler:    _MemoraService_SRem_Handler,
+		},
+		{
+			MethodName: "SIsMember",
+			Handler:    _MemoraService_SIsMember_Handler,
+		},
+		{
+			MethodName: "SCard",
+			Handler:    _MemoraService_SCard_Handler,
+		},
+		{
+			MethodName: "SMembers",
+			Handler:    _MemoraService_SMembers_Handler,
+		},
+		{
+			MethodName: "SRandMember",
+			Handler:    _MemoraService_SRandMember_Handler,
+		},
+		{
+			MethodName: "SInter",
+			Handler:    _MemoraService_SInter_Handler,
+		},
+		{
+			MethodName: "SUnion",
+			Handler:    _MemoraService_SUnion_Handler,
+		},
+		{
+			MethodName: "SDiff",
+			Handler:    _MemoraService_SDiff_Handler,
+		},
+		{
+			MethodName: "SInterStore",
+			Handler:    _MemoraService_SInterStore_Handler,
+		},
+		{
+			MethodName: "SUnionStore",
+			Handler:    _MemoraService_SUnionStore_Handler,
+		},
+		{
+			MethodName: "SDiffStore",
+			Handler:    _MemoraService_SDiffStore_Handler,
+		},
+		{
+			MethodName: "ZAdd",
+			Handler:    _MemoraService_ZAdd_Handler,
+		},
+		{
+			MethodName: "ZIncrBy",
+			Handler:    _MemoraService_ZIncrBy_Handler,
+		},
+		{
+			MethodName: "ZRem",
+			Handler:    _MemoraService_ZRem_Handler,
+		},
+		{
+			MethodName: "ZScore",
+			Handler:    _MemoraService_ZScore_Handler,
+		},
+		{
+			MethodName: "ZCard",
+			Handler:    _MemoraService_ZCard_Handler,
+		},
+		{
+			MethodName: "ZRank",
+			Handler:    _MemoraService_ZRank_Handler,
+		},
+		{
+			MethodName: "ZRange",
+			Handler:    _MemoraService_ZRange_Handler,
+		},
+		{
+			MethodName: "ZRangeByScore",
+			Handler:    _MemoraService_ZRangeByScore_Handler,
+		},
+		{
+			MethodName: "ZRangeByLex",
+			Handler:    _MemoraService_ZRangeByLex_Handler,
+		},
+		{
+			MethodName: "ZRemRangeByScore",
+			Handler:    _MemoraService_ZRemRangeByScore_Handler,
+		},
+		{
+			MethodName: "XAdd",
+			Handler:    _MemoraService_XAdd_Handler,
+		},
+		{
+			MethodName: "XLen",
+			Handler:    _MemoraService_XLen_Handler,
+		},
+		{
+			MethodName: "XRange",
+			Handler:    _MemoraService_XRange_Handler,
+		},
+		{
+			MethodName: "XGroupCreate",
+			Handler:    _MemoraService_XGroupCreate_Handler,
+		},
+		{
+			MethodName: "XGroupDestroy",
+			Handler:    _MemoraService_XGroupDestroy_Handler,
+		},
+		{
+			MethodName: "XAck",
+			Handler:    _MemoraService_XAck_Handler,
+		},
+		{
+			MethodName: "XPending",
+			Handler:    _MemoraService_XPending_Handler,
+		},
+		{
+			MethodName: "XAutoClaim",
+			Handler:    _MemoraService_XAutoClaim_Handler,
+		},
+		{
+			MethodName: "PFAdd",
+			Handler:    _MemoraService_PFAdd_Handler,
+		},
+		{
+			MethodName: "PFCount",
+			Handler:    _MemoraService_PFCount_Handler,
+		},
+		{
+			MethodName: "PFMerge",
+			Handler:    _MemoraService_PFMerge_Handler,
+		},
+		{
+			MethodName: "BFReserve",
+			Handler:    _MemoraService_BFReserve_Handler,
+		},
+		{
+			MethodName: "BFAdd",
+			Handler:    _MemoraService_BFAdd_Handler,
+		},
+		{
+			MethodName: "BFExists",
+			Handler:    _MemoraService_BFExists_Handler,
+		},
+		{
+			MethodName: "CMSInitByDim",
+			Handler:    _MemoraService_CMSInitByDim_Handler,
+		},
+		{
+			MethodName: "CMSInitByProb",
+			Handler:    _MemoraService_CMSInitByProb_Handler,
+		},
+		{
+			MethodName: "CMSIncrBy",
+			Handler:    _MemoraService_CMSIncrBy_Handler,
+		},
+		{
+			MethodName: "CMSQuery",
+			Handler:    _MemoraService_CMSQuery_Handler,
+		},
+		{
+			MethodName: "MGet",
+			Handler:    _MemoraService_MGet_Handler,
+		},
+		{
+			MethodName: "MSet",
+			Handler:    _MemoraService_MSet_Handler,
+		},
+		{
+			MethodName: "MDelete",
+			Handler:    _MemoraService_MDelete_Handler,
+		},
+		{
+			MethodName: "Connect",
+			Handler:    _MemoraService_Connect_Handler,
+		},
+		{
+			MethodName: "Disconnect",
+			Handler:    _MemoraService_Disconnect_Handler,
+		},
+		{
+			MethodName: "Snapshot",
+			Handler:    _MemoraService_Snapshot_Handler,
+		},
+		{
+			MethodName: "RewriteAOF",
+			Handler:    _MemoraService_RewriteAOF_Handler,
+		},
+		{
+			MethodName: "Stats",
+			Handler:    _MemoraService_Stats_Handler,
+		},
+		{
+			MethodName: "TTL",
+			Handler:    _MemoraService_TTL_Handler,
+		},
+		{
+			MethodName: "Expire",
+			Handler:    _MemoraService_Expire_Handler,
+		},
+		{
+			MethodName: "Persist",
+			Handler:    _MemoraService_Persist_Handler,
+		},
+		{
+			MethodName: "ACLSetUser",
+			Handler:    _MemoraService_ACLSetUser_Handler,
+		},
+		{
+			MethodName: "ACLDelUser",
+			Handler:    _MemoraService_ACLDelUser_Handler,
+		},
+		{
+			MethodName: "ACLList",
+			Handler:    _MemoraService_ACLList_Handler,
+		},
+		{
+			MethodName: "ACLLoad",
+			Handler:    _MemoraService_ACLLoad_Handler,
+		},
+		{
+			MethodName: "ACLSave",
+			Handler:    _MemoraService_ACLSave_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "XReadGroup",
+			Handler:       _MemoraService_XReadGroup_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "memora.proto",
 }
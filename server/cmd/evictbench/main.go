@@ -0,0 +1,142 @@
+// Command evictbench replays recorded access traces against the cache with each eviction
+// policy and compares their hit ratios.
+//
+// A trace has one access per line: a key, optionally followed by the value size in bytes and a
+// ttl in milliseconds. Each access reads the key and stores it on a miss, like a read through cache.
+// Empty lines and lines starting with # are ignored.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Lucascluz/memora-server/internal/cache"
+)
+
+type access struct {
+	key  string
+	size int
+	ttl  int64
+}
+
+type result struct {
+	hits     int
+	misses   int
+	rejected int
+	evicted  uint64
+	elapsed  time.Duration
+}
+
+func main() {
+	traces := flag.String("trace", "", "comma separated trace files to replay")
+	policies := flag.String("policy", strings.Join(cache.Policies, ","), "comma separated eviction policies to compare")
+	maxMemory := flag.Int64("max-memory", 64<<20, "memory bound of the cache in bytes")
+	valueSize := flag.Int("value-size", 64, "value size in bytes for accesses that do not specify one")
+	shards := flag.Int("shards", cache.DefaultShards, "number of cache shards, each evicting within its part of the memory bound")
+	flag.Parse()
+
+	if *traces == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "TRACE\tPOLICY\tHIT RATIO\tHITS\tMISSES\tREJECTED\tEVICTED\tTIME")
+
+	for _, path := range strings.Split(*traces, ",") {
+		accesses, err := readTrace(path, *valueSize)
+		if err != nil {
+			log.Fatalf("Failed to read trace: %v", err)
+		}
+
+		for _, name := range strings.Split(*policies, ",") {
+			policy, err := cache.ParsePolicy(name)
+			if err != nil {
+				log.Fatalf("Invalid configuration: %v", err)
+			}
+
+			c := cache.NewCache(cache.WithMaxMemory(*maxMemory), cache.WithPolicy(policy), cache.WithShards(*shards))
+			r := replay(c, accesses)
+			ratio := float64(r.hits) / float64(max(r.hits+r.misses, 1)) * 100
+			fmt.Fprintf(w, "%s\t%s\t%.2f%%\t%d\t%d\t%d\t%d\t%s\n",
+				path, name, ratio, r.hits, r.misses, r.rejected, r.evicted, r.elapsed.Round(time.Millisecond))
+		}
+	}
+
+	w.Flush()
+}
+
+// replay runs the accesses against c, storing every missed key
+func replay(c *cache.Cache, accesses []access) result {
+	var r result
+	values := make(map[int][]byte)
+
+	start := time.Now()
+	for _, a := range accesses {
+		if _, err := c.Get(a.key); err == nil {
+			r.hits++
+			continue
+		}
+		r.misses++
+
+		value, ok := values[a.size]
+		if !ok {
+			value = make([]byte, a.size)
+			values[a.size] = value
+		}
+
+		var ttl int64
+		if a.ttl > 0 {
+			ttl = time.Now().UnixMilli() + a.ttl
+		}
+		if err := c.Set(a.key, value, ttl); err != nil {
+			r.rejected++
+		}
+	}
+	r.elapsed = time.Since(start)
+	r.evicted = c.Stats().Evicted
+
+	return r
+}
+
+func readTrace(path string, valueSize int) ([]access, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var accesses []access
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		a := access{key: fields[0], size: valueSize}
+		if len(fields) > 1 {
+			if a.size, err = strconv.Atoi(fields[1]); err != nil || a.size < 0 {
+				return nil, fmt.Errorf("%s:%d: invalid value size %q", path, line, fields[1])
+			}
+		}
+		if len(fields) > 2 {
+			if a.ttl, err = strconv.ParseInt(fields[2], 10, 64); err != nil || a.ttl < 0 {
+				return nil, fmt.Errorf("%s:%d: invalid ttl %q", path, line, fields[2])
+			}
+		}
+		accesses = append(accesses, a)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return accesses, nil
+}
@@ -1,25 +1,202 @@
 package main
 
 import (
+	"flag"
 	"log"
 	"net"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
+	"time"
 
 	pb "github.com/Lucascluz/memora-proto/gen"
+	"github.com/Lucascluz/memora-server/internal/acl"
+	"github.com/Lucascluz/memora-server/internal/aof"
+	"github.com/Lucascluz/memora-server/internal/auth"
+	"github.com/Lucascluz/memora-server/internal/cache"
+	"github.com/Lucascluz/memora-server/internal/certs"
+	"github.com/Lucascluz/memora-server/internal/recovery"
 	"github.com/Lucascluz/memora-server/internal/server"
+	"github.com/Lucascluz/memora-server/internal/session"
+	"github.com/Lucascluz/memora-server/internal/snapshot"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 func main() {
+	appendOnly := flag.Bool("aof", true, "persist every write to the append only file")
+	aofPath := flag.String("aof-path", "memora.aof", "path of the append only file")
+	aofFsync := flag.String("aof-fsync", "everysec", "append only file fsync policy: always, everysec or never")
+	aofRewriteGrowth := flag.Int("aof-rewrite-percentage", 100, "rewrite the append only file once it grew by this percentage, 0 disables it")
+	aofRewriteMinSize := flag.Int64("aof-rewrite-min-size", 64<<20, "minimum append only file size in bytes before it is rewritten")
+	snapshots := flag.Bool("snapshot", true, "save point in time snapshots of the cache")
+	snapshotDir := flag.String("snapshot-dir", ".", "directory holding the snapshot files")
+	snapshotInterval := flag.Duration("snapshot-interval", 5*time.Minute, "time between scheduled snapshots, 0 disables them")
+	snapshotKeep := flag.Int("snapshot-keep", 3, "number of snapshot files to keep")
+	maxMemory := flag.Int64("max-memory", 0, "maximum memory in bytes taken by keys and values before entries are evicted, 0 means no limit")
+	evictionPolicy := flag.String("eviction-policy", cache.PolicyAllKeysLRU, "entries evicted once max-memory is reached: "+strings.Join(cache.Policies, ", "))
+	shards := flag.Int("shards", cache.DefaultShards, "number of independently locked cache shards, rounded up to a power of two")
+	sessionIdleTimeout := flag.Duration("session-idle-timeout", 0, "disconnect clients that sent no request for this long, 0 keeps them until they disconnect")
+	aclFile := flag.String("acl-file", "", "file with the commands and keys every user may access, empty allows everything")
+	usersFile := flag.String("users-file", "", "file with the credentials clients connect with, empty accepts any client")
+	tlsCert := flag.String("tls-cert", "", "certificate file served over TLS, empty serves plaintext")
+	tlsKey := flag.String("tls-key", "", "private key file of the TLS certificate")
+	tlsClientCA := flag.String("tls-client-ca", "", "CA file verifying client certificates, clients must present one when set")
+	tlsReloadInterval := flag.Duration("tls-reload-interval", time.Minute, "time between checks for changed TLS files, 0 disables reloading")
+	tokenTTL := flag.Duration("token-ttl", time.Hour, "time after which client keys expire and clients have to connect again, 0 keeps them valid")
+	expireInterval := flag.Duration("expire-interval", 100*time.Millisecond, "time between active expiry cycles, 0 disables them")
+	flag.Parse()
+
+	eviction, err := cache.ParsePolicy(*evictionPolicy)
+	if err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+
+	memoraCache := cache.NewCache(cache.WithMaxMemory(*maxMemory), cache.WithPolicy(eviction), cache.WithShards(*shards))
+	var opts []server.Option
+
+	// Restore the cache from the newest snapshot and the append only file before serving requests
+	recoveryConfig := recovery.Config{}
+	if *snapshots {
+		recoveryConfig.SnapshotDir = *snapshotDir
+	}
+	if *appendOnly {
+		recoveryConfig.AOFPath = *aofPath
+	}
+	report, err := recovery.Recover(memoraCache, recoveryConfig)
+	if err != nil {
+		log.Fatalf("Failed to recover persisted data: %v", err)
+	}
+	if report.Snapshot != "" {
+		log.Printf("Loaded %d entries from snapshot %s", report.Loaded, report.Snapshot)
+	}
+	if report.Truncated > 0 {
+		log.Printf("Truncated %d bytes of an incomplete record from %s", report.Truncated, *aofPath)
+	}
+
+	var appendFile *aof.AOF
+	if *appendOnly {
+		log.Printf("Replayed %d operations from %s", report.Replayed, *aofPath)
+
+		policy, err := aof.ParseFsyncPolicy(*aofFsync)
+		if err != nil {
+			log.Fatalf("Invalid configuration: %v", err)
+		}
+
+		appendFile, err = aof.Open(*aofPath, policy)
+		if err != nil {
+			log.Fatalf("Failed to open append only file: %v", err)
+		}
+		defer func() {
+			if err := appendFile.Close(); err != nil {
+				log.Printf("Failed to close append only file: %v", err)
+			}
+		}()
+		memoraCache.SetJournal(appendFile)
+
+		// make the append only file cover what was restored from the snapshot alone
+		if report.Rewrite {
+			if err := appendFile.Rewrite(memoraCache.Dump); err != nil {
+				log.Fatalf("Failed to rewrite append only file: %v", err)
+			}
+		}
+
+		if *aofRewriteGrowth > 0 {
+			appendFile.AutoRewrite(memoraCache.Dump, *aofRewriteGrowth, *aofRewriteMinSize)
+		}
+		opts = append(opts, server.WithAOF(appendFile))
+	}
+
+	if *snapshots {
+		snapshotter := snapshot.New(*snapshotDir, *snapshotKeep, memoraCache)
+		if appendFile != nil {
+			snapshotter.SetJournal(appendFile)
+		}
+		if *snapshotInterval > 0 {
+			snapshotter.Start(*snapshotInterval)
+		}
+		defer func() {
+			snapshotter.Stop()
+			if path, err := snapshotter.Save(); err != nil {
+				log.Printf("Failed to save shutdown snapshot: %v", err)
+			} else {
+				log.Printf("Saved shutdown snapshot %s", path)
+			}
+		}()
+		opts = append(opts, server.WithSnapshotter(snapshotter))
+	}
+
+	// Actively reclaim expired entries that are never read again
+	if *expireInterval > 0 {
+		memoraCache.StartSweeper(*expireInterval)
+		defer memoraCache.StopSweeper()
+	}
+
+	// Authenticate clients against the users file
+	if *usersFile != "" {
+		users, err := auth.Load(*usersFile)
+		if err != nil {
+			log.Fatalf("Failed to load users: %v", err)
+		}
+		opts = append(opts, server.WithAuth(users))
+	}
+
+	// Authorize requests against the ACL file, reloading it on SIGHUP
+	if *aclFile != "" {
+		rules, err := acl.Load(*aclFile)
+		if err != nil {
+			log.Fatalf("Failed to load ACL: %v", err)
+		}
+		opts = append(opts, server.WithACL(rules))
+
+		hangup := make(chan os.Signal, 1)
+		signal.Notify(hangup, syscall.SIGHUP)
+		go func() {
+			for range hangup {
+				if err := rules.Reload(); err != nil {
+					log.Printf("Failed to reload ACL: %v", err)
+					continue
+				}
+				log.Printf("Reloaded ACL from %s", *aclFile)
+			}
+		}()
+	}
+
+	// Track the connected clients, expiring the ones that went away without disconnecting
+	sessions := session.NewRegistry(session.WithIdleTimeout(*sessionIdleTimeout), session.WithTokenTTL(*tokenTTL))
+	sessions.StartReaper()
+	defer sessions.StopReaper()
+	opts = append(opts, server.WithSessions(sessions))
+
 	lis, err := net.Listen("tcp", ":1212")
 	if err != nil {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
-	memoraServer := server.NewServer()
+	// every request but Connect is authenticated by the server interceptors
+	memoraServer := server.NewServer(memoraCache, opts...)
+	serverOpts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(memoraServer.UnaryInterceptor()),
+		grpc.ChainStreamInterceptor(memoraServer.StreamInterceptor()),
+	}
+
+	// Serve over TLS, reloading the certificates when they are rotated
+	if *tlsCert != "" || *tlsKey != "" {
+		reloader, err := certs.NewReloader(*tlsCert, *tlsKey, *tlsClientCA)
+		if err != nil {
+			log.Fatalf("Failed to load TLS certificates: %v", err)
+		}
+		if *tlsReloadInterval > 0 {
+			reloader.Start(*tlsReloadInterval)
+			defer reloader.Stop()
+		}
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(reloader.Config())))
+	} else if *tlsClientCA != "" {
+		log.Fatalf("Invalid configuration: -tls-client-ca requires -tls-cert and -tls-key")
+	}
+
+	grpcServer := grpc.NewServer(serverOpts...)
 	pb.RegisterMemoraServiceServer(grpcServer, memoraServer)
 
 	// Graceful shutdown support
@@ -36,6 +213,8 @@ func main() {
 	<-quit
 	log.Println("Shutting down server...")
 
+	// blocking pops would otherwise hold the graceful stop until their deadline
+	memoraServer.Close()
 	grpcServer.GracefulStop()
 	log.Println("Server stopped gracefully")
 }
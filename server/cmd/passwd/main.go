@@ -0,0 +1,53 @@
+// Command passwd prints users file lines for the server -users-file flag.
+//
+//	passwd -user alice          reads the password from stdin
+//	passwd -apikey ci           generates a new API key and prints it along the line
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/Lucascluz/memora-server/internal/auth"
+)
+
+func main() {
+	user := flag.String("user", "", "name of the user whose password is read from stdin")
+	apiKey := flag.String("apikey", "", "name to generate a new API key for")
+	flag.Parse()
+
+	switch {
+	case *user != "":
+		fmt.Fprint(os.Stderr, "Password: ")
+		password, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil && password == "" {
+			log.Fatalf("Failed to read password: %v", err)
+		}
+		password = strings.TrimRight(password, "\r\n")
+		if password == "" {
+			log.Fatal("Password cannot be empty")
+		}
+
+		hash, err := auth.HashPassword(password)
+		if err != nil {
+			log.Fatalf("Failed to hash password: %v", err)
+		}
+		fmt.Printf("user %s %s\n", *user, hash)
+
+	case *apiKey != "":
+		key, err := auth.GenerateAPIKey()
+		if err != nil {
+			log.Fatalf("Failed to generate api key: %v", err)
+		}
+		fmt.Fprintf(os.Stderr, "API key: %s\n", key)
+		fmt.Printf("apikey %s %s\n", *apiKey, auth.HashAPIKey(key))
+
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
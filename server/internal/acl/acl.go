@@ -0,0 +1,529 @@
+// Package acl authorizes the requests of users per command and key pattern, like Redis ACLs.
+//
+// The ACL file has one user per line, blank lines and lines starting with # are ignored:
+//
+//	user <name> <rule>...
+//
+// Rules are applied in order, later command rules override earlier ones:
+//
+//	+<command>    allow a command, e.g. +Get
+//	-<command>    deny a command
+//	+@<category>  allow the commands of a category: read, write, admin or all
+//	-@<category>  deny the commands of a category
+//	allcommands   same as +@all
+//	nocommands    same as -@all
+//	~<pattern>    allow reading and writing the keys matching a glob pattern, e.g. ~cache:app1:*
+//	%R~<pattern>  allow reading the keys matching a pattern
+//	%W~<pattern>  allow writing the keys matching a pattern
+//	allkeys       same as ~*
+//	resetkeys     forget the key patterns given so far
+//	reset         forget every rule given so far
+//
+// A user without rules may run nothing. The rules of the user named default apply to the users
+// without a line of their own, and to every client when authentication is disabled.
+package acl
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// DefaultUser is the user whose rules apply to the users without rules of their own
+const DefaultUser = "default"
+
+var ErrDenied = errors.New("permission denied")
+
+// Access is a set of permissions a command needs or a rule grants
+type Access uint8
+
+const (
+	Read Access = 1 << iota
+	Write
+	Admin
+
+	All = Read | Write | Admin
+)
+
+// categories maps the names used in +@ and -@ rules to the permissions they cover
+var categories = map[string]Access{
+	"read":  Read,
+	"write": Write,
+	"admin": Admin,
+	"all":   All,
+}
+
+// commands maps the lowercase name of every RPC to the permissions it needs, commands needing
+// Read or Write are also checked against the key patterns of the user
+var commands = map[string]Access{
+	"get":         Read,
+	"mget":        Read,
+	"ttl":         Read,
+	"stats":       Read,
+	"set":         Write,
+	"delete":      Write,
+	"mset":        Write,
+	"mdelete":     Write,
+	"expire":      Write,
+	"persist":     Write,
+	"incrby":      Write,
+	"incrbyfloat": Write,
+	"hget":        Read,
+	"hgetall":     Read,
+	"hset":        Write,
+	"hdel":        Write,
+	"hincrby":     Write,
+	"lrange":      Read,
+	"llen":        Read,
+	"lpush":       Write,
+	"rpush":       Write,
+	"ltrim":       Write,
+	"lpop":        Read | Write,
+	"rpop":        Read | Write,
+	"blpop":       Read | Write,
+	"brpop":       Read | Write,
+	"sismember":   Read,
+	"scard":       Read,
+	"smembers":    Read,
+	"srandmember": Read,
+	"sinter":      Read,
+	"sunion":      Read,
+	"sdiff":       Read,
+	"sadd":        Write,
+	"srem":        Write,
+	"sinterstore": Read | Write,
+	"sunionstore": Read | Write,
+	"sdiffstore":  Read | Write,
+	"getset":      Read | Write,
+	"getdel":      Read | Write,
+	"snapshot":    Admin,
+	"rewriteaof":  Admin,
+	"aclsetuser":  Admin,
+	"acldeluser":  Admin,
+	"acllist":     Admin,
+	"aclload":     Admin,
+	"aclsave":     Admin,
+
+	"zscore":           Read,
+	"zcard":            Read,
+	"zrank":            Read,
+	"zrange":           Read,
+	"zrangebyscore":    Read,
+	"zrangebylex":      Read,
+	"zadd":             Write,
+	"zincrby":          Write,
+	"zrem":             Write,
+	"zremrangebyscore": Write,
+
+	"xlen":          Read,
+	"xrange":        Read,
+	"xpending":      Read,
+	"xadd":          Write,
+	"xgroupcreate":  Write,
+	"xgroupdestroy": Write,
+	"xack":          Write,
+	"xreadgroup":    Read | Write,
+	"xautoclaim":    Read | Write,
+
+	"pfcount":       Read,
+	"bfexists":      Read,
+	"cmsquery":      Read,
+	"pfadd":         Write,
+	"bfreserve":     Write,
+	"bfadd":         Write,
+	"cmsinitbydim":  Write,
+	"cmsinitbyprob": Write,
+	"cmsincrby":     Write,
+	"pfmerge":       Read | Write,
+}
+
+// storeCommands read their source keys and store the result under a destination key, passed
+// first to Check. The destination only needs Write and the sources only Read, so the commands
+// still need both categories allowed.
+var storeCommands = map[string]bool{
+	"sinterstore": true,
+	"sunionstore": true,
+	"sdiffstore":  true,
+	"pfmerge":     true,
+}
+
+// CommandAccess returns the permissions a command needs. Unknown commands need Admin so new
+// commands are denied until they are categorized.
+func CommandAccess(command string) Access {
+	access, ok := commands[strings.ToLower(command)]
+	if !ok {
+		return Admin
+	}
+	return access
+}
+
+// commandRule allows or denies a single command, or the commands of a category when command is empty
+type commandRule struct {
+	allow    bool
+	command  string
+	category string
+}
+
+func (r commandRule) String() string {
+	sign := "-"
+	if r.allow {
+		sign = "+"
+	}
+	if r.command != "" {
+		return sign + r.command
+	}
+	return sign + "@" + r.category
+}
+
+// keyPattern grants Read, Write or both on the keys matching a glob pattern
+type keyPattern struct {
+	pattern string
+	access  Access
+}
+
+func (p keyPattern) String() string {
+	switch p.access {
+	case Read:
+		return "%R~" + p.pattern
+	case Write:
+		return "%W~" + p.pattern
+	}
+	return "~" + p.pattern
+}
+
+// user holds the rules of a user
+type user struct {
+	commands []commandRule
+	keys     []keyPattern
+}
+
+// apply adds rules to the user, leaving it untouched when one of them is invalid
+func (u *user) apply(rules []string) error {
+	next := user{commands: slices.Clone(u.commands), keys: slices.Clone(u.keys)}
+
+	for _, rule := range rules {
+		switch {
+		case rule == "reset":
+			next = user{}
+		case rule == "resetkeys":
+			next.keys = nil
+		case rule == "allkeys":
+			next.keys = append(next.keys, keyPattern{pattern: "*", access: Read | Write})
+		case rule == "allcommands":
+			next.commands = append(next.commands, commandRule{allow: true, category: "all"})
+		case rule == "nocommands":
+			next.commands = append(next.commands, commandRule{allow: false, category: "all"})
+		case strings.HasPrefix(rule, "~"):
+			next.keys = append(next.keys, keyPattern{pattern: rule[1:], access: Read | Write})
+		case strings.HasPrefix(rule, "%"):
+			perms, pattern, ok := strings.Cut(rule[1:], "~")
+			access, valid := parsePermissions(perms)
+			if !ok || !valid {
+				return fmt.Errorf("invalid key rule %q, expected %%R~, %%W~ or %%RW~ followed by a pattern", rule)
+			}
+			next.keys = append(next.keys, keyPattern{pattern: pattern, access: access})
+		case strings.HasPrefix(rule, "+") || strings.HasPrefix(rule, "-"):
+			cr := commandRule{allow: rule[0] == '+'}
+			name := strings.ToLower(rule[1:])
+			if category, ok := strings.CutPrefix(name, "@"); ok {
+				if _, ok := categories[category]; !ok {
+					return fmt.Errorf("unknown command category %q", category)
+				}
+				cr.category = category
+			} else {
+				if _, ok := commands[name]; !ok {
+					return fmt.Errorf("unknown command %q", rule[1:])
+				}
+				cr.command = name
+			}
+			next.commands = append(next.commands, cr)
+		default:
+			return fmt.Errorf("unknown rule %q", rule)
+		}
+	}
+
+	*u = next
+	return nil
+}
+
+// allowed reports whether the user may run a command, the last rule naming it wins. Otherwise the
+// command needs every permission it requires granted by the category rules, e.g. +@read +@write
+// for a command that reads and writes.
+func (u *user) allowed(command string, access Access) bool {
+	var granted Access
+	explicit, named := false, false
+	for _, rule := range u.commands {
+		if rule.command != "" {
+			if rule.command == command {
+				explicit, named = rule.allow, true
+			}
+			continue
+		}
+
+		category := categories[rule.category]
+		if category&access == 0 {
+			continue
+		}
+		if rule.allow {
+			granted |= category
+		} else {
+			granted &^= category
+		}
+		// a later category rule covering the command overrides the rules naming it
+		named = false
+	}
+
+	if named {
+		return explicit
+	}
+	return granted&access == access
+}
+
+// keyAccess returns the permissions the user has on a key
+func (u *user) keyAccess(key string) Access {
+	var access Access
+	for _, p := range u.keys {
+		if match(p.pattern, key) {
+			access |= p.access
+		}
+	}
+	return access
+}
+
+func (u *user) String() string {
+	rules := make([]string, 0, len(u.commands)+len(u.keys))
+	for _, rule := range u.commands {
+		rules = append(rules, rule.String())
+	}
+	for _, p := range u.keys {
+		rules = append(rules, p.String())
+	}
+	return strings.Join(rules, " ")
+}
+
+// ACL holds the rules of every user, loaded from and saved to an ACL file
+type ACL struct {
+	path string
+
+	mu    sync.RWMutex
+	users map[string]*user
+}
+
+// Load reads an ACL file
+func Load(path string) (*ACL, error) {
+	a := &ACL{path: path}
+	if err := a.Reload(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Reload reads the ACL file again, discarding the changes that were not saved.
+// The current rules are kept when the file is invalid.
+func (a *ACL) Reload() error {
+	f, err := os.Open(a.path)
+	if err != nil {
+		return fmt.Errorf("failed to open acl file: %w", err)
+	}
+	defer f.Close()
+
+	users := make(map[string]*user)
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		if fields[0] != "user" || len(fields) < 2 {
+			return fmt.Errorf("%s:%d: expected user followed by a name and rules", a.path, line)
+		}
+
+		name := fields[1]
+		if _, ok := users[name]; ok {
+			return fmt.Errorf("%s:%d: duplicate user %q", a.path, line, name)
+		}
+		u := &user{}
+		if err := u.apply(fields[2:]); err != nil {
+			return fmt.Errorf("%s:%d: %w", a.path, line, err)
+		}
+		users[name] = u
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read acl file: %w", err)
+	}
+
+	a.mu.Lock()
+	a.users = users
+	a.mu.Unlock()
+
+	return nil
+}
+
+// Save writes the current rules to the ACL file, replacing it atomically
+func (a *ACL) Save() error {
+	var b strings.Builder
+	for _, line := range a.List() {
+		b.WriteString(line)
+		b.WriteByte('\n')
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(a.path), filepath.Base(a.path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create acl file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.WriteString(b.String()); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write acl file: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to sync acl file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close acl file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), a.path); err != nil {
+		return fmt.Errorf("failed to replace acl file: %w", err)
+	}
+	return nil
+}
+
+// SetUser applies rules to a user, creating it when it has none yet. Nothing changes when a rule is invalid.
+func (a *ACL) SetUser(name string, rules []string) error {
+	if name == "" || strings.ContainsFunc(name, isSpace) {
+		return fmt.Errorf("invalid user name %q", name)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	u, ok := a.users[name]
+	if !ok {
+		u = &user{}
+	}
+	if err := u.apply(rules); err != nil {
+		return err
+	}
+	a.users[name] = u
+	return nil
+}
+
+// DeleteUser removes the rules of a user, reporting whether it had any
+func (a *ACL) DeleteUser(name string) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	_, ok := a.users[name]
+	delete(a.users, name)
+	return ok
+}
+
+// List returns the ACL file line of every user, sorted by name
+func (a *ACL) List() []string {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	lines := make([]string, 0, len(a.users))
+	for name, u := range a.users {
+		line := "user " + name
+		if rules := u.String(); rules != "" {
+			line += " " + rules
+		}
+		lines = append(lines, line)
+	}
+	slices.Sort(lines)
+	return lines
+}
+
+// Check returns an error wrapping ErrDenied unless name may run command on keys, the destination
+// first for the commands storing a result. An empty name is checked against the default user.
+func (a *ACL) Check(name, command string, keys ...string) error {
+	if name == "" {
+		name = DefaultUser
+	}
+	command = strings.ToLower(command)
+	access := CommandAccess(command)
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	u, ok := a.users[name]
+	if !ok {
+		u, ok = a.users[DefaultUser]
+	}
+	if !ok || !u.allowed(command, access) {
+		return fmt.Errorf("%w: user %s cannot run %s", ErrDenied, name, command)
+	}
+
+	// only the key permissions the command needs are checked
+	need := access & (Read | Write)
+	for i, key := range keys {
+		need := need
+		if storeCommands[command] {
+			// the destination is only written and the sources only read
+			if i == 0 {
+				need &= Write
+			} else {
+				need &= Read
+			}
+		}
+		if u.keyAccess(key)&need != need {
+			return fmt.Errorf("%w: user %s cannot access key %q with %s", ErrDenied, name, key, command)
+		}
+	}
+	return nil
+}
+
+// parsePermissions parses the R, W or RW of a %<permissions>~<pattern> rule
+func parsePermissions(s string) (Access, bool) {
+	var access Access
+	for _, c := range strings.ToUpper(s) {
+		switch c {
+		case 'R':
+			access |= Read
+		case 'W':
+			access |= Write
+		default:
+			return 0, false
+		}
+	}
+	return access, access != 0
+}
+
+func isSpace(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\n' || r == '\r'
+}
+
+// match reports whether key matches a glob pattern where * matches any run of characters
+// and ? a single one
+func match(pattern, key string) bool {
+	// backtrack to the last * when the rest of the key does not match
+	var p, k, star, next int
+	star = -1
+	for k < len(key) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == key[k]):
+			p++
+			k++
+		case p < len(pattern) && pattern[p] == '*':
+			star, next = p, k
+			p++
+		case star >= 0:
+			next++
+			p, k = star+1, next
+		default:
+			return false
+		}
+	}
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}
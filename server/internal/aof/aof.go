@@ -0,0 +1,620 @@
+// Package aof implements the append only file used to persist every write
+// applied to the cache so it can be replayed after a restart.
+package aof
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"log"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/Lucascluz/memora-server/internal/data"
+)
+
+// FsyncPolicy controls how often the append only file is flushed to disk
+type FsyncPolicy int
+
+const (
+	// FsyncAlways syncs the file after every appended operation
+	FsyncAlways FsyncPolicy = iota
+	// FsyncEverySec syncs the file once per second in the background
+	FsyncEverySec
+	// FsyncNever leaves syncing to the operating system
+	FsyncNever
+)
+
+const (
+	magic   = "MAOF"
+	version = 3
+
+	// the header is the magic, the version and the 8 byte id of the file
+	headerSize = len(magic) + 1 + 8
+	// the frame of a record is the payload length, the payload checksum and a checksum of both,
+	// so a damaged length is told apart from a file ending in the middle of a payload
+	frameSize = 12
+
+	// maxRecord bounds the payload size so a corrupt length cannot trigger a huge allocation
+	maxRecord = 1 << 30
+
+	// drainThreshold is the buffered tail size small enough to copy while appends are blocked
+	drainThreshold = 64 << 10
+)
+
+var (
+	ErrBadHeader = errors.New("aof: invalid file header")
+	ErrChecksum  = errors.New("aof: record checksum mismatch")
+	ErrTruncated = errors.New("aof: truncated record")
+	ErrCorrupt   = errors.New("aof: corrupt record")
+	ErrClosed    = errors.New("aof: file is closed")
+	ErrRewriting = errors.New("aof: rewrite already in progress")
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// ParseFsyncPolicy converts the "always", "everysec" and "never" names into a policy
+func ParseFsyncPolicy(s string) (FsyncPolicy, error) {
+	switch s {
+	case "always":
+		return FsyncAlways, nil
+	case "everysec":
+		return FsyncEverySec, nil
+	case "never":
+		return FsyncNever, nil
+	}
+	return 0, fmt.Errorf("aof: unknown fsync policy %q", s)
+}
+
+func (p FsyncPolicy) String() string {
+	switch p {
+	case FsyncAlways:
+		return "always"
+	case FsyncEverySec:
+		return "everysec"
+	case FsyncNever:
+		return "never"
+	}
+	return fmt.Sprintf("FsyncPolicy(%d)", int(p))
+}
+
+// DumpFunc returns the operations rebuilding the current dataset.
+// It must call mark while writes are blocked so the dump lines up with the operations appended after it.
+type DumpFunc func(mark func()) []data.Operation
+
+// Recovery reports what Replay found in the file
+type Recovery struct {
+	// ID identifies the file, it changes every time the file is rewritten
+	ID uint64
+	// Replayed is the number of operations handed to apply
+	Replayed int
+	// Truncated is the number of bytes of an incomplete final record that were cut off
+	Truncated int64
+}
+
+type AOF struct {
+	mu     sync.Mutex
+	path   string
+	id     uint64
+	file   *os.File
+	policy FsyncPolicy
+	dirty  bool
+
+	// size is the current file size, base the size right after the last rewrite
+	size int64
+	base int64
+
+	// while rewriting, appended records are also buffered for the new file
+	rewriting  bool
+	rewriteBuf []byte
+	rewriteMu  sync.Mutex
+
+	done chan struct{}
+	stop sync.Once
+	wg   sync.WaitGroup
+}
+
+// Open opens the append only file at path, creating it when missing.
+// New operations are always appended after the existing ones.
+func Open(path string, policy FsyncPolicy) (*AOF, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("aof: failed to open %s: %w", path, err)
+	}
+
+	id, size, err := prepare(file)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	a := &AOF{
+		path:   path,
+		id:     id,
+		file:   file,
+		policy: policy,
+		size:   size,
+		base:   size,
+		done:   make(chan struct{}),
+	}
+
+	if policy == FsyncEverySec {
+		a.wg.Add(1)
+		go a.syncLoop()
+	}
+
+	return a, nil
+}
+
+// Append writes the operation to the end of the file honoring the fsync policy
+func (a *AOF) Append(op data.Operation) error {
+	record, err := encodeRecord(op)
+	if err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.file == nil {
+		return ErrClosed
+	}
+
+	if _, err := a.file.Write(record); err != nil {
+		return fmt.Errorf("aof: failed to append operation: %w", err)
+	}
+	a.size += int64(len(record))
+
+	if a.rewriting {
+		a.rewriteBuf = append(a.rewriteBuf, record...)
+	}
+
+	if a.policy == FsyncAlways {
+		return a.file.Sync()
+	}
+
+	a.dirty = true
+	return nil
+}
+
+// Size returns the current size of the file in bytes
+func (a *AOF) Size() int64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.size
+}
+
+// Position returns the id of the file and the offset right after the last appended operation
+func (a *AOF) Position() (uint64, int64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.id, a.size
+}
+
+// Rewrite replaces the file with the minimal set of operations returned by dump.
+// Writes keep being appended to the old file while the new one is built, they are
+// buffered and copied over before the new file atomically takes its place.
+func (a *AOF) Rewrite(dump DumpFunc) error {
+	if !a.rewriteMu.TryLock() {
+		return ErrRewriting
+	}
+	defer a.rewriteMu.Unlock()
+
+	tmp, err := os.CreateTemp(filepath.Dir(a.path), filepath.Base(a.path)+".rewrite-*")
+	if err != nil {
+		return fmt.Errorf("aof: failed to create rewrite file: %w", err)
+	}
+	if err := tmp.Chmod(0o644); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	swapped := false
+	defer func() {
+		if swapped {
+			return
+		}
+		a.mu.Lock()
+		a.rewriting = false
+		a.rewriteBuf = nil
+		a.mu.Unlock()
+
+		tmp.Close()
+		os.Remove(tmp.Name())
+	}()
+
+	ops := dump(func() {
+		a.mu.Lock()
+		a.rewriting = true
+		a.rewriteBuf = nil
+		a.mu.Unlock()
+	})
+
+	id := newID()
+	w := bufio.NewWriter(tmp)
+	if _, err := w.Write(header(id)); err != nil {
+		return fmt.Errorf("aof: failed to write rewrite file: %w", err)
+	}
+	for _, op := range ops {
+		record, err := encodeRecord(op)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(record); err != nil {
+			return fmt.Errorf("aof: failed to write rewrite file: %w", err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("aof: failed to write rewrite file: %w", err)
+	}
+
+	// drain what was appended meanwhile so the final swap only copies a small tail
+	for {
+		a.mu.Lock()
+		buf := a.rewriteBuf
+		a.rewriteBuf = nil
+		a.mu.Unlock()
+
+		if _, err := tmp.Write(buf); err != nil {
+			return fmt.Errorf("aof: failed to write rewrite file: %w", err)
+		}
+		if len(buf) < drainThreshold {
+			break
+		}
+	}
+	if err := tmp.Sync(); err != nil {
+		return fmt.Errorf("aof: failed to sync rewrite file: %w", err)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.file == nil {
+		return ErrClosed
+	}
+
+	if _, err := tmp.Write(a.rewriteBuf); err != nil {
+		return fmt.Errorf("aof: failed to write rewrite file: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		return fmt.Errorf("aof: failed to sync rewrite file: %w", err)
+	}
+	info, err := tmp.Stat()
+	if err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), a.path); err != nil {
+		return fmt.Errorf("aof: failed to replace %s: %w", a.path, err)
+	}
+	swapped = true
+
+	a.file.Close()
+	a.file = tmp
+	a.id = id
+	a.size = info.Size()
+	a.base = a.size
+	a.dirty = false
+	a.rewriting = false
+	a.rewriteBuf = nil
+
+	return syncDir(filepath.Dir(a.path))
+}
+
+// AutoRewrite checks every second whether the file grew by growth percent since the last
+// rewrite and is at least minSize bytes, and rewrites it from dump when it did
+func (a *AOF) AutoRewrite(dump DumpFunc, growth int, minSize int64) {
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				a.mu.Lock()
+				size, base := a.size, a.base
+				a.mu.Unlock()
+
+				if size < minSize || size < base+base*int64(growth)/100 {
+					continue
+				}
+
+				if err := a.Rewrite(dump); err != nil && !errors.Is(err, ErrRewriting) {
+					log.Printf("Append only file rewrite failed: %v", err)
+					continue
+				}
+				log.Printf("Rewrote append only file from %d to %d bytes", size, a.Size())
+			case <-a.done:
+				return
+			}
+		}
+	}()
+}
+
+// Sync flushes every appended operation to disk
+func (a *AOF) Sync() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.sync()
+}
+
+// Close stops the background syncer, syncs pending writes and closes the file
+func (a *AOF) Close() error {
+	a.stop.Do(func() { close(a.done) })
+	a.wg.Wait()
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.file == nil {
+		return nil
+	}
+
+	err := a.file.Sync()
+	if cerr := a.file.Close(); err == nil {
+		err = cerr
+	}
+	a.file = nil
+
+	return err
+}
+
+func (a *AOF) sync() error {
+	if a.file == nil {
+		return ErrClosed
+	}
+	if !a.dirty {
+		return nil
+	}
+
+	a.dirty = false
+	return a.file.Sync()
+}
+
+func (a *AOF) syncLoop() {
+	defer a.wg.Done()
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			a.mu.Lock()
+			_ = a.sync()
+			a.mu.Unlock()
+		case <-a.done:
+			return
+		}
+	}
+}
+
+// ReadID returns the id stored in the header of the file at path, or 0 when there is no file yet
+func ReadID(path string) (uint64, error) {
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("aof: failed to open %s: %w", path, err)
+	}
+	defer file.Close()
+
+	id, err := readHeader(file)
+	if errors.Is(err, io.EOF) {
+		return 0, nil
+	}
+	return id, err
+}
+
+// Replay reads the operations stored at path starting at offset and hands them to apply in order.
+// An offset of 0 replays the whole file and a missing file is not an error and replays nothing.
+//
+// A final record cut short by a crash is truncated from the file, any other damaged record
+// is reported as an error since replaying past it would silently lose operations.
+func Replay(path string, offset int64, apply func(data.Operation) error) (Recovery, error) {
+	var rec Recovery
+
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return rec, nil
+	}
+	if err != nil {
+		return rec, fmt.Errorf("aof: failed to open %s: %w", path, err)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return rec, err
+	}
+
+	rec.ID, err = readHeader(file)
+	if errors.Is(err, io.EOF) {
+		return rec, nil
+	}
+	if err != nil {
+		return rec, err
+	}
+
+	pos := int64(headerSize)
+	if offset > pos {
+		if offset > info.Size() {
+			return rec, fmt.Errorf("aof: offset %d is past the end of %s", offset, path)
+		}
+		if _, err := file.Seek(offset, io.SeekStart); err != nil {
+			return rec, err
+		}
+		pos = offset
+	}
+
+	r := bufio.NewReader(file)
+	for {
+		op, size, err := readRecord(r)
+		if errors.Is(err, io.EOF) {
+			return rec, nil
+		}
+		if errors.Is(err, ErrTruncated) {
+			rec.Truncated = info.Size() - pos
+			if err := os.Truncate(path, pos); err != nil {
+				return rec, fmt.Errorf("aof: failed to truncate incomplete record at offset %d: %w", pos, err)
+			}
+			return rec, nil
+		}
+		if err != nil {
+			return rec, fmt.Errorf("%w at offset %d", err, pos)
+		}
+
+		if err := apply(op); err != nil {
+			return rec, fmt.Errorf("aof: failed to apply record at offset %d: %w", pos, err)
+		}
+		rec.Replayed++
+		pos += size
+	}
+}
+
+// prepare writes the header on empty files or validates it on existing ones, returning the id and size
+func prepare(file *os.File) (uint64, int64, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if info.Size() == 0 {
+		id := newID()
+		if _, err := file.Write(header(id)); err != nil {
+			return 0, 0, fmt.Errorf("aof: failed to write header: %w", err)
+		}
+		return id, int64(headerSize), file.Sync()
+	}
+
+	id, err := readHeader(file)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	size, err := file.Seek(0, io.SeekEnd)
+	return id, size, err
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+
+	return d.Sync()
+}
+
+func newID() uint64 {
+	// zero is reserved for "no file"
+	for {
+		if id := rand.Uint64(); id != 0 {
+			return id
+		}
+	}
+}
+
+func header(id uint64) []byte {
+	buf := append([]byte(magic), version)
+	return binary.BigEndian.AppendUint64(buf, id)
+}
+
+func readHeader(r io.Reader) (uint64, error) {
+	buf := make([]byte, headerSize)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		if errors.Is(err, io.EOF) {
+			return 0, io.EOF
+		}
+		return 0, ErrBadHeader
+	}
+	if !bytes.Equal(buf[:len(magic)], []byte(magic)) || buf[len(magic)] != version {
+		return 0, ErrBadHeader
+	}
+	return binary.BigEndian.Uint64(buf[len(magic)+1:]), nil
+}
+
+func encodeRecord(op data.Operation) ([]byte, error) {
+	payload, err := op.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	record := make([]byte, frameSize, frameSize+len(payload))
+	binary.BigEndian.PutUint32(record[0:4], uint32(len(payload)))
+	binary.BigEndian.PutUint32(record[4:8], crc32.Checksum(payload, crcTable))
+	binary.BigEndian.PutUint32(record[8:12], crc32.Checksum(record[0:8], crcTable))
+
+	return append(record, payload...), nil
+}
+
+// readRecord returns the operation and the size of its record. It returns io.EOF only when
+// the reader ends exactly on a record boundary and ErrTruncated when the file ends mid record,
+// inside the frame or inside the payload of a valid frame.
+func readRecord(r *bufio.Reader) (data.Operation, int64, error) {
+	var op data.Operation
+
+	frame := make([]byte, frameSize)
+	if _, err := io.ReadFull(r, frame); err != nil {
+		if errors.Is(err, io.EOF) {
+			return op, 0, io.EOF
+		}
+		return op, 0, ErrTruncated
+	}
+
+	// a crash can leave the preallocated tail of the file filled with zeros
+	if bytes.Equal(frame, make([]byte, frameSize)) && zeroTail(r) {
+		return op, 0, ErrTruncated
+	}
+
+	if crc32.Checksum(frame[0:8], crcTable) != binary.BigEndian.Uint32(frame[8:12]) {
+		return op, 0, fmt.Errorf("%w: frame checksum mismatch", ErrCorrupt)
+	}
+
+	size := binary.BigEndian.Uint32(frame[0:4])
+	if size > maxRecord {
+		return op, 0, ErrCorrupt
+	}
+
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return op, 0, ErrTruncated
+	}
+
+	if crc32.Checksum(payload, crcTable) != binary.BigEndian.Uint32(frame[4:8]) {
+		return op, 0, ErrChecksum
+	}
+
+	if err := op.UnmarshalBinary(payload); err != nil {
+		return op, 0, fmt.Errorf("%w: %v", ErrCorrupt, err)
+	}
+
+	return op, int64(frameSize + len(payload)), nil
+}
+
+// zeroTail consumes the reader and reports whether everything left was zero bytes
+func zeroTail(r *bufio.Reader) bool {
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			return true
+		}
+		if b != 0 {
+			return false
+		}
+	}
+}
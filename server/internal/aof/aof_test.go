@@ -0,0 +1,158 @@
+package aof
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Lucascluz/memora-server/internal/data"
+)
+
+// writeRecords appends n operations to a new file and returns its path and the offset every
+// record starts at
+func writeRecords(t *testing.T, n int) (string, []int64) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "test.aof")
+	a, err := Open(path, FsyncNever)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	offsets := make([]int64, n)
+	for i := range n {
+		offsets[i] = a.Size()
+		op := data.Operation{Op: data.OpSet, Key: fmt.Sprint("key", i), Val: []byte(fmt.Sprint("value", i))}
+		if err := a.Append(op); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := a.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return path, offsets
+}
+
+func replayAll(path string) ([]string, Recovery, error) {
+	var keys []string
+	rec, err := Replay(path, 0, func(op data.Operation) error {
+		keys = append(keys, op.Key)
+		return nil
+	})
+	return keys, rec, err
+}
+
+func fileSize(t *testing.T, path string) int64 {
+	t.Helper()
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return info.Size()
+}
+
+func TestReplayTruncatesTornTail(t *testing.T) {
+	tests := []struct {
+		name string
+		// cut returns the size to cut the file to from the offset of the last record and the file size
+		cut func(last, size int64) int64
+	}{
+		{"inside payload", func(last, size int64) int64 { return size - 3 }},
+		{"inside frame", func(last, size int64) int64 { return last + frameSize/2 }},
+		{"after frame", func(last, size int64) int64 { return last + frameSize }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, offsets := writeRecords(t, 10)
+			last := offsets[len(offsets)-1]
+			size := tt.cut(last, fileSize(t, path))
+			if err := os.Truncate(path, size); err != nil {
+				t.Fatal(err)
+			}
+
+			keys, rec, err := replayAll(path)
+			if err != nil {
+				t.Fatalf("Replay() error = %v, want nil", err)
+			}
+			if len(keys) != 9 || rec.Replayed != 9 {
+				t.Errorf("Replay() replayed %d operations, want 9", rec.Replayed)
+			}
+			if rec.Truncated != size-last {
+				t.Errorf("Replay() truncated %d bytes, want %d", rec.Truncated, size-last)
+			}
+			if got := fileSize(t, path); got != last {
+				t.Errorf("file size after Replay() = %d, want %d", got, last)
+			}
+		})
+	}
+}
+
+func TestReplayTruncatesZeroTail(t *testing.T) {
+	path, _ := writeRecords(t, 10)
+	size := fileSize(t, path)
+
+	// a crash can leave preallocated zeros after the last record
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Write(make([]byte, 100)); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	_, rec, err := replayAll(path)
+	if err != nil || rec.Replayed != 10 || rec.Truncated != 100 {
+		t.Fatalf("Replay() = %d replayed, %d truncated, %v, want 10, 100, nil", rec.Replayed, rec.Truncated, err)
+	}
+	if got := fileSize(t, path); got != size {
+		t.Errorf("file size after Replay() = %d, want %d", got, size)
+	}
+}
+
+func TestReplayRejectsDamageMidFile(t *testing.T) {
+	tests := []struct {
+		name string
+		// offset returns the offset of the byte to flip from the offset of the damaged record
+		offset  func(record int64) int64
+		wantErr error
+	}{
+		// a larger length runs past the end of the file, like a torn tail would
+		{"length past end of file", func(record int64) int64 { return record + 1 }, ErrCorrupt},
+		{"length over maximum", func(record int64) int64 { return record }, ErrCorrupt},
+		{"payload checksum", func(record int64) int64 { return record + 4 }, ErrCorrupt},
+		{"frame checksum", func(record int64) int64 { return record + 8 }, ErrCorrupt},
+		{"payload", func(record int64) int64 { return record + frameSize + 2 }, ErrChecksum},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, offsets := writeRecords(t, 10)
+			size := fileSize(t, path)
+
+			buf, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			buf[tt.offset(offsets[3])] ^= 0x40
+			if err := os.WriteFile(path, buf, 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			_, rec, err := replayAll(path)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Replay() error = %v, want %v", err, tt.wantErr)
+			}
+			if rec.Replayed != 3 || rec.Truncated != 0 {
+				t.Errorf("Replay() = %d replayed, %d truncated, want 3, 0", rec.Replayed, rec.Truncated)
+			}
+			if got := fileSize(t, path); got != size {
+				t.Errorf("file size after Replay() = %d, want %d unchanged", got, size)
+			}
+		})
+	}
+}
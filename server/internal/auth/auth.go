@@ -0,0 +1,214 @@
+// Package auth checks the credentials clients connect with against a users file.
+//
+// The users file has one credential per line, blank lines and lines starting with # are ignored:
+//
+//	user <name> pbkdf2-sha256$<iterations>$<salt>$<hash>
+//	apikey <name> sha256$<hash>
+//
+// Salts and hashes are base64 (standard encoding, no padding) for passwords and hex for API keys.
+// Passwords are stretched with PBKDF2 since they are guessable, API keys are random so a single
+// SHA-256 is enough. Use HashPassword and HashAPIKey, or the passwd command, to produce the lines.
+package auth
+
+import (
+	"bufio"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	// Iterations is the PBKDF2 work factor of new password hashes
+	Iterations = 600000
+
+	saltSize = 16
+	keySize  = 32
+
+	passwordScheme = "pbkdf2-sha256"
+	apiKeyScheme   = "sha256"
+)
+
+var (
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrMissingCredentials = errors.New("missing credentials")
+)
+
+var b64 = base64.RawStdEncoding
+
+type password struct {
+	iterations int
+	salt       []byte
+	hash       []byte
+}
+
+// Users holds the credentials loaded from a users file
+type Users struct {
+	passwords map[string]password
+	// apiKeys maps the SHA-256 of every API key to the name it authenticates as
+	apiKeys map[[sha256.Size]byte]string
+
+	// dummy is verified for unknown users so they take as long as known ones
+	dummy password
+}
+
+// Load reads a users file
+func Load(path string) (*Users, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open users file: %w", err)
+	}
+	defer f.Close()
+
+	u := &Users{
+		passwords: make(map[string]password),
+		apiKeys:   make(map[[sha256.Size]byte]string),
+		dummy:     password{iterations: Iterations, salt: make([]byte, saltSize), hash: make([]byte, keySize)},
+	}
+
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("%s:%d: expected a kind, a name and a hash", path, line)
+		}
+
+		kind, name, hash := fields[0], fields[1], fields[2]
+		switch kind {
+		case "user":
+			p, err := parsePassword(hash)
+			if err != nil {
+				return nil, fmt.Errorf("%s:%d: %w", path, line, err)
+			}
+			u.passwords[name] = p
+		case "apikey":
+			sum, err := parseAPIKey(hash)
+			if err != nil {
+				return nil, fmt.Errorf("%s:%d: %w", path, line, err)
+			}
+			u.apiKeys[sum] = name
+		default:
+			return nil, fmt.Errorf("%s:%d: unknown credential kind %q", path, line, kind)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read users file: %w", err)
+	}
+
+	return u, nil
+}
+
+// Authenticate checks a username and password, or an API key, and returns the name they authenticate as
+func (u *Users) Authenticate(username, pass, apiKey string) (string, error) {
+	if apiKey != "" {
+		sum := sha256.Sum256([]byte(apiKey))
+		name, ok := u.apiKeys[sum]
+		if !ok {
+			return "", ErrInvalidCredentials
+		}
+		return name, nil
+	}
+
+	if username == "" {
+		return "", ErrMissingCredentials
+	}
+
+	p, ok := u.passwords[username]
+	if !ok {
+		// spend the same time as for a known user so names cannot be probed
+		u.dummy.verify(pass)
+		return "", ErrInvalidCredentials
+	}
+	if !p.verify(pass) {
+		return "", ErrInvalidCredentials
+	}
+
+	return username, nil
+}
+
+func (p password) verify(pass string) bool {
+	if p.iterations == 0 {
+		return false
+	}
+	hash, err := pbkdf2.Key(sha256.New, pass, p.salt, p.iterations, len(p.hash))
+	if err != nil {
+		return false
+	}
+	return subtle.ConstantTimeCompare(hash, p.hash) == 1
+}
+
+// HashPassword returns the users file hash of a password
+func HashPassword(pass string) (string, error) {
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	hash, err := pbkdf2.Key(sha256.New, pass, salt, Iterations, keySize)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s$%d$%s$%s", passwordScheme, Iterations, b64.EncodeToString(salt), b64.EncodeToString(hash)), nil
+}
+
+// HashAPIKey returns the users file hash of an API key
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return apiKeyScheme + "$" + hex.EncodeToString(sum[:])
+}
+
+// GenerateAPIKey returns a new random API key
+func GenerateAPIKey() (string, error) {
+	b := make([]byte, keySize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func parsePassword(s string) (password, error) {
+	parts := strings.Split(s, "$")
+	if len(parts) != 4 || parts[0] != passwordScheme {
+		return password{}, fmt.Errorf("password hash is not in the %s$<iterations>$<salt>$<hash> format", passwordScheme)
+	}
+
+	iterations, err := strconv.Atoi(parts[1])
+	if err != nil || iterations < 1 {
+		return password{}, fmt.Errorf("invalid password hash iterations %q", parts[1])
+	}
+	salt, err := b64.DecodeString(parts[2])
+	if err != nil {
+		return password{}, fmt.Errorf("invalid password hash salt: %w", err)
+	}
+	hash, err := b64.DecodeString(parts[3])
+	if err != nil || len(hash) == 0 {
+		return password{}, errors.New("invalid password hash")
+	}
+
+	return password{iterations: iterations, salt: salt, hash: hash}, nil
+}
+
+func parseAPIKey(s string) ([sha256.Size]byte, error) {
+	var sum [sha256.Size]byte
+
+	scheme, hash, ok := strings.Cut(s, "$")
+	if !ok || scheme != apiKeyScheme {
+		return sum, fmt.Errorf("api key hash is not in the %s$<hash> format", apiKeyScheme)
+	}
+	b, err := hex.DecodeString(hash)
+	if err != nil || len(b) != sha256.Size {
+		return sum, errors.New("invalid api key hash")
+	}
+
+	copy(sum[:], b)
+	return sum, nil
+}
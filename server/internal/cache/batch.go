@@ -0,0 +1,91 @@
+package cache
+
+import "time"
+
+// byShard returns the positions of n keys grouped by the index of the shard holding them, so a
+// batch visits every shard once and in the same order as lockAll
+func (c *Cache) byShard(n int, key func(i int) string) [][]int {
+	groups := make([][]int, len(c.shards))
+	for i := range n {
+		idx := c.shardIndex(key(i))
+		groups[idx] = append(groups[idx], i)
+	}
+	return groups
+}
+
+// MGet returns the values of keys and the error of each key in the same order, ErrNotFound for the
+// keys that are missing or expired and ErrWrongType for the ones holding another type than a string.
+// Each shard is read locked once for all of its keys, expired entries are left to the sweeper.
+func (c *Cache) MGet(keys []string) ([][]byte, []error) {
+	values := make([][]byte, len(keys))
+	errs := make([]error, len(keys))
+	now := time.Now().UnixMilli()
+
+	for idx, positions := range c.byShard(len(keys), func(i int) string { return keys[i] }) {
+		if len(positions) == 0 {
+			continue
+		}
+
+		s := c.shards[idx]
+		s.mu.RLock()
+		for _, i := range positions {
+			e, ok := s.store[keys[i]]
+			if !ok || e.expired(now) {
+				s.policy.access(keys[i], nil)
+				errs[i] = ErrNotFound
+				continue
+			}
+			s.policy.access(keys[i], e)
+			if e.coll != nil {
+				errs[i] = ErrWrongType
+				continue
+			}
+			values[i] = e.value
+		}
+		s.mu.RUnlock()
+	}
+
+	return values, errs
+}
+
+// MSet stores every item like Set and returns the error of each item in the same order, nil for
+// the ones that were stored. Each shard is locked once for all of its items, applied in order.
+func (c *Cache) MSet(items []Item) []error {
+	errs := make([]error, len(items))
+
+	for idx, positions := range c.byShard(len(items), func(i int) string { return items[i].Key }) {
+		if len(positions) == 0 {
+			continue
+		}
+
+		s := c.shards[idx]
+		s.mu.Lock()
+		for _, i := range positions {
+			errs[i] = s.set(items[i].Key, items[i].Value, items[i].Ttl)
+		}
+		s.mu.Unlock()
+	}
+
+	return errs
+}
+
+// MDelete deletes every key like Delete and returns the error of each key in the same order,
+// ErrNotFound for the missing ones. Each shard is locked once for all of its keys.
+func (c *Cache) MDelete(keys []string) []error {
+	errs := make([]error, len(keys))
+
+	for idx, positions := range c.byShard(len(keys), func(i int) string { return keys[i] }) {
+		if len(positions) == 0 {
+			continue
+		}
+
+		s := c.shards[idx]
+		s.mu.Lock()
+		for _, i := range positions {
+			errs[i] = s.delete(keys[i])
+		}
+		s.mu.Unlock()
+	}
+
+	return errs
+}
@@ -0,0 +1,306 @@
+package cache
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"strconv"
+
+	"github.com/Lucascluz/memora-server/internal/data"
+)
+
+const (
+	// DefaultBloomErrorRate and DefaultBloomCapacity configure the Bloom filters created by BFAdd
+	DefaultBloomErrorRate = 0.01
+	DefaultBloomCapacity  = 100
+
+	// bloomGrowth multiplies the capacity of every layer added to a full filter
+	bloomGrowth = 2
+	// bloomTightening multiplies the error rate of every layer added, so the error rates of the
+	// layers sum up to at most the error rate of the filter
+	bloomTightening = 0.5
+	// maxBloomBits bounds the bits of the first layer of a filter, 512 MB
+	maxBloomBits = 1 << 32
+	// bloomLayerOverhead approximates the memory of a layer besides its bits
+	bloomLayerOverhead = 48
+)
+
+// bloom is a scalable Bloom filter. It tells whether an item was added with no false negatives and
+// a rate of false positives bounded by errorRate, adding a larger layer with a lower error rate
+// whenever the last one holds its capacity, so it never has to be sized upfront.
+type bloom struct {
+	errorRate float64
+	layers    []*bloomLayer
+}
+
+// bloomLayer is a classic Bloom filter sized for capacity items at an error rate
+type bloomLayer struct {
+	bits     []uint64
+	nbits    uint64
+	hashes   int
+	capacity int64
+	count    int64
+}
+
+func newBloom(errorRate float64, capacity int64) *bloom {
+	b := &bloom{errorRate: errorRate}
+	b.layers = append(b.layers, newBloomLayer(capacity, b.layerErrorRate(0)))
+	return b
+}
+
+// layerErrorRate returns the error rate of the layer of index i
+func (b *bloom) layerErrorRate(i int) float64 {
+	return b.errorRate * (1 - bloomTightening) * math.Pow(bloomTightening, float64(i))
+}
+
+// bloomBits returns the number of bits and hashes of a layer for capacity items at errorRate
+func bloomBits(capacity int64, errorRate float64) (uint64, int) {
+	nbits := math.Ceil(-float64(capacity) * math.Log(errorRate) / (math.Ln2 * math.Ln2))
+	// round up to whole words
+	words := uint64(math.Ceil(nbits / 64))
+	return max(words, 1) * 64, max(int(math.Ceil(-math.Log2(errorRate))), 1)
+}
+
+func newBloomLayer(capacity int64, errorRate float64) *bloomLayer {
+	nbits, hashes := bloomBits(capacity, errorRate)
+	return &bloomLayer{bits: make([]uint64, nbits/64), nbits: nbits, hashes: hashes, capacity: capacity}
+}
+
+func (l *bloomLayer) size() int64 {
+	return int64(len(l.bits)*8) + bloomLayerOverhead
+}
+
+func (l *bloomLayer) contains(h1, h2 uint64) bool {
+	for i := range uint64(l.hashes) {
+		pos := (h1 + i*h2) % l.nbits
+		if l.bits[pos/64]&(1<<(pos%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (l *bloomLayer) add(h1, h2 uint64) {
+	for i := range uint64(l.hashes) {
+		pos := (h1 + i*h2) % l.nbits
+		l.bits[pos/64] |= 1 << (pos % 64)
+	}
+	l.count++
+}
+
+func (b *bloom) kind() Kind {
+	return KindBloom
+}
+
+func (b *bloom) size() int64 {
+	var size int64
+	for _, l := range b.layers {
+		size += l.size()
+	}
+	return size
+}
+
+func (b *bloom) contains(item string) bool {
+	h1, h2 := hashItem(item)
+	for _, l := range b.layers {
+		if l.contains(h1, h2) {
+			return true
+		}
+	}
+	return false
+}
+
+// add adds an item, reporting whether it may not have been added before
+func (b *bloom) add(item string) bool {
+	h1, h2 := hashItem(item)
+	for _, l := range b.layers {
+		if l.contains(h1, h2) {
+			return false
+		}
+	}
+
+	last := b.layers[len(b.layers)-1]
+	if last.count >= last.capacity {
+		last = newBloomLayer(last.capacity*bloomGrowth, b.layerErrorRate(len(b.layers)))
+		b.layers = append(b.layers, last)
+	}
+	last.add(h1, h2)
+	return true
+}
+
+// growth returns the memory the layers added by adding n new items would take at most
+func (b *bloom) growth(n int) int64 {
+	last := b.layers[len(b.layers)-1]
+	need := int64(n) - (last.capacity - last.count)
+	capacity, errorRate := last.capacity, b.layerErrorRate(len(b.layers)-1)
+
+	var delta int64
+	for need > 0 {
+		capacity *= bloomGrowth
+		errorRate *= bloomTightening
+		nbits, _ := bloomBits(capacity, errorRate)
+		delta += int64(nbits/8) + bloomLayerOverhead
+		need -= capacity
+	}
+	return delta
+}
+
+// encode returns the error rate followed by every layer, see data.AppendFields
+func (b *bloom) encode() []byte {
+	buf := data.AppendFields(nil, strconv.AppendFloat(nil, b.errorRate, 'g', -1, 64))
+	for _, l := range b.layers {
+		bits := make([]byte, len(l.bits)*8)
+		for i, word := range l.bits {
+			binary.LittleEndian.PutUint64(bits[i*8:], word)
+		}
+		layer := data.AppendFields(nil, strconv.AppendInt(nil, l.capacity, 10), strconv.AppendInt(nil, l.count, 10), bits)
+		buf = data.AppendFields(buf, layer)
+	}
+	return buf
+}
+
+func decodeBloom(buf []byte) (collection, error) {
+	items, err := data.SplitFields(buf)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) < 2 {
+		return nil, errors.New("bloom filter without layers")
+	}
+	errorRate, err := strconv.ParseFloat(string(items[0]), 64)
+	if err != nil || errorRate <= 0 || errorRate >= 1 {
+		return nil, errors.New("invalid bloom filter error rate")
+	}
+
+	b := &bloom{errorRate: errorRate}
+	for i, item := range items[1:] {
+		fields, err := data.SplitFields(item)
+		if err != nil || len(fields) != 3 {
+			return nil, errors.New("invalid bloom filter layer")
+		}
+		capacity, err1 := strconv.ParseInt(string(fields[0]), 10, 64)
+		count, err2 := strconv.ParseInt(string(fields[1]), 10, 64)
+		if err1 != nil || err2 != nil || capacity < 1 {
+			return nil, errors.New("invalid bloom filter layer")
+		}
+
+		l := newBloomLayer(capacity, b.layerErrorRate(i))
+		if len(fields[2]) != len(l.bits)*8 {
+			return nil, errors.New("invalid bloom filter layer bits")
+		}
+		for j := range l.bits {
+			l.bits[j] = binary.LittleEndian.Uint64(fields[2][j*8:])
+		}
+		l.count = count
+		b.layers = append(b.layers, l)
+	}
+	return b, nil
+}
+
+// checkBloom validates the error rate and capacity of a Bloom filter
+func checkBloom(errorRate float64, capacity int64) error {
+	if !(errorRate > 0 && errorRate < 1) {
+		return ErrInvalidProbability
+	}
+	if capacity < 1 {
+		return ErrInvalidCapacity
+	}
+	if nbits, _ := bloomBits(capacity, errorRate*(1-bloomTightening)); nbits > maxBloomBits {
+		return ErrInvalidCapacity
+	}
+	return nil
+}
+
+// BFReserve creates an empty Bloom filter under key without expiration, with a rate of false
+// positives of at most errorRate and a first layer sized for capacity items. It fails with
+// ErrExists when the key exists.
+func (c *Cache) BFReserve(key string, errorRate float64, capacity int64) error {
+	if err := checkBloom(errorRate, capacity); err != nil {
+		return err
+	}
+
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.lookup(key); ok {
+		return ErrExists
+	}
+
+	e := &entry{coll: newBloom(errorRate, capacity), version: c.nextVersion()}
+	val := data.AppendFields(nil, strconv.AppendFloat(nil, errorRate, 'g', -1, 64), strconv.AppendInt(nil, capacity, 10))
+	return s.replace(key, e, data.Operation{Op: data.OpBFReserve, Key: key, Val: val})
+}
+
+// BFAdd adds items to the Bloom filter under key, creating it without expiration with the default
+// error rate and capacity when the key is missing. It reports for every item whether it was added,
+// false when the filter may already hold it.
+func (c *Cache) BFAdd(key string, items ...string) ([]bool, error) {
+	if len(items) == 0 {
+		return nil, ErrNoItems
+	}
+
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	op := data.Operation{Op: data.OpBFAdd, Key: key}
+	for _, item := range items {
+		op.Val = data.AppendFields(op.Val, []byte(item))
+	}
+
+	added := make([]bool, len(items))
+	e, err := s.collection(key, KindBloom)
+	if errors.Is(err, ErrNotFound) {
+		// a missing key becomes a new filter holding the items
+		b := newBloom(DefaultBloomErrorRate, DefaultBloomCapacity)
+		for i, item := range items {
+			added[i] = b.add(item)
+		}
+		e = &entry{coll: b, version: c.nextVersion()}
+		if err := s.replace(key, e, op); err != nil {
+			return nil, err
+		}
+		return added, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	b := e.coll.(*bloom)
+	if err := s.resize(key, e, b.growth(len(items))); err != nil {
+		return nil, err
+	}
+
+	// record the operation before applying it
+	if err := c.record(op); err != nil {
+		return nil, err
+	}
+
+	before := b.size()
+	for i, item := range items {
+		added[i] = b.add(item)
+	}
+	s.changed(key, e, b.size()-before)
+	return added, nil
+}
+
+// BFExists reports for every item whether the Bloom filter under key may hold it, false for
+// every item when the key is missing
+func (c *Cache) BFExists(key string, items ...string) ([]bool, error) {
+	exists := make([]bool, len(items))
+	err := c.view(key, func(e *entry) error {
+		if e.kind() != KindBloom {
+			return ErrWrongType
+		}
+		b := e.coll.(*bloom)
+		for i, item := range items {
+			exists[i] = b.contains(item)
+		}
+		return nil
+	})
+	if errors.Is(err, ErrNotFound) {
+		return exists, nil
+	}
+	return exists, err
+}
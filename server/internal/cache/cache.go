@@ -2,77 +2,690 @@ package cache
 
 import (
 	"errors"
+	"fmt"
+	"hash/maphash"
+	"math/bits"
+	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
+
+	"github.com/Lucascluz/memora-server/internal/data"
+)
+
+var (
+	ErrNotFound   = errors.New("key not found")
+	ErrNilValue   = errors.New("cannot insert null value")
+	ErrExpired    = errors.New("cannot insert expired entry")
+	ErrInvalidTTL = errors.New("invalid ttl")
 )
 
+// ttl values are absolute expiration times in unix milliseconds, 0 means the entry never expires
 type entry struct {
 	value []byte
-	ttl   int64
+	// coll holds the value of keys of any other type than a string, nil for strings
+	coll collection
+	ttl  int64
+	// version changes on every write of the entry, see Cache.nextVersion
+	version uint64
+
+	// access is the unix nano time of the last read or write, reads update it under the read lock
+	access atomic.Int64
+	// freq is the access frequency counter kept by the LFU policies, see lfuCount
+	freq atomic.Uint32
+}
+
+// expired reports whether the entry is past its ttl at the given unix millisecond time
+func (e *entry) expired(now int64) bool {
+	return e.ttl != 0 && e.ttl <= now
+}
+
+// touch marks the entry as just used
+func (e *entry) touch() {
+	e.access.Store(time.Now().UnixNano())
+}
+
+// Item is a copy of a cache entry handed out for persistence
+type Item struct {
+	Key  string
+	Kind Kind
+	// Value is the value of strings, and the encoding of the elements of other types
+	Value []byte
+	// Ttl is the expiration time in unix milliseconds, 0 when the entry never expires
+	Ttl int64
+}
+
+// Journal records every write applied to the cache, e.g. the append only file
+type Journal interface {
+	Append(op data.Operation) error
 }
 
+// Cache splits its entries across shards picked by key hash, each with its own lock,
+// so operations on different keys do not contend with each other
 type Cache struct {
-	store map[string]entry
-	mu    sync.Mutex
+	shards []*shard
+	seed   maphash.Seed
+
+	// journal is only set while every shard is locked, so reading it under any shard lock is safe
+	journal Journal
+
+	expired atomic.Uint64
+	evicted atomic.Uint64
+	// version is the last version given to an entry
+	version atomic.Uint64
+
+	// maxMemory is split evenly between the shards, each evicting its own entries
+	maxMemory int64
+	newPolicy PolicyFactory
+
+	sweeperMu sync.Mutex
+	sweeper   *sweeper
+}
+
+// Stats holds counters describing the cache
+type Stats struct {
+	Keys       int
+	Volatile   int
+	Expired    uint64
+	Evicted    uint64
+	UsedMemory int64
+	MaxMemory  int64
 }
 
-func NewCache() *Cache {
-	return &Cache{
-		store: make(map[string]entry),
-		mu:    sync.Mutex{},
+// Option configures a cache created by NewCache
+type Option func(*Cache)
+
+// WithMaxMemory bounds the memory taken by keys, values and their overhead to maxMemory bytes.
+// Entries chosen by the eviction policy are evicted to make room for new ones, 0 means no bound.
+func WithMaxMemory(maxMemory int64) Option {
+	return func(c *Cache) {
+		c.maxMemory = maxMemory
 	}
 }
 
+// WithPolicy sets the eviction policy used once the memory bound is reached, allkeys-lru by default.
+// Every shard evicts its own entries with a policy created by newPolicy.
+func WithPolicy(newPolicy PolicyFactory) Option {
+	return func(c *Cache) {
+		c.newPolicy = newPolicy
+	}
+}
+
+// WithShards splits the cache into n shards, rounded up to a power of two. More shards let
+// more operations run in parallel while each shard gets a smaller part of the memory bound.
+func WithShards(n int) Option {
+	return func(c *Cache) {
+		c.shards = make([]*shard, 1<<bits.Len(uint(max(n, 1)-1)))
+	}
+}
+
+func NewCache(opts ...Option) *Cache {
+	c := &Cache{
+		shards:    make([]*shard, DefaultShards),
+		seed:      maphash.MakeSeed(),
+		newPolicy: func() Policy { return newLRU(PolicyAllKeysLRU, false) },
+	}
+	// versions are not persisted, starting from the clock keeps them increasing across restarts
+	c.version.Store(uint64(time.Now().UnixNano()))
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	for i := range c.shards {
+		c.shards[i] = &shard{
+			c:         c,
+			store:     make(map[string]*entry),
+			expires:   make(map[string]struct{}),
+			blocked:   make(map[string][]*waiter),
+			signals:   make(map[string]chan struct{}),
+			maxMemory: c.maxMemory / int64(len(c.shards)),
+			policy:    c.newPolicy(),
+		}
+	}
+	// a bound smaller than the shard count still bounds every shard
+	if c.maxMemory > 0 && c.maxMemory < int64(len(c.shards)) {
+		for _, s := range c.shards {
+			s.maxMemory = 1
+		}
+	}
+
+	return c
+}
+
+// SetJournal attaches the journal that will record every following write.
+// It is set after replaying so the replayed operations are not recorded twice.
+func (c *Cache) SetJournal(j Journal) {
+	c.lockAll()
+	defer c.unlockAll()
+
+	c.journal = j
+}
+
+// Set stores the value under key until ttl, given in unix milliseconds. A ttl of 0 never expires.
 func (c *Cache) Set(key string, value []byte, ttl int64) error {
-	c.mu.Lock()
-	defer c.mu.Unlock()
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.set(key, value, ttl)
+}
 
+// set stores the value under key, callers must hold s.mu
+func (s *shard) set(key string, value []byte, ttl int64) error {
 	// check if value is nil
 	if value == nil {
-		return errors.New("cannot insert null value")
+		return ErrNilValue
 	}
 
-	// check if ttl is >= 0
-	if ttl < 0 {
-		return errors.New("cannot insert expired entry")
+	// check if ttl is in the future
+	if ttl < 0 || (ttl != 0 && ttl <= time.Now().UnixMilli()) {
+		return ErrExpired
+	}
+
+	e := &entry{value: value, ttl: ttl, version: s.c.nextVersion()}
+	return s.replace(key, e, data.Operation{Op: data.OpSet, Key: key, Val: value, Ttl: ttlTime(ttl)})
+}
+
+// replace stores e under key in place of any entry of any type, recording op first. Callers must hold s.mu
+func (s *shard) replace(key string, e *entry, op data.Operation) error {
+	// make room for the entry, evicting others if needed
+	if err := s.reserve(key, e); err != nil {
+		return err
+	}
+
+	// record the operation before applying it
+	if err := s.c.record(op); err != nil {
+		return err
 	}
 
 	//set value (overrides if key already exists)
-	c.store[key] = entry{value: value, ttl: ttl}
+	s.put(key, e)
 
 	return nil
 }
 
 func (c *Cache) Get(key string) ([]byte, error) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
+	value, _, err := c.GetWithVersion(key)
+	return value, err
+}
 
-	// check if exists
-	entry, ok := c.store[key]
-	if !ok {
-		return nil, errors.New("key not found")
+// GetWithVersion returns the value stored under key along with its version
+func (c *Cache) GetWithVersion(key string) ([]byte, uint64, error) {
+	var value []byte
+	var version uint64
+	err := c.view(key, func(e *entry) error {
+		if e.coll != nil {
+			return ErrWrongType
+		}
+		value, version = e.value, e.version
+		return nil
+	})
+	return value, version, err
+}
+
+// view runs fn with the live entry stored under key, failing with ErrNotFound when there is none.
+// fn runs under the read lock of the shard so it must not change the entry.
+func (c *Cache) view(key string, fn func(e *entry) error) error {
+	// live entries are served under the read lock so reads do not serialize
+	s := c.shard(key)
+	s.mu.RLock()
+	e, ok := s.store[key]
+	if ok && !e.expired(time.Now().UnixMilli()) {
+		s.policy.access(key, e)
+		err := fn(e)
+		s.mu.RUnlock()
+		return err
 	}
+	s.policy.access(key, nil)
+	s.mu.RUnlock()
 
-	// check if expired
-	if entry.ttl < time.Now().Unix() {
-		return nil, errors.New("entry expired")
+	if !ok {
+		return ErrNotFound
 	}
 
-	return entry.value, nil
+	// expired entries are removed as soon as they are read
+	s.mu.Lock()
+	s.lookup(key)
+	s.mu.Unlock()
+
+	return ErrNotFound
 }
 
 func (c *Cache) Delete(key string) error {
-	c.mu.Lock()
-	defer c.mu.Unlock()
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
+	return s.delete(key)
+}
+
+// delete removes the entry stored under key, callers must hold s.mu
+func (s *shard) delete(key string) error {
 	// check if exists
-	_, ok := c.store[key]
+	_, ok := s.store[key]
 	if !ok {
-		return errors.New("key not found")
+		return ErrNotFound
+	}
+
+	// record the operation before applying it
+	if err := s.c.record(data.Operation{Op: data.OpDelete, Key: key}); err != nil {
+		return err
 	}
 
 	// delete entry
-	delete(c.store, key)
+	s.remove(key)
 
 	return nil
 }
+
+// TTL returns the expiration time of key in unix milliseconds, 0 when it never expires
+func (c *Cache) TTL(key string) (int64, error) {
+	s := c.shard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	e, ok := s.store[key]
+	if !ok || e.expired(time.Now().UnixMilli()) {
+		return 0, ErrNotFound
+	}
+
+	return e.ttl, nil
+}
+
+// Expire sets the expiration time of key to ttl in unix milliseconds, a ttl of 0 removes it.
+// A ttl that already passed deletes the key right away.
+func (c *Cache) Expire(key string, ttl int64) error {
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// check if ttl is valid
+	if ttl < 0 {
+		return ErrInvalidTTL
+	}
+
+	// check if exists
+	e, ok := s.lookup(key)
+	if !ok {
+		return ErrNotFound
+	}
+
+	// an expiration in the past behaves like a delete
+	if ttl != 0 && ttl <= time.Now().UnixMilli() {
+		if err := c.record(data.Operation{Op: data.OpDelete, Key: key}); err != nil {
+			return err
+		}
+		s.remove(key)
+		return nil
+	}
+
+	// record the operation before applying it
+	if err := c.record(data.Operation{Op: data.OpExpire, Key: key, Ttl: ttlTime(ttl)}); err != nil {
+		return err
+	}
+
+	e.ttl = ttl
+	e.version = c.nextVersion()
+	s.index(key, e)
+
+	return nil
+}
+
+// Persist removes the expiration of key, reporting whether it had one
+func (c *Cache) Persist(key string) (bool, error) {
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// check if exists
+	e, ok := s.lookup(key)
+	if !ok {
+		return false, ErrNotFound
+	}
+
+	if e.ttl == 0 {
+		return false, nil
+	}
+
+	// record the operation before applying it
+	if err := c.record(data.Operation{Op: data.OpExpire, Key: key}); err != nil {
+		return false, err
+	}
+
+	e.ttl = 0
+	e.version = c.nextVersion()
+	s.index(key, e)
+
+	return true, nil
+}
+
+// Apply replays an operation read from the journal
+func (c *Cache) Apply(op data.Operation) error {
+	switch op.Op {
+	case data.OpSet:
+		ttl := ttlMillis(op.Ttl)
+		if ttl != 0 && ttl <= time.Now().UnixMilli() {
+			// the entry expired since it was recorded, drop any older value instead
+			_ = c.Delete(op.Key)
+			return nil
+		}
+		return c.Set(op.Key, op.Val, ttl)
+	case data.OpDelete:
+		// a missing key means the delete was already applied
+		_ = c.Delete(op.Key)
+		return nil
+	case data.OpExpire:
+		// a missing key means it was deleted or expired after the operation was recorded
+		_ = c.Expire(op.Key, ttlMillis(op.Ttl))
+		return nil
+	case data.OpRestore:
+		if len(op.Val) == 0 {
+			return fmt.Errorf("restore operation of key %q has no type", op.Key)
+		}
+		ttl := ttlMillis(op.Ttl)
+		if ttl != 0 && ttl <= time.Now().UnixMilli() {
+			_ = c.Delete(op.Key)
+			return nil
+		}
+		return c.restore(op.Key, Kind(op.Val[0]), op.Val[1:], ttl)
+	case data.OpHSet:
+		fields, err := decodePairs(op.Val)
+		if err != nil {
+			return fmt.Errorf("invalid hset operation of key %q: %w", op.Key, err)
+		}
+		_, _, err = c.HSet(op.Key, fields)
+		return err
+	case data.OpHDel:
+		fields, err := data.SplitFields(op.Val)
+		if err != nil {
+			return fmt.Errorf("invalid hdel operation of key %q: %w", op.Key, err)
+		}
+		names := make([]string, len(fields))
+		for i, field := range fields {
+			names[i] = string(field)
+		}
+		// a missing key means it was deleted or expired after the operation was recorded
+		if _, err := c.HDel(op.Key, names...); err != nil && !errors.Is(err, ErrNotFound) {
+			return err
+		}
+		return nil
+	case data.OpLPush, data.OpRPush:
+		values, err := data.SplitFields(op.Val)
+		if err != nil {
+			return fmt.Errorf("invalid %s operation of key %q: %w", op.Op, op.Key, err)
+		}
+		_, err = c.push(op.Key, values, op.Op == data.OpLPush)
+		return err
+	case data.OpLPop, data.OpRPop:
+		count, err := strconv.Atoi(string(op.Val))
+		if err != nil {
+			return fmt.Errorf("invalid %s operation of key %q: %w", op.Op, op.Key, err)
+		}
+		// a missing key means it was deleted or expired after the operation was recorded
+		if _, err := c.pop(op.Key, count, op.Op == data.OpLPop); err != nil && !errors.Is(err, ErrNotFound) {
+			return err
+		}
+		return nil
+	case data.OpLTrim:
+		bounds, err := data.SplitFields(op.Val)
+		if err != nil || len(bounds) != 2 {
+			return fmt.Errorf("invalid ltrim operation of key %q", op.Key)
+		}
+		start, err1 := strconv.Atoi(string(bounds[0]))
+		stop, err2 := strconv.Atoi(string(bounds[1]))
+		if err1 != nil || err2 != nil {
+			return fmt.Errorf("invalid ltrim operation of key %q", op.Key)
+		}
+		return c.LTrim(op.Key, start, stop)
+	case data.OpSAdd, data.OpSRem:
+		fields, err := data.SplitFields(op.Val)
+		if err != nil {
+			return fmt.Errorf("invalid %s operation of key %q: %w", op.Op, op.Key, err)
+		}
+		members := make([]string, len(fields))
+		for i, member := range fields {
+			members[i] = string(member)
+		}
+		if op.Op == data.OpSAdd {
+			_, err = c.SAdd(op.Key, members...)
+			return err
+		}
+		// a missing key means it was deleted or expired after the operation was recorded
+		if _, err := c.SRem(op.Key, members...); err != nil && !errors.Is(err, ErrNotFound) {
+			return err
+		}
+		return nil
+	case data.OpZAdd:
+		members, err := decodeScores(op.Val)
+		if err != nil {
+			return fmt.Errorf("invalid zadd operation of key %q: %w", op.Key, err)
+		}
+		_, _, err = c.ZAdd(op.Key, members, ZAddFlags{})
+		return err
+	case data.OpZRem:
+		fields, err := data.SplitFields(op.Val)
+		if err != nil {
+			return fmt.Errorf("invalid zrem operation of key %q: %w", op.Key, err)
+		}
+		members := make([]string, len(fields))
+		for i, member := range fields {
+			members[i] = string(member)
+		}
+		// a missing key means it was deleted or expired after the operation was recorded
+		if _, err := c.ZRem(op.Key, members...); err != nil && !errors.Is(err, ErrNotFound) {
+			return err
+		}
+		return nil
+	case data.OpXAdd, data.OpXGroupCreate, data.OpXGroupDestroy, data.OpXDeliver, data.OpXAck:
+		return c.applyStream(op)
+	case data.OpPFAdd, data.OpBFAdd:
+		fields, err := data.SplitFields(op.Val)
+		if err != nil {
+			return fmt.Errorf("invalid %s operation of key %q: %w", op.Op, op.Key, err)
+		}
+		items := make([]string, len(fields))
+		for i, item := range fields {
+			items[i] = string(item)
+		}
+		if op.Op == data.OpPFAdd {
+			_, err = c.PFAdd(op.Key, items...)
+			return err
+		}
+		_, err = c.BFAdd(op.Key, items...)
+		return err
+	case data.OpBFReserve:
+		fields, err := data.SplitFields(op.Val)
+		if err != nil || len(fields) != 2 {
+			return fmt.Errorf("invalid bfreserve operation of key %q", op.Key)
+		}
+		errorRate, err1 := strconv.ParseFloat(string(fields[0]), 64)
+		capacity, err2 := strconv.ParseInt(string(fields[1]), 10, 64)
+		if err1 != nil || err2 != nil {
+			return fmt.Errorf("invalid bfreserve operation of key %q", op.Key)
+		}
+		return c.BFReserve(op.Key, errorRate, capacity)
+	case data.OpCMSInit:
+		fields, err := data.SplitFields(op.Val)
+		if err != nil || len(fields) != 2 {
+			return fmt.Errorf("invalid cmsinit operation of key %q", op.Key)
+		}
+		width, err1 := strconv.ParseUint(string(fields[0]), 10, 64)
+		depth, err2 := strconv.ParseUint(string(fields[1]), 10, 64)
+		if err1 != nil || err2 != nil {
+			return fmt.Errorf("invalid cmsinit operation of key %q", op.Key)
+		}
+		return c.CMSInitByDim(op.Key, width, depth)
+	case data.OpCMSIncrBy:
+		fields, err := data.SplitFields(op.Val)
+		if err != nil || len(fields)%2 != 0 {
+			return fmt.Errorf("invalid cmsincrby operation of key %q", op.Key)
+		}
+		items := make([]CMSItem, len(fields)/2)
+		for i := range items {
+			increment, err := strconv.ParseUint(string(fields[2*i+1]), 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid cmsincrby operation of key %q", op.Key)
+			}
+			items[i] = CMSItem{Item: string(fields[2*i]), Increment: increment}
+		}
+		// a missing key means it was deleted or expired after the operation was recorded
+		if _, err := c.CMSIncrBy(op.Key, items); err != nil && !errors.Is(err, ErrNotFound) {
+			return err
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown operation %q", op.Op)
+}
+
+// restore stores a value of any type under key in place of any entry, value being the encoding of
+// the elements for other types than strings
+func (c *Cache) restore(key string, kind Kind, value []byte, ttl int64) error {
+	if kind == KindString {
+		return c.Set(key, value, ttl)
+	}
+	coll, err := decode(kind, value)
+	if err != nil {
+		return err
+	}
+
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e := &entry{coll: coll, ttl: ttl, version: c.nextVersion()}
+	val := append([]byte{byte(kind)}, value...)
+	return s.replace(key, e, data.Operation{Op: data.OpRestore, Key: key, Val: val, Ttl: ttlTime(ttl)})
+}
+
+// Items returns a point in time copy of every live entry
+func (c *Cache) Items() []Item {
+	return c.Checkpoint(nil)
+}
+
+// Checkpoint copies every live entry after running mark under the same locks,
+// so mark observes the journal exactly at the point the copy represents.
+// The locks are only held while copying, string values are shared since they are never mutated in
+// place while the other types are encoded under the locks.
+func (c *Cache) Checkpoint(mark func()) []Item {
+	// the read locks are enough to keep writes, and so journal appends, out while copying
+	c.rlockAll()
+	defer c.runlockAll()
+
+	if mark != nil {
+		mark()
+	}
+
+	size := 0
+	for _, s := range c.shards {
+		size += len(s.store)
+	}
+
+	now := time.Now().UnixMilli()
+	items := make([]Item, 0, size)
+	for _, s := range c.shards {
+		for key, entry := range s.store {
+			if entry.expired(now) {
+				continue
+			}
+			items = append(items, Item{Key: key, Kind: entry.kind(), Value: entry.encode(), Ttl: entry.ttl})
+		}
+	}
+
+	return items
+}
+
+// Dump returns the minimal list of operations rebuilding the live entries, see Checkpoint
+func (c *Cache) Dump(mark func()) []data.Operation {
+	items := c.Checkpoint(mark)
+
+	ops := make([]data.Operation, len(items))
+	for i, item := range items {
+		if item.Kind == KindString {
+			ops[i] = data.Operation{Op: data.OpSet, Key: item.Key, Val: item.Value, Ttl: ttlTime(item.Ttl)}
+			continue
+		}
+		val := append([]byte{byte(item.Kind)}, item.Value...)
+		ops[i] = data.Operation{Op: data.OpRestore, Key: item.Key, Val: val, Ttl: ttlTime(item.Ttl)}
+	}
+
+	return ops
+}
+
+// Load inserts the items into the cache without recording them in the journal.
+// Items that do not fit in the memory bound evict older ones like any other write, items that
+// cannot be decoded are skipped.
+func (c *Cache) Load(items []Item) {
+	c.lockAll()
+	defer c.unlockAll()
+
+	now := time.Now().UnixMilli()
+	for _, item := range items {
+		e := &entry{value: item.Value, ttl: item.Ttl, version: c.nextVersion()}
+		if e.expired(now) {
+			continue
+		}
+		if item.Kind != KindString {
+			coll, err := decode(item.Kind, item.Value)
+			if err != nil {
+				continue
+			}
+			e.value, e.coll = nil, coll
+		}
+		s := c.shard(item.Key)
+		if err := s.reserve(item.Key, e); err != nil {
+			continue
+		}
+		s.put(item.Key, e)
+	}
+}
+
+// Stats returns the current counters of the cache
+func (c *Cache) Stats() Stats {
+	c.rlockAll()
+	defer c.runlockAll()
+
+	stats := Stats{
+		Expired:   c.expired.Load(),
+		Evicted:   c.evicted.Load(),
+		MaxMemory: c.maxMemory,
+	}
+	for _, s := range c.shards {
+		stats.Keys += len(s.store)
+		stats.Volatile += len(s.expires)
+		stats.UsedMemory += s.used
+	}
+
+	return stats
+}
+
+// nextVersion returns a version greater than every version given before
+func (c *Cache) nextVersion() uint64 {
+	return c.version.Add(1)
+}
+
+// record appends the operation to the journal, callers must hold the lock of the key's shard
+func (c *Cache) record(op data.Operation) error {
+	if c.journal == nil {
+		return nil
+	}
+	if err := c.journal.Append(op); err != nil {
+		return fmt.Errorf("failed to record %s operation: %w", op.Op, err)
+	}
+	return nil
+}
+
+// ttlTime converts a ttl to the time recorded in the journal, keeping 0 as the zero time
+func ttlTime(ttl int64) time.Time {
+	if ttl == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(ttl)
+}
+
+// ttlMillis is the inverse of ttlTime
+func ttlMillis(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
+}
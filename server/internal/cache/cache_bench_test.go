@@ -0,0 +1,72 @@
+package cache
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+const (
+	// benchKeys is the number of distinct keys the benchmarks pick from
+	benchKeys = 100000
+	// benchValueSize is the size of the values the benchmarks store
+	benchValueSize = 64
+)
+
+// benchShards are the shard counts every benchmark is compared for
+var benchShards = []int{1, DefaultShards, 4 * DefaultShards}
+
+var benchNames = sync.OnceValue(func() []string {
+	names := make([]string, benchKeys)
+	for i := range names {
+		names[i] = fmt.Sprintf("key:%d", i)
+	}
+	return names
+})
+
+func BenchmarkGet(b *testing.B) {
+	benchmarkWorkload(b, 100)
+}
+
+func BenchmarkSet(b *testing.B) {
+	benchmarkWorkload(b, 0)
+}
+
+func BenchmarkMixed(b *testing.B) {
+	benchmarkWorkload(b, 90)
+}
+
+// benchmarkWorkload runs reads out of 100 operations as reads and the others as writes of random
+// keys from GOMAXPROCS goroutines, for every shard count. Run with -cpu 1,4,16 to see how the
+// shards scale with cores.
+func benchmarkWorkload(b *testing.B, reads int) {
+	names := benchNames()
+	value := make([]byte, benchValueSize)
+
+	for _, n := range benchShards {
+		b.Run(fmt.Sprintf("shards=%d", n), func(b *testing.B) {
+			c := NewCache(WithShards(n))
+			for _, name := range names {
+				if err := c.Set(name, value, 0); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ResetTimer()
+
+			var seed atomic.Uint64
+			b.RunParallel(func(pb *testing.PB) {
+				rng := rand.New(rand.NewPCG(seed.Add(1), 0))
+				for pb.Next() {
+					name := names[rng.IntN(len(names))]
+					if rng.IntN(100) < reads {
+						_, _ = c.Get(name)
+					} else {
+						_ = c.Set(name, value, 0)
+					}
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestConcurrentMultiKeyOperations runs operations locking several shards at once on overlapping
+// keys, while blocked pops and checkpoints run too. Run with -race, a lock order mistake shows up
+// as a deadlock.
+func TestConcurrentMultiKeyOperations(t *testing.T) {
+	const (
+		rounds = 500
+		// pushes is the number of values pushed and popped per list
+		pushes = 200
+	)
+	c := NewCache(WithShards(4))
+
+	names := func(prefix string, n int) []string {
+		keys := make([]string, n)
+		for i := range keys {
+			keys[i] = fmt.Sprint(prefix, i)
+		}
+		return keys
+	}
+	sets, hlls, strs, lists := names("set", 6), names("hll", 6), names("str", 12), names("list", 3)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	run := func(worker func(rng *rand.Rand) error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rng := rand.New(rand.NewPCG(rand.Uint64(), 0))
+			if err := worker(rng); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	// pick returns n distinct keys in a random order
+	pick := func(rng *rand.Rand, keys []string, n int) []string {
+		perm := rng.Perm(len(keys))[:n]
+		picked := make([]string, n)
+		for i, j := range perm {
+			picked[i] = keys[j]
+		}
+		return picked
+	}
+
+	for range 2 {
+		run(func(rng *rand.Rand) error {
+			for i := range rounds {
+				if _, err := c.SAdd(sets[rng.IntN(len(sets))], fmt.Sprint(i%20), fmt.Sprint(rng.IntN(20))); err != nil {
+					return err
+				}
+				keys := pick(rng, sets, 3)
+				var err error
+				if i%2 == 0 {
+					_, err = c.SInterStore(keys[0], keys...)
+				} else {
+					_, err = c.SUnionStore(keys[0], keys[1:]...)
+				}
+				if err != nil {
+					return fmt.Errorf("store into %s: %w", keys[0], err)
+				}
+			}
+			return nil
+		})
+		run(func(rng *rand.Rand) error {
+			for i := range rounds {
+				if _, err := c.PFAdd(hlls[rng.IntN(len(hlls))], fmt.Sprint(i)); err != nil {
+					return err
+				}
+				keys := pick(rng, hlls, 3)
+				if err := c.PFMerge(keys[0], keys...); err != nil {
+					return fmt.Errorf("PFMerge() into %s: %w", keys[0], err)
+				}
+			}
+			return nil
+		})
+		run(func(rng *rand.Rand) error {
+			for i := range rounds {
+				keys := pick(rng, strs, 5)
+				items := make([]Item, len(keys))
+				for j, key := range keys {
+					items[j] = Item{Key: key, Value: []byte(key)}
+				}
+				for _, err := range c.MSet(items) {
+					if err != nil {
+						return err
+					}
+				}
+				keys = pick(rng, strs, 5)
+				values, errs := c.MGet(keys)
+				for j, value := range values {
+					if errs[j] != nil && !errors.Is(errs[j], ErrNotFound) {
+						return fmt.Errorf("MGet() of %s error = %w", keys[j], errs[j])
+					}
+					if errs[j] == nil && string(value) != keys[j] {
+						return fmt.Errorf("MGet() value of %s = %q in round %d, want its name", keys[j], value, i)
+					}
+				}
+			}
+			return nil
+		})
+		run(func(*rand.Rand) error {
+			for range rounds / 10 {
+				marks := 0
+				items := c.Checkpoint(func() { marks++ })
+				if marks != 1 {
+					return fmt.Errorf("Checkpoint() ran mark %d times, want once", marks)
+				}
+				// every copy is a consistent encoding that loads back
+				restored := NewCache()
+				restored.Load(items)
+				if got := len(restored.Items()); got != len(items) {
+					return fmt.Errorf("loaded %d of %d checkpoint entries", got, len(items))
+				}
+			}
+			return nil
+		})
+	}
+
+	// every value pushed is popped exactly once by poppers blocked on the lists in any order
+	popped := make(chan string, pushes*len(lists))
+	for _, key := range lists {
+		run(func(*rand.Rand) error {
+			for i := range pushes {
+				if _, err := c.RPush(key, []byte(fmt.Sprint(key, "-", i))); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+	for range len(lists) {
+		run(func(rng *rand.Rand) error {
+			for range pushes {
+				_, value, err := c.BPop(ctx, pick(rng, lists, len(lists)), rng.IntN(2) == 0)
+				if err != nil {
+					return fmt.Errorf("BPop() error = %w", err)
+				}
+				popped <- string(value)
+			}
+			return nil
+		})
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		t.Fatal("operations did not finish, want no deadlock")
+	}
+
+	close(popped)
+	seen := make(map[string]bool)
+	for value := range popped {
+		if seen[value] {
+			t.Errorf("BPop() returned %s twice", value)
+		}
+		seen[value] = true
+	}
+	if len(seen) != pushes*len(lists) {
+		t.Errorf("BPop() returned %d values, want %d", len(seen), pushes*len(lists))
+	}
+}
@@ -0,0 +1,219 @@
+package cache
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"strconv"
+
+	"github.com/Lucascluz/memora-server/internal/data"
+)
+
+const (
+	// maxCMSCounters bounds the counters of a sketch, 128 MB
+	maxCMSCounters = 1 << 24
+	// cmsOverhead approximates the memory of a sketch besides its counters
+	cmsOverhead = 48
+)
+
+// CMSItem is an item of a Count-Min Sketch along with the count to add to it
+type CMSItem struct {
+	Item      string
+	Increment uint64
+}
+
+// cms is a Count-Min Sketch. It estimates how many times every item was counted from depth rows
+// of width counters, each row hashing the item to one of its counters. The estimate is the
+// smallest of these counters: it never undercounts, and overcounts by at most e/width of the total
+// count with a probability of at least 1 - e^-depth.
+type cms struct {
+	width    uint64
+	depth    uint64
+	counters []uint64
+	total    uint64
+}
+
+func newCMS(width, depth uint64) *cms {
+	return &cms{width: width, depth: depth, counters: make([]uint64, width*depth)}
+}
+
+// cmsDimensions returns the width and depth of a sketch overcounting by at most errorRate of
+// the total count with a probability of at least 1 - probability
+func cmsDimensions(errorRate, probability float64) (uint64, uint64) {
+	return uint64(math.Ceil(math.E / errorRate)), uint64(math.Ceil(math.Log(1 / probability)))
+}
+
+func (sk *cms) kind() Kind {
+	return KindCMS
+}
+
+func (sk *cms) size() int64 {
+	return int64(len(sk.counters)*8) + cmsOverhead
+}
+
+// cells returns the index of the counter of item in every row
+func (sk *cms) cells(item string) []uint64 {
+	h1, h2 := hashItem(item)
+	cells := make([]uint64, sk.depth)
+	for row := range sk.depth {
+		cells[row] = row*sk.width + (h1+row*h2)%sk.width
+	}
+	return cells
+}
+
+func (sk *cms) estimate(cells []uint64) uint64 {
+	count := uint64(math.MaxUint64)
+	for _, cell := range cells {
+		count = min(count, sk.counters[cell])
+	}
+	return count
+}
+
+func (sk *cms) encode() []byte {
+	counters := make([]byte, len(sk.counters)*8)
+	for i, counter := range sk.counters {
+		binary.LittleEndian.PutUint64(counters[i*8:], counter)
+	}
+	return data.AppendFields(nil, strconv.AppendUint(nil, sk.width, 10), strconv.AppendUint(nil, sk.depth, 10),
+		strconv.AppendUint(nil, sk.total, 10), counters)
+}
+
+func decodeCMS(buf []byte) (collection, error) {
+	items, err := data.SplitFields(buf)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) != 4 {
+		return nil, errors.New("invalid sketch")
+	}
+	width, err1 := strconv.ParseUint(string(items[0]), 10, 64)
+	depth, err2 := strconv.ParseUint(string(items[1]), 10, 64)
+	total, err3 := strconv.ParseUint(string(items[2]), 10, 64)
+	if err1 != nil || err2 != nil || err3 != nil || checkCMS(width, depth) != nil {
+		return nil, errors.New("invalid sketch dimensions")
+	}
+
+	sk := newCMS(width, depth)
+	if len(items[3]) != len(sk.counters)*8 {
+		return nil, errors.New("invalid sketch counters")
+	}
+	for i := range sk.counters {
+		sk.counters[i] = binary.LittleEndian.Uint64(items[3][i*8:])
+	}
+	sk.total = total
+	return sk, nil
+}
+
+// checkCMS validates the dimensions of a sketch
+func checkCMS(width, depth uint64) error {
+	if width < 1 || depth < 1 || width > maxCMSCounters || depth > maxCMSCounters/width {
+		return ErrInvalidDimensions
+	}
+	return nil
+}
+
+// CMSInitByDim creates a Count-Min Sketch under key without expiration with depth rows of width
+// counters. It fails with ErrExists when the key exists.
+func (c *Cache) CMSInitByDim(key string, width, depth uint64) error {
+	if err := checkCMS(width, depth); err != nil {
+		return err
+	}
+
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.lookup(key); ok {
+		return ErrExists
+	}
+
+	e := &entry{coll: newCMS(width, depth), version: c.nextVersion()}
+	val := data.AppendFields(nil, strconv.AppendUint(nil, width, 10), strconv.AppendUint(nil, depth, 10))
+	return s.replace(key, e, data.Operation{Op: data.OpCMSInit, Key: key, Val: val})
+}
+
+// CMSInitByProb creates a Count-Min Sketch under key like CMSInitByDim, sized to overcount an item
+// by at most errorRate of the total count with a probability of at least 1 - probability
+func (c *Cache) CMSInitByProb(key string, errorRate, probability float64) error {
+	if !(errorRate > 0 && errorRate < 1) || !(probability > 0 && probability < 1) {
+		return ErrInvalidProbability
+	}
+	width, depth := cmsDimensions(errorRate, probability)
+	return c.CMSInitByDim(key, width, depth)
+}
+
+// CMSIncrBy adds increments to the counts of items in the Count-Min Sketch under key and returns
+// their new estimated counts. It fails with ErrOverflow, counting nothing, when a counter would overflow.
+func (c *Cache) CMSIncrBy(key string, items []CMSItem) ([]uint64, error) {
+	if len(items) == 0 {
+		return nil, ErrNoItems
+	}
+
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.collection(key, KindCMS)
+	if err != nil {
+		return nil, err
+	}
+
+	// check every counter first, an item may be given several times
+	sk := e.coll.(*cms)
+	cells := make([][]uint64, len(items))
+	added := make(map[uint64]uint64)
+	total := sk.total
+	for i, item := range items {
+		cells[i] = sk.cells(item.Item)
+		for _, cell := range cells[i] {
+			if sk.counters[cell]+added[cell] > math.MaxUint64-item.Increment {
+				return nil, ErrOverflow
+			}
+			added[cell] += item.Increment
+		}
+		if total > math.MaxUint64-item.Increment {
+			return nil, ErrOverflow
+		}
+		total += item.Increment
+	}
+
+	// record the operation before applying it
+	var val []byte
+	for _, item := range items {
+		val = data.AppendFields(val, []byte(item.Item), strconv.AppendUint(nil, item.Increment, 10))
+	}
+	if err := c.record(data.Operation{Op: data.OpCMSIncrBy, Key: key, Val: val}); err != nil {
+		return nil, err
+	}
+
+	for cell, increment := range added {
+		sk.counters[cell] += increment
+	}
+	sk.total = total
+	s.changed(key, e, 0)
+
+	counts := make([]uint64, len(items))
+	for i := range items {
+		counts[i] = sk.estimate(cells[i])
+	}
+	return counts, nil
+}
+
+// CMSQuery returns the estimated counts of items in the Count-Min Sketch under key, along with the
+// total count of the sketch, e.g. to tell the heavy hitters counted more than a share of the total
+func (c *Cache) CMSQuery(key string, items ...string) ([]uint64, uint64, error) {
+	counts := make([]uint64, len(items))
+	var total uint64
+	err := c.view(key, func(e *entry) error {
+		if e.kind() != KindCMS {
+			return ErrWrongType
+		}
+		sk := e.coll.(*cms)
+		for i, item := range items {
+			counts[i] = sk.estimate(sk.cells(item))
+		}
+		total = sk.total
+		return nil
+	})
+	return counts, total, err
+}
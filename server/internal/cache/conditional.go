@@ -0,0 +1,106 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrExists          = errors.New("key already exists")
+	ErrVersionMismatch = errors.New("version does not match")
+)
+
+// Condition tells when SetIf stores its value
+type Condition int
+
+const (
+	// Always stores the value like Set
+	Always Condition = iota
+	// IfAbsent only stores the value when the key does not exist
+	IfAbsent
+	// IfPresent only stores the value when the key exists
+	IfPresent
+	// IfVersion only stores the value when the version of the entry matches, 0 matching a missing key
+	IfVersion
+)
+
+// SetIf stores the value under key like Set when cond holds, and returns the version of the new entry.
+// It fails with ErrExists, ErrNotFound or ErrVersionMismatch when cond does not hold, version is
+// only compared by IfVersion.
+func (c *Cache) SetIf(key string, value []byte, ttl int64, cond Condition, version uint64) (uint64, error) {
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// the condition and the write happen under the same lock, so no other write gets in between
+	e, ok := s.lookup(key)
+	switch cond {
+	case Always:
+	case IfAbsent:
+		if ok {
+			return 0, ErrExists
+		}
+	case IfPresent:
+		if !ok {
+			return 0, ErrNotFound
+		}
+	case IfVersion:
+		var current uint64
+		if ok {
+			current = e.version
+		}
+		if current != version {
+			return 0, ErrVersionMismatch
+		}
+	default:
+		return 0, fmt.Errorf("unknown set condition %d", cond)
+	}
+
+	if err := s.set(key, value, ttl); err != nil {
+		return 0, err
+	}
+	return s.store[key].version, nil
+}
+
+// GetSet stores the value under key like Set and returns the value it replaced, nil when the key did
+// not exist, along with the version of the new entry. It fails with ErrWrongType when the key holds
+// another type than a string.
+func (c *Cache) GetSet(key string, value []byte, ttl int64) ([]byte, uint64, error) {
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var old []byte
+	if e, ok := s.lookup(key); ok {
+		if e.coll != nil {
+			return nil, 0, ErrWrongType
+		}
+		old = e.value
+	}
+
+	if err := s.set(key, value, ttl); err != nil {
+		return nil, 0, err
+	}
+	return old, s.store[key].version, nil
+}
+
+// GetDel deletes key and returns the value it held along with its version. It fails with
+// ErrWrongType when the key holds another type than a string.
+func (c *Cache) GetDel(key string) ([]byte, uint64, error) {
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.lookup(key)
+	if !ok {
+		return nil, 0, ErrNotFound
+	}
+	if e.coll != nil {
+		return nil, 0, ErrWrongType
+	}
+
+	if err := s.delete(key); err != nil {
+		return nil, 0, err
+	}
+	return e.value, e.version, nil
+}
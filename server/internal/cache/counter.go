@@ -0,0 +1,99 @@
+package cache
+
+import (
+	"errors"
+	"math"
+	"strconv"
+)
+
+var (
+	ErrNotInteger = errors.New("value is not an integer")
+	ErrNotFloat   = errors.New("value is not a valid float")
+	ErrOverflow   = errors.New("increment would overflow")
+)
+
+// IncrBy adds delta to the integer stored as decimal text under key and returns the result with
+// the version of the entry. A missing key is created at 0 expiring at ttl, an existing key keeps
+// its ttl unless replaceTTL is set.
+func (c *Cache) IncrBy(key string, delta int64, ttl int64, replaceTTL bool) (int64, uint64, error) {
+	var result int64
+	version, err := c.update(key, ttl, replaceTTL, func(old []byte) ([]byte, error) {
+		var n int64
+		if old != nil {
+			parsed, err := strconv.ParseInt(string(old), 10, 64)
+			if err != nil {
+				return nil, ErrNotInteger
+			}
+			n = parsed
+		}
+
+		sum, err := addInt(n, delta)
+		if err != nil {
+			return nil, err
+		}
+		result = sum
+		return strconv.AppendInt(nil, result, 10), nil
+	})
+	return result, version, err
+}
+
+// IncrByFloat adds delta to the number stored as decimal text under key like IncrBy. The result
+// is stored without exponent, and fails with ErrOverflow when it is not finite.
+func (c *Cache) IncrByFloat(key string, delta float64, ttl int64, replaceTTL bool) (float64, uint64, error) {
+	var result float64
+	version, err := c.update(key, ttl, replaceTTL, func(old []byte) ([]byte, error) {
+		var n float64
+		if old != nil {
+			parsed, err := strconv.ParseFloat(string(old), 64)
+			if err != nil || math.IsNaN(parsed) || math.IsInf(parsed, 0) {
+				return nil, ErrNotFloat
+			}
+			n = parsed
+		}
+
+		result = n + delta
+		if math.IsNaN(result) || math.IsInf(result, 0) {
+			return nil, ErrOverflow
+		}
+		return strconv.AppendFloat(nil, result, 'f', -1, 64), nil
+	})
+	return result, version, err
+}
+
+// update replaces the value stored under key with the one fn computes from the current value,
+// nil when the key is missing, and returns the version of the new entry. A missing key is created
+// expiring at ttl, an existing key keeps its ttl unless replaceTTL is set.
+func (c *Cache) update(key string, ttl int64, replaceTTL bool, fn func(old []byte) ([]byte, error)) (uint64, error) {
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var old []byte
+	if e, ok := s.lookup(key); ok {
+		if e.coll != nil {
+			return 0, ErrWrongType
+		}
+		old = e.value
+		if !replaceTTL {
+			ttl = e.ttl
+		}
+	}
+
+	value, err := fn(old)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := s.set(key, value, ttl); err != nil {
+		return 0, err
+	}
+	return s.store[key].version, nil
+}
+
+// addInt returns n + delta, failing with ErrOverflow when it does not fit in an int64
+func addInt(n, delta int64) (int64, error) {
+	if (delta > 0 && n > math.MaxInt64-delta) || (delta < 0 && n < math.MinInt64-delta) {
+		return 0, ErrOverflow
+	}
+	return n + delta, nil
+}
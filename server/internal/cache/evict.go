@@ -0,0 +1,197 @@
+package cache
+
+import (
+	"errors"
+	"slices"
+	"sort"
+
+	"github.com/Lucascluz/memora-server/internal/data"
+)
+
+const (
+	// entryOverhead approximates the bookkeeping memory of an entry besides its key and value:
+	// the map slot, the entry struct and its expires index slot
+	entryOverhead = 64
+
+	// evictionSamples is how many keys are sampled into the eviction pool for each eviction
+	evictionSamples = 5
+	// evictionPoolSize is how many of the best candidates are kept between evictions
+	evictionPoolSize = 16
+)
+
+var ErrOutOfMemory = errors.New("not enough memory to store the entry")
+
+// entrySize is the memory accounted for an entry
+func entrySize(key string, e *entry) int64 {
+	size := int64(len(key) + len(e.value) + entryOverhead)
+	if e.coll != nil {
+		size += e.coll.size()
+	}
+	return size
+}
+
+// reserve evicts entries chosen by the eviction policy until e fits under key within the memory
+// bound of the shard. The entry currently stored under key is never evicted since e replaces it.
+// Callers must hold s.mu.
+func (s *shard) reserve(key string, e *entry) error {
+	var old int64
+	if cur, ok := s.store[key]; ok {
+		old = entrySize(key, cur)
+	}
+	return s.grow(key, old, entrySize(key, e))
+}
+
+// grow evicts entries chosen by the eviction policy until the entry under key fits within the
+// memory bound of the shard once its size goes from old to size bytes. Callers must hold s.mu.
+func (s *shard) grow(key string, old, size int64) error {
+	if s.maxMemory == 0 {
+		return nil
+	}
+
+	// an entry larger than the bound would only empty the shard before failing
+	if size > s.maxMemory {
+		return ErrOutOfMemory
+	}
+
+	need := size - old
+	for s.used+need > s.maxMemory {
+		victim, ok := s.policy.victim(sampler{s: s, skip: key})
+		if !ok {
+			return ErrOutOfMemory
+		}
+
+		// evictions are recorded so replaying the journal stays within the bound too
+		if err := s.c.record(data.Operation{Op: data.OpDelete, Key: victim}); err != nil {
+			return err
+		}
+		s.remove(victim)
+		s.c.evicted.Add(1)
+	}
+
+	return nil
+}
+
+// sampler hands random entries to a policy choosing an eviction victim, never the key being written.
+// Map iteration starts at a random position, which makes the samples random.
+type sampler struct {
+	s    *shard
+	skip string
+}
+
+// sample calls fn with up to n random entries, only entries with a ttl when volatile is set
+func (sp sampler) sample(n int, volatile bool, fn func(key string, e *entry)) {
+	sampled := 0
+	visit := func(key string, e *entry) bool {
+		if sampled == n {
+			return false
+		}
+		if key != sp.skip {
+			sampled++
+			fn(key, e)
+		}
+		return true
+	}
+
+	if volatile {
+		for key := range sp.s.expires {
+			if !visit(key, sp.s.store[key]) {
+				return
+			}
+		}
+		return
+	}
+
+	for key, e := range sp.s.store {
+		if !visit(key, e) {
+			return
+		}
+	}
+}
+
+// get returns the entry stored under key unless it is the key being written
+func (sp sampler) get(key string) (*entry, bool) {
+	if key == sp.skip {
+		return nil, false
+	}
+	e, ok := sp.s.store[key]
+	return e, ok
+}
+
+// scoreFunc ranks an entry for eviction, the lowest score is evicted first.
+// It returns false for entries that must not be evicted.
+type scoreFunc func(key string, e *entry) (int64, bool)
+
+// sampleVictim approximates evicting the lowest scored entry like Redis does: every call samples
+// a few random entries into a pool of the best candidates seen so far, and the best candidate
+// that is still stored with the same score is evicted.
+func sampleVictim(sp sampler, pool *evictionPool, volatile bool, score scoreFunc) (string, bool) {
+	for {
+		offered := 0
+		sp.sample(evictionSamples, volatile, func(key string, e *entry) {
+			if sc, ok := score(key, e); ok {
+				pool.offer(key, sc)
+				offered++
+			}
+		})
+
+		// candidates may have been removed or used again since they entered the pool
+		for {
+			cand, ok := pool.pop()
+			if !ok {
+				break
+			}
+			e, ok := sp.get(cand.key)
+			if !ok {
+				continue
+			}
+			if sc, ok := score(cand.key, e); !ok || sc != cand.score {
+				continue
+			}
+			return cand.key, true
+		}
+
+		// the fresh samples were stale too only if there was nothing to offer
+		if offered == 0 {
+			return "", false
+		}
+	}
+}
+
+type candidate struct {
+	key   string
+	score int64
+}
+
+// evictionPool keeps the best eviction candidates sorted by ascending score
+type evictionPool struct {
+	candidates []candidate
+}
+
+func (p *evictionPool) offer(key string, score int64) {
+	for i, cand := range p.candidates {
+		if cand.key == key {
+			// refresh a key that is already pooled
+			p.candidates = append(p.candidates[:i], p.candidates[i+1:]...)
+			break
+		}
+	}
+
+	i := sort.Search(len(p.candidates), func(i int) bool { return p.candidates[i].score > score })
+	if i == evictionPoolSize {
+		return
+	}
+
+	p.candidates = slices.Insert(p.candidates, i, candidate{key: key, score: score})
+	if len(p.candidates) > evictionPoolSize {
+		p.candidates = p.candidates[:evictionPoolSize]
+	}
+}
+
+func (p *evictionPool) pop() (candidate, bool) {
+	if len(p.candidates) == 0 {
+		return candidate{}, false
+	}
+	cand := p.candidates[0]
+	p.candidates = p.candidates[1:]
+	return cand, true
+}
@@ -0,0 +1,135 @@
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// sweepSample is how many keys with a ttl are checked per lock acquisition
+	sweepSample = 20
+	// sweepRepeat keeps a cycle going while more than 1/sweepRepeat of the sample was expired
+	sweepRepeat = 4
+	// sweepBudget is the fraction of the interval a single cycle may spend sweeping
+	sweepBudget = 4
+)
+
+type sweeper struct {
+	done chan struct{}
+	stop sync.Once
+	wg   sync.WaitGroup
+
+	// next is the shard the following cycle starts from when a cycle ran out of time
+	next int
+}
+
+// StartSweeper actively removes expired entries every interval, similar to the Redis
+// sampling expiry: each cycle samples keys with a ttl, removes the expired ones and samples
+// again while many of them were expired, within a time budget of a quarter of the interval.
+// Shards are swept in turn and a shard lock is only held for one sample at a time, so readers
+// never wait for a whole cycle. A sweeper that is already running is stopped and replaced.
+func (c *Cache) StartSweeper(interval time.Duration) {
+	s := &sweeper{done: make(chan struct{})}
+
+	c.sweeperMu.Lock()
+	old := c.sweeper
+	c.sweeper = s
+	c.sweeperMu.Unlock()
+
+	if old != nil {
+		old.halt()
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				c.sweep(s, interval/sweepBudget)
+			case <-s.done:
+				return
+			}
+		}
+	}()
+}
+
+// StopSweeper stops the sweeper started by StartSweeper and waits for its cycle to end
+func (c *Cache) StopSweeper() {
+	c.sweeperMu.Lock()
+	s := c.sweeper
+	c.sweeper = nil
+	c.sweeperMu.Unlock()
+
+	if s != nil {
+		s.halt()
+	}
+}
+
+// halt ends the goroutine of s and waits for its cycle to end
+func (s *sweeper) halt() {
+	s.stop.Do(func() { close(s.done) })
+	s.wg.Wait()
+}
+
+// sweep runs one expiry cycle over every shard and returns the number of removed entries
+func (c *Cache) sweep(sw *sweeper, budget time.Duration) int {
+	deadline := time.Now().Add(budget)
+	removed := 0
+
+	for range c.shards {
+		s := c.shards[sw.next]
+		sw.next = (sw.next + 1) % len(c.shards)
+
+		for {
+			sampled, expired := s.sweepSample()
+			removed += expired
+
+			// move on once the sample shows few expired keys are left in the shard
+			if sampled < sweepSample || expired*sweepRepeat <= sampled {
+				break
+			}
+			if time.Now().After(deadline) {
+				return removed
+			}
+		}
+
+		if time.Now().After(deadline) {
+			return removed
+		}
+	}
+
+	return removed
+}
+
+// sweepSample checks up to sweepSample keys with a ttl and removes the expired ones.
+// Map iteration starts at a random position, which makes the sample random.
+func (s *shard) sweepSample() (int, int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now().UnixMilli()
+	sampled, expired := 0, 0
+
+	// expired entries are not recorded in the journal, their absolute ttl
+	// already makes them expire again when the journal is replayed
+	for key := range s.expires {
+		if sampled == sweepSample {
+			break
+		}
+		sampled++
+
+		if s.store[key].expired(now) {
+			s.remove(key)
+			expired++
+		}
+	}
+
+	s.c.expired.Add(uint64(expired))
+
+	return sampled, expired
+}
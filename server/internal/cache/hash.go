@@ -0,0 +1,296 @@
+package cache
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/Lucascluz/memora-server/internal/data"
+)
+
+// fieldOverhead approximates the bookkeeping memory of a hash field besides its name and value
+const fieldOverhead = 48
+
+var (
+	ErrFieldNotFound = errors.New("field not found")
+	ErrNoFields      = errors.New("no fields given")
+)
+
+// hash maps the fields of a hash key to their values. Values are replaced, never mutated in place,
+// so they can be handed out.
+type hash struct {
+	fields map[string][]byte
+	bytes  int64
+}
+
+func newHash() *hash {
+	return &hash{fields: make(map[string][]byte)}
+}
+
+func (h *hash) kind() Kind {
+	return KindHash
+}
+
+func (h *hash) size() int64 {
+	return h.bytes
+}
+
+func (h *hash) encode() []byte {
+	return encodePairs(h.fields)
+}
+
+func decodeHash(buf []byte) (collection, error) {
+	fields, err := decodePairs(buf)
+	if err != nil {
+		return nil, err
+	}
+	h := newHash()
+	for field, value := range fields {
+		h.set(field, value)
+	}
+	return h, nil
+}
+
+// growth returns how many bytes the hash grows by once the fields are set
+func (h *hash) growth(fields map[string][]byte) int64 {
+	var delta int64
+	for field, value := range fields {
+		delta += fieldSize(field, value)
+		if old, ok := h.fields[field]; ok {
+			delta -= fieldSize(field, old)
+		}
+	}
+	return delta
+}
+
+// set stores the value of a field, reporting whether the field is new
+func (h *hash) set(field string, value []byte) bool {
+	old, exists := h.fields[field]
+	if exists {
+		h.bytes -= fieldSize(field, old)
+	}
+	h.fields[field] = value
+	h.bytes += fieldSize(field, value)
+	return !exists
+}
+
+// del removes a field, reporting whether it existed
+func (h *hash) del(field string) bool {
+	old, ok := h.fields[field]
+	if !ok {
+		return false
+	}
+	h.bytes -= fieldSize(field, old)
+	delete(h.fields, field)
+	return true
+}
+
+// fieldSize is the memory accounted for a field of a hash
+func fieldSize(field string, value []byte) int64 {
+	return int64(len(field) + len(value) + fieldOverhead)
+}
+
+// HSet stores the values of the fields of the hash under key, creating the hash without expiration
+// when the key is missing. It returns how many fields were added along with the version of the hash,
+// and fails with ErrWrongType when the key holds another type.
+func (c *Cache) HSet(key string, fields map[string][]byte) (int, uint64, error) {
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.hset(key, fields)
+}
+
+// hset stores the values of the fields of the hash under key, callers must hold s.mu
+func (s *shard) hset(key string, fields map[string][]byte) (int, uint64, error) {
+	if len(fields) == 0 {
+		return 0, 0, ErrNoFields
+	}
+	for _, value := range fields {
+		if value == nil {
+			return 0, 0, ErrNilValue
+		}
+	}
+
+	e, err := s.collection(key, KindHash)
+	if errors.Is(err, ErrNotFound) {
+		// a missing key becomes a new hash holding the fields
+		h := newHash()
+		for field, value := range fields {
+			h.set(field, value)
+		}
+		e = &entry{coll: h, version: s.c.nextVersion()}
+		if err := s.replace(key, e, hashOperation(key, fields)); err != nil {
+			return 0, 0, err
+		}
+		return len(fields), e.version, nil
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+
+	h := e.coll.(*hash)
+	delta := h.growth(fields)
+	if err := s.resize(key, e, delta); err != nil {
+		return 0, 0, err
+	}
+
+	// record the operation before applying it
+	if err := s.c.record(hashOperation(key, fields)); err != nil {
+		return 0, 0, err
+	}
+
+	added := 0
+	for field, value := range fields {
+		if h.set(field, value) {
+			added++
+		}
+	}
+	s.changed(key, e, delta)
+
+	return added, e.version, nil
+}
+
+// HGet returns the value of a field of the hash under key along with the version of the hash.
+// It fails with ErrNotFound when the key is missing and ErrFieldNotFound when the field is.
+func (c *Cache) HGet(key, field string) ([]byte, uint64, error) {
+	var value []byte
+	var version uint64
+	err := c.view(key, func(e *entry) error {
+		if e.kind() != KindHash {
+			return ErrWrongType
+		}
+		v, ok := e.coll.(*hash).fields[field]
+		if !ok {
+			return ErrFieldNotFound
+		}
+		value, version = v, e.version
+		return nil
+	})
+	return value, version, err
+}
+
+// HGetAll returns a copy of the fields of the hash under key along with the version of the hash
+func (c *Cache) HGetAll(key string) (map[string][]byte, uint64, error) {
+	var fields map[string][]byte
+	var version uint64
+	err := c.view(key, func(e *entry) error {
+		if e.kind() != KindHash {
+			return ErrWrongType
+		}
+		h := e.coll.(*hash)
+		fields = make(map[string][]byte, len(h.fields))
+		for field, value := range h.fields {
+			fields[field] = value
+		}
+		version = e.version
+		return nil
+	})
+	return fields, version, err
+}
+
+// HDel removes fields from the hash under key and returns how many existed. A hash left without
+// fields is deleted.
+func (c *Cache) HDel(key string, fields ...string) (int, error) {
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.collection(key, KindHash)
+	if err != nil {
+		return 0, err
+	}
+
+	h := e.coll.(*hash)
+	present := make(map[string]struct{}, len(fields))
+	for _, field := range fields {
+		if _, ok := h.fields[field]; ok {
+			present[field] = struct{}{}
+		}
+	}
+	if len(present) == 0 {
+		return 0, nil
+	}
+	if len(present) == len(h.fields) {
+		return len(present), s.delete(key)
+	}
+
+	// record the operation before applying it
+	names := make([][]byte, 0, len(present))
+	for field := range present {
+		names = append(names, []byte(field))
+	}
+	if err := s.c.record(data.Operation{Op: data.OpHDel, Key: key, Val: data.AppendFields(nil, names...)}); err != nil {
+		return 0, err
+	}
+
+	before := h.size()
+	for field := range present {
+		h.del(field)
+	}
+	s.changed(key, e, h.size()-before)
+
+	return len(present), nil
+}
+
+// HIncrBy adds delta to the integer stored as decimal text in a field of the hash under key and
+// returns the result with the version of the hash. Missing keys and fields are created at 0.
+func (c *Cache) HIncrBy(key, field string, delta int64) (int64, uint64, error) {
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var n int64
+	e, err := s.collection(key, KindHash)
+	switch {
+	case err == nil:
+		if old, ok := e.coll.(*hash).fields[field]; ok {
+			parsed, err := strconv.ParseInt(string(old), 10, 64)
+			if err != nil {
+				return 0, 0, ErrNotInteger
+			}
+			n = parsed
+		}
+	case !errors.Is(err, ErrNotFound):
+		return 0, 0, err
+	}
+	result, err := addInt(n, delta)
+	if err != nil {
+		return 0, 0, err
+	}
+	_, version, err := s.hset(key, map[string][]byte{field: strconv.AppendInt(nil, result, 10)})
+	if err != nil {
+		return 0, 0, err
+	}
+	return result, version, nil
+}
+
+// hashOperation returns the journal operation setting the fields of the hash under key
+func hashOperation(key string, fields map[string][]byte) data.Operation {
+	return data.Operation{Op: data.OpHSet, Key: key, Val: encodePairs(fields)}
+}
+
+// encodePairs encodes the fields of a hash as field value pairs, see data.AppendFields
+func encodePairs(fields map[string][]byte) []byte {
+	var buf []byte
+	for field, value := range fields {
+		buf = data.AppendFields(buf, []byte(field), value)
+	}
+	return buf
+}
+
+// decodePairs decodes the field value pairs of an encoded hash
+func decodePairs(buf []byte) (map[string][]byte, error) {
+	items, err := data.SplitFields(buf)
+	if err != nil {
+		return nil, err
+	}
+	if len(items)%2 != 0 {
+		return nil, errors.New("field without a value")
+	}
+
+	fields := make(map[string][]byte, len(items)/2)
+	for i := 0; i < len(items); i += 2 {
+		fields[string(items[i])] = items[i+1]
+	}
+	return fields, nil
+}
@@ -0,0 +1,263 @@
+package cache
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+	"time"
+
+	"github.com/Lucascluz/memora-server/internal/data"
+)
+
+const (
+	// hllPrecision is the number of hash bits selecting a register, for a standard error of 0.81%
+	hllPrecision = 14
+	hllRegisters = 1 << hllPrecision
+	// hllQ is the number of hash bits left to count leading zeros in once the register is selected
+	hllQ = 64 - hllPrecision
+)
+
+// hllAlpha is the bias correction constant of the estimator for a large number of registers
+var hllAlpha = 0.5 / math.Ln2
+
+// hyperloglog estimates the number of distinct elements added to it in a fixed 16 KB, every
+// register holding the longest run of zeros seen in the hashes of the elements it was selected by
+type hyperloglog struct {
+	registers []uint8
+}
+
+func newHyperLogLog() *hyperloglog {
+	return &hyperloglog{registers: make([]uint8, hllRegisters)}
+}
+
+func (h *hyperloglog) kind() Kind {
+	return KindHyperLogLog
+}
+
+func (h *hyperloglog) size() int64 {
+	return hllRegisters
+}
+
+func (h *hyperloglog) encode() []byte {
+	return append([]byte(nil), h.registers...)
+}
+
+func decodeHyperLogLog(buf []byte) (collection, error) {
+	if len(buf) != hllRegisters {
+		return nil, errors.New("invalid hyperloglog registers")
+	}
+	h := newHyperLogLog()
+	for i, r := range buf {
+		if r > hllQ+1 {
+			return nil, errors.New("invalid hyperloglog register")
+		}
+		h.registers[i] = r
+	}
+	return h, nil
+}
+
+// add adds an element, reporting whether a register changed
+func (h *hyperloglog) add(element string) bool {
+	hash, _ := hashItem(element)
+	i := hash & (hllRegisters - 1)
+	// the sentinel bit bounds the run of zeros of a hash whose remaining bits are all 0
+	run := uint8(bits.TrailingZeros64(hash>>hllPrecision|1<<hllQ) + 1)
+	if run <= h.registers[i] {
+		return false
+	}
+	h.registers[i] = run
+	return true
+}
+
+// merge makes h count the elements added to other too
+func (h *hyperloglog) merge(other *hyperloglog) {
+	for i, r := range other.registers {
+		h.registers[i] = max(h.registers[i], r)
+	}
+}
+
+// count estimates the number of distinct elements added with the estimator of Otmar Ertl, which
+// unlike the original one has no bias to correct for small or large counts
+func (h *hyperloglog) count() uint64 {
+	var histogram [hllQ + 2]int
+	for _, r := range h.registers {
+		histogram[r]++
+	}
+
+	m := float64(hllRegisters)
+	z := m * hllTau((m-float64(histogram[hllQ+1]))/m)
+	for k := hllQ; k >= 1; k-- {
+		z = (z + float64(histogram[k])) * 0.5
+	}
+	z += m * hllSigma(float64(histogram[0])/m)
+	return uint64(math.Round(hllAlpha * m * m / z))
+}
+
+func hllSigma(x float64) float64 {
+	if x == 1 {
+		return math.Inf(1)
+	}
+	y, z := 1.0, x
+	for {
+		x *= x
+		prev := z
+		z += x * y
+		y += y
+		if z == prev {
+			return z
+		}
+	}
+}
+
+func hllTau(x float64) float64 {
+	if x == 0 || x == 1 {
+		return 0
+	}
+	y, z := 1.0, 1-x
+	for {
+		x = math.Sqrt(x)
+		prev := z
+		y *= 0.5
+		z -= (1 - x) * (1 - x) * y
+		if z == prev {
+			return z / 3
+		}
+	}
+}
+
+// PFAdd adds elements to the HyperLogLog under key, creating it without expiration when the key is
+// missing, and reports whether its estimate may have changed, always true for a new key
+func (c *Cache) PFAdd(key string, elements ...string) (bool, error) {
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	op := data.Operation{Op: data.OpPFAdd, Key: key}
+	for _, element := range elements {
+		op.Val = data.AppendFields(op.Val, []byte(element))
+	}
+
+	e, err := s.collection(key, KindHyperLogLog)
+	if errors.Is(err, ErrNotFound) {
+		// a missing key becomes a new HyperLogLog holding the elements
+		h := newHyperLogLog()
+		for _, element := range elements {
+			h.add(element)
+		}
+		e = &entry{coll: h, version: c.nextVersion()}
+		return true, s.replace(key, e, op)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	// record the operation before applying it
+	if err := c.record(op); err != nil {
+		return false, err
+	}
+
+	changed := false
+	h := e.coll.(*hyperloglog)
+	for _, element := range elements {
+		if h.add(element) {
+			changed = true
+		}
+	}
+	if changed {
+		s.changed(key, e, 0)
+	}
+	return changed, nil
+}
+
+// PFCount estimates the number of distinct elements added to the HyperLogLogs under keys, counting
+// the elements found in several of them once. Missing keys count no elements.
+func (c *Cache) PFCount(keys ...string) (uint64, error) {
+	if len(keys) == 0 {
+		return 0, ErrNoKeys
+	}
+
+	shards := c.shardsOf(keys)
+	for _, s := range shards {
+		s.mu.RLock()
+	}
+	defer func() {
+		for _, s := range shards {
+			s.mu.RUnlock()
+		}
+	}()
+
+	logs, err := c.hyperloglogs(keys)
+	if err != nil {
+		return 0, err
+	}
+	if len(keys) == 1 {
+		if logs[0] == nil {
+			return 0, nil
+		}
+		return logs[0].count(), nil
+	}
+
+	union := newHyperLogLog()
+	for _, h := range logs {
+		if h != nil {
+			union.merge(h)
+		}
+	}
+	return union.count(), nil
+}
+
+// PFMerge stores under dest a HyperLogLog counting the elements of dest and of the HyperLogLogs
+// under keys, creating dest without expiration when it is missing and keeping its expiration otherwise
+func (c *Cache) PFMerge(dest string, keys ...string) error {
+	shards := c.shardsOf(append([]string{dest}, keys...))
+	for _, s := range shards {
+		s.mu.Lock()
+	}
+	defer func() {
+		for _, s := range shards {
+			s.mu.Unlock()
+		}
+	}()
+
+	logs, err := c.hyperloglogs(append([]string{dest}, keys...))
+	if err != nil {
+		return err
+	}
+
+	merged := newHyperLogLog()
+	for _, h := range logs {
+		if h != nil {
+			merged.merge(h)
+		}
+	}
+
+	s := c.shard(dest)
+	var ttl int64
+	if old, ok := s.store[dest]; ok && logs[0] != nil {
+		ttl = old.ttl
+	}
+	e := &entry{coll: merged, ttl: ttl, version: c.nextVersion()}
+	val := append([]byte{byte(KindHyperLogLog)}, merged.encode()...)
+	return s.replace(dest, e, data.Operation{Op: data.OpRestore, Key: dest, Val: val, Ttl: ttlTime(ttl)})
+}
+
+// hyperloglogs returns the HyperLogLogs stored under keys in the same order, nil for the missing
+// and expired ones. Callers must hold the locks of their shards, at least for reading.
+func (c *Cache) hyperloglogs(keys []string) ([]*hyperloglog, error) {
+	now := time.Now().UnixMilli()
+	logs := make([]*hyperloglog, len(keys))
+	for i, key := range keys {
+		s := c.shard(key)
+		e, ok := s.store[key]
+		if !ok || e.expired(now) {
+			s.policy.access(key, nil)
+			continue
+		}
+		s.policy.access(key, e)
+		if e.kind() != KindHyperLogLog {
+			return nil, &KeyError{Key: key, Err: ErrWrongType}
+		}
+		logs[i] = e.coll.(*hyperloglog)
+	}
+	return logs, nil
+}
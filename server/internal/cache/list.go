@@ -0,0 +1,469 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/Lucascluz/memora-server/internal/data"
+)
+
+// elementOverhead approximates the bookkeeping memory of a list element besides its value
+const elementOverhead = 24
+
+var (
+	ErrNoValues     = errors.New("no values given")
+	ErrInvalidCount = errors.New("count must be positive")
+)
+
+// list is a double ended queue kept in a ring buffer. Values are never mutated in place, so they
+// can be handed out.
+type list struct {
+	items [][]byte
+	head  int
+	n     int
+	bytes int64
+}
+
+func newList() *list {
+	return &list{}
+}
+
+func (l *list) kind() Kind {
+	return KindList
+}
+
+func (l *list) size() int64 {
+	return l.bytes
+}
+
+// encode returns the values from head to tail, see data.AppendFields
+func (l *list) encode() []byte {
+	var buf []byte
+	for i := range l.n {
+		buf = data.AppendFields(buf, l.at(i))
+	}
+	return buf
+}
+
+func decodeList(buf []byte) (collection, error) {
+	values, err := data.SplitFields(buf)
+	if err != nil {
+		return nil, err
+	}
+	l := newList()
+	for _, value := range values {
+		l.push(value, false)
+	}
+	return l, nil
+}
+
+// at returns the value at index i, counted from the head
+func (l *list) at(i int) []byte {
+	return l.items[(l.head+i)%len(l.items)]
+}
+
+// push adds a value to the head when front is set, to the tail otherwise
+func (l *list) push(value []byte, front bool) {
+	if l.n == len(l.items) {
+		l.resize(max(2*l.n, 4))
+	}
+	if front {
+		l.head = (l.head - 1 + len(l.items)) % len(l.items)
+		l.items[l.head] = value
+	} else {
+		l.items[(l.head+l.n)%len(l.items)] = value
+	}
+	l.n++
+	l.bytes += elementSize(value)
+}
+
+// pop removes a value from the head when front is set, from the tail otherwise. The list must not be empty.
+func (l *list) pop(front bool) []byte {
+	i := l.head
+	if front {
+		l.head = (l.head + 1) % len(l.items)
+	} else {
+		i = (l.head + l.n - 1) % len(l.items)
+	}
+	value := l.items[i]
+	l.items[i] = nil
+	l.n--
+	l.bytes -= elementSize(value)
+
+	// release the buffer once it is mostly empty
+	if len(l.items) > 4 && l.n < len(l.items)/4 {
+		l.resize(len(l.items) / 2)
+	}
+	return value
+}
+
+// trim keeps the values from index start to stop included, both within the list
+func (l *list) trim(start, stop int) {
+	kept := make([][]byte, 0, stop-start+1)
+	for i := start; i <= stop; i++ {
+		kept = append(kept, l.at(i))
+	}
+
+	*l = list{}
+	for _, value := range kept {
+		l.push(value, false)
+	}
+}
+
+// resize moves the values to a buffer of the given capacity, starting at index 0
+func (l *list) resize(capacity int) {
+	items := make([][]byte, capacity)
+	for i := range l.n {
+		items[i] = l.at(i)
+	}
+	l.items, l.head = items, 0
+}
+
+// elementSize is the memory accounted for a value of a list
+func elementSize(value []byte) int64 {
+	return int64(len(value) + elementOverhead)
+}
+
+// span converts start and stop indexes, negative ones counting from the tail, into a range within
+// a list of n values. It returns false when the range is empty.
+func span(start, stop, n int) (int, int, bool) {
+	if start < 0 {
+		start = max(start+n, 0)
+	}
+	if stop < 0 {
+		stop += n
+	}
+	stop = min(stop, n-1)
+	if start > stop || start >= n {
+		return 0, 0, false
+	}
+	return start, stop, true
+}
+
+// LPush pushes the values to the head of the list under key one after the other, so they end up
+// in reverse order, creating the list without expiration when the key is missing. It returns the
+// length of the list before waiting pops are served, see BPop.
+func (c *Cache) LPush(key string, values ...[]byte) (int, error) {
+	return c.push(key, values, true)
+}
+
+// RPush pushes the values to the tail of the list under key like LPush, so they end up in order
+func (c *Cache) RPush(key string, values ...[]byte) (int, error) {
+	return c.push(key, values, false)
+}
+
+func (c *Cache) push(key string, values [][]byte, front bool) (int, error) {
+	if len(values) == 0 {
+		return 0, ErrNoValues
+	}
+	for _, value := range values {
+		if value == nil {
+			return 0, ErrNilValue
+		}
+	}
+
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	op := data.Operation{Op: data.OpRPush, Key: key, Val: data.AppendFields(nil, values...)}
+	if front {
+		op.Op = data.OpLPush
+	}
+
+	e, err := s.collection(key, KindList)
+	if errors.Is(err, ErrNotFound) {
+		// a missing key becomes a new list holding the values
+		l := newList()
+		for _, value := range values {
+			l.push(value, front)
+		}
+		e = &entry{coll: l, version: c.nextVersion()}
+		if err := s.replace(key, e, op); err != nil {
+			return 0, err
+		}
+	} else if err != nil {
+		return 0, err
+	} else {
+		var delta int64
+		for _, value := range values {
+			delta += elementSize(value)
+		}
+		if err := s.resize(key, e, delta); err != nil {
+			return 0, err
+		}
+
+		// record the operation before applying it
+		if err := c.record(op); err != nil {
+			return 0, err
+		}
+
+		l := e.coll.(*list)
+		for _, value := range values {
+			l.push(value, front)
+		}
+		s.changed(key, e, delta)
+	}
+
+	n := e.coll.(*list).n
+	s.serve(key, e)
+	return n, nil
+}
+
+// LPop removes up to count values from the head of the list under key and returns them in the
+// order they were removed. A list left without values is deleted.
+func (c *Cache) LPop(key string, count int) ([][]byte, error) {
+	return c.pop(key, count, true)
+}
+
+// RPop removes up to count values from the tail of the list under key like LPop
+func (c *Cache) RPop(key string, count int) ([][]byte, error) {
+	return c.pop(key, count, false)
+}
+
+func (c *Cache) pop(key string, count int, front bool) ([][]byte, error) {
+	if count < 1 {
+		return nil, ErrInvalidCount
+	}
+
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.collection(key, KindList)
+	if err != nil {
+		return nil, err
+	}
+	return s.pop(key, e, count, front)
+}
+
+// pop removes up to count values from the list under key, deleting the key once the list is empty.
+// Callers must hold s.mu
+func (s *shard) pop(key string, e *entry, count int, front bool) ([][]byte, error) {
+	l := e.coll.(*list)
+	count = min(count, l.n)
+
+	// record the operation before applying it, replaying it deletes the emptied list too
+	op := data.Operation{Op: data.OpRPop, Key: key, Val: strconv.AppendInt(nil, int64(count), 10)}
+	if front {
+		op.Op = data.OpLPop
+	}
+	if err := s.c.record(op); err != nil {
+		return nil, err
+	}
+
+	values := make([][]byte, count)
+	before := l.size()
+	for i := range values {
+		values[i] = l.pop(front)
+	}
+
+	if l.n == 0 {
+		s.remove(key)
+	} else {
+		s.changed(key, e, l.size()-before)
+	}
+	return values, nil
+}
+
+// LRange returns the values of the list under key from index start to stop included, negative
+// indexes counting from the tail. A missing key is an empty list.
+func (c *Cache) LRange(key string, start, stop int) ([][]byte, error) {
+	var values [][]byte
+	err := c.view(key, func(e *entry) error {
+		if e.kind() != KindList {
+			return ErrWrongType
+		}
+		l := e.coll.(*list)
+		from, to, ok := span(start, stop, l.n)
+		if !ok {
+			return nil
+		}
+		values = make([][]byte, 0, to-from+1)
+		for i := from; i <= to; i++ {
+			values = append(values, l.at(i))
+		}
+		return nil
+	})
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	return values, err
+}
+
+// LTrim keeps the values of the list under key from index start to stop included like LRange,
+// deleting the key when the range is empty. A missing key is an empty list.
+func (c *Cache) LTrim(key string, start, stop int) error {
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.collection(key, KindList)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	l := e.coll.(*list)
+	from, to, ok := span(start, stop, l.n)
+	if !ok {
+		return s.delete(key)
+	}
+	if from == 0 && to == l.n-1 {
+		return nil
+	}
+
+	// record the operation before applying it
+	val := data.AppendFields(nil, strconv.AppendInt(nil, int64(from), 10), strconv.AppendInt(nil, int64(to), 10))
+	if err := c.record(data.Operation{Op: data.OpLTrim, Key: key, Val: val}); err != nil {
+		return err
+	}
+
+	before := l.size()
+	l.trim(from, to)
+	s.changed(key, e, l.size()-before)
+	return nil
+}
+
+// LLen returns the length of the list under key, 0 when the key is missing
+func (c *Cache) LLen(key string) (int, error) {
+	var n int
+	err := c.view(key, func(e *entry) error {
+		if e.kind() != KindList {
+			return ErrWrongType
+		}
+		n = e.coll.(*list).n
+		return nil
+	})
+	if errors.Is(err, ErrNotFound) {
+		return 0, nil
+	}
+	return n, err
+}
+
+// waiter is a pop blocked until a value is pushed under one of its keys
+type waiter struct {
+	front bool
+	// claimed is set by whoever settles the waiter first, a push handing it a value or the waiter
+	// giving up, so a value is never handed to a waiter that already returned
+	claimed atomic.Bool
+	result  chan popResult
+}
+
+type popResult struct {
+	key   string
+	value []byte
+	err   error
+}
+
+func (w *waiter) claim() bool {
+	return w.claimed.CompareAndSwap(false, true)
+}
+
+// BPop pops a value from the head when front is set, or the tail otherwise, of the first list of keys
+// that is not empty. When they all are it waits for a value to be pushed until ctx is done, returning
+// ctx.Err(). Pops blocked on the same key are served in the order they blocked, and no lock is
+// held while waiting. It returns the key the value was popped from.
+func (c *Cache) BPop(ctx context.Context, keys []string, front bool) (string, []byte, error) {
+	w := &waiter{front: front, result: make(chan popResult, 1)}
+
+	var blocked []string
+	defer func() { c.unblock(w, blocked) }()
+
+	// the waiter is registered under each key before the next one is checked, so a push to a
+	// key already checked hands its value to the waiter instead of being missed
+	for _, key := range keys {
+		s := c.shard(key)
+		s.mu.Lock()
+		e, err := s.collection(key, KindList)
+		if errors.Is(err, ErrNotFound) {
+			s.blocked[key] = append(s.blocked[key], w)
+			s.mu.Unlock()
+			blocked = append(blocked, key)
+			continue
+		}
+
+		// a push to a key registered before may have handed a value over already
+		if !w.claim() {
+			s.mu.Unlock()
+			break
+		}
+		var value []byte
+		if err == nil {
+			var values [][]byte
+			values, err = s.pop(key, e, 1, front)
+			if err == nil {
+				value = values[0]
+			}
+		}
+		s.mu.Unlock()
+		return key, value, err
+	}
+
+	select {
+	case r := <-w.result:
+		return r.key, r.value, r.err
+	case <-ctx.Done():
+	}
+
+	if w.claim() {
+		return "", nil, ctx.Err()
+	}
+	// a push handed a value over while giving up, it is already in the channel
+	r := <-w.result
+	return r.key, r.value, r.err
+}
+
+// serve hands the values of the list under key to the pops blocked on it, in the order they
+// blocked, until the list or the pops run out. Callers must hold s.mu
+func (s *shard) serve(key string, e *entry) {
+	waiters := s.blocked[key]
+	l := e.coll.(*list)
+	for len(waiters) > 0 && l.n > 0 {
+		w := waiters[0]
+		waiters = waiters[1:]
+		if !w.claim() {
+			continue
+		}
+
+		r := popResult{key: key}
+		values, err := s.pop(key, e, 1, w.front)
+		if err != nil {
+			r.err = err
+		} else {
+			r.value = values[0]
+		}
+		w.result <- r
+	}
+
+	if len(waiters) == 0 {
+		delete(s.blocked, key)
+	} else {
+		s.blocked[key] = waiters
+	}
+}
+
+// unblock removes the waiter from the pops blocked on keys
+func (c *Cache) unblock(w *waiter, keys []string) {
+	for _, key := range keys {
+		s := c.shard(key)
+		s.mu.Lock()
+		waiters := s.blocked[key]
+		for i, other := range waiters {
+			if other == w {
+				waiters = append(waiters[:i:i], waiters[i+1:]...)
+				break
+			}
+		}
+		if len(waiters) == 0 {
+			delete(s.blocked, key)
+		} else {
+			s.blocked[key] = waiters
+		}
+		s.mu.Unlock()
+	}
+}
@@ -0,0 +1,139 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// blockedPop runs BPop from the head of keys in the background and returns its result
+func blockedPop(ctx context.Context, c *Cache, keys ...string) <-chan popResult {
+	result := make(chan popResult, 1)
+	go func() {
+		key, value, err := c.BPop(ctx, keys, true)
+		result <- popResult{key: key, value: value, err: err}
+	}()
+	return result
+}
+
+// waitBlocked waits until n pops are blocked on key
+func waitBlocked(t *testing.T, c *Cache, key string, n int) {
+	t.Helper()
+
+	s := c.shard(key)
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		s.mu.Lock()
+		blocked := len(s.blocked[key])
+		s.mu.Unlock()
+		if blocked == n {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%d pops blocked on %s, want %d", blocked, key, n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func receive(t *testing.T, result <-chan popResult) popResult {
+	t.Helper()
+
+	select {
+	case r := <-result:
+		return r
+	case <-time.After(5 * time.Second):
+		t.Fatal("BPop() did not return")
+		return popResult{}
+	}
+}
+
+func TestBPopServesWaitersInOrder(t *testing.T) {
+	c := NewCache()
+	ctx := context.Background()
+
+	first := blockedPop(ctx, c, "queue")
+	waitBlocked(t, c, "queue", 1)
+	second := blockedPop(ctx, c, "queue")
+	waitBlocked(t, c, "queue", 2)
+
+	if _, err := c.RPush("queue", []byte("a"), []byte("b")); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		name   string
+		result <-chan popResult
+		want   string
+	}{
+		{"first", first, "a"},
+		{"second", second, "b"},
+	} {
+		r := receive(t, tt.result)
+		if r.err != nil || r.key != "queue" || string(r.value) != tt.want {
+			t.Errorf("%s BPop() = %s, %q, %v, want queue, %q", tt.name, r.key, r.value, r.err, tt.want)
+		}
+	}
+	waitBlocked(t, c, "queue", 0)
+}
+
+func TestBPopOnSeveralKeysServedOnce(t *testing.T) {
+	c := NewCache()
+
+	result := blockedPop(context.Background(), c, "a", "b")
+	waitBlocked(t, c, "a", 1)
+	waitBlocked(t, c, "b", 1)
+
+	// both pushes race to hand their value over, only one may
+	pushed := make(chan error, 2)
+	for _, key := range []string{"a", "b"} {
+		go func() {
+			_, err := c.RPush(key, []byte(key))
+			pushed <- err
+		}()
+	}
+	for range 2 {
+		if err := <-pushed; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	r := receive(t, result)
+	if r.err != nil || string(r.value) != r.key {
+		t.Fatalf("BPop() = %s, %q, %v, want the value of the key it popped from", r.key, r.value, r.err)
+	}
+
+	other := map[string]string{"a": "b", "b": "a"}[r.key]
+	if n, err := c.LLen(other); err != nil || n != 1 {
+		t.Errorf("LLen(%s) = %d, %v, want its value left in the list", other, n, err)
+	}
+	if n, err := c.LLen(r.key); err != nil || n != 0 {
+		t.Errorf("LLen(%s) = %d, %v, want its value popped", r.key, n, err)
+	}
+	waitBlocked(t, c, "a", 0)
+	waitBlocked(t, c, "b", 0)
+}
+
+func TestBPopCancelRemovesWaiter(t *testing.T) {
+	c := NewCache()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	result := blockedPop(ctx, c, "queue", "other")
+	waitBlocked(t, c, "queue", 1)
+
+	cancel()
+	if r := receive(t, result); !errors.Is(r.err, context.Canceled) {
+		t.Fatalf("BPop() after cancel = %q, %v, want %v", r.value, r.err, context.Canceled)
+	}
+	waitBlocked(t, c, "queue", 0)
+	waitBlocked(t, c, "other", 0)
+
+	// the value of a later push stays in the list
+	if _, err := c.RPush("queue", []byte("a")); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := c.LLen("queue"); err != nil || n != 1 {
+		t.Errorf("LLen() after cancel = %d, %v, want 1", n, err)
+	}
+}
@@ -0,0 +1,216 @@
+package cache
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"time"
+)
+
+// Eviction policy names, the allkeys policies evict any entry while the volatile
+// policies only evict entries that have a ttl
+const (
+	PolicyNoEviction     = "noeviction"
+	PolicyAllKeysLRU     = "allkeys-lru"
+	PolicyAllKeysLFU     = "allkeys-lfu"
+	PolicyAllKeysRandom  = "allkeys-random"
+	PolicyVolatileLRU    = "volatile-lru"
+	PolicyVolatileLFU    = "volatile-lfu"
+	PolicyVolatileRandom = "volatile-random"
+	PolicyVolatileTTL    = "volatile-ttl"
+	PolicyWTinyLFU       = "w-tinylfu"
+)
+
+// Policies lists every eviction policy name accepted by ParsePolicy
+var Policies = []string{
+	PolicyNoEviction,
+	PolicyAllKeysLRU,
+	PolicyAllKeysLFU,
+	PolicyAllKeysRandom,
+	PolicyVolatileLRU,
+	PolicyVolatileLFU,
+	PolicyVolatileRandom,
+	PolicyVolatileTTL,
+	PolicyWTinyLFU,
+}
+
+// Policy decides which entries of a cache shard are evicted once it is over its memory bound.
+// A policy keeps state about the entries of a single shard so it must not be shared.
+type Policy interface {
+	// Name is the name the policy is parsed from
+	Name() string
+
+	// access is called each time key is read or written, e is nil when a read missed.
+	// Reads call it under the shard read lock so it must be safe for concurrent use.
+	access(key string, e *entry)
+	// insert is called under the shard lock when key is stored and was not before
+	insert(key string, e *entry)
+	// remove is called under the shard lock when key is no longer stored
+	remove(key string)
+	// victim picks the next key to evict under the shard lock, false when none may be evicted
+	victim(s sampler) (string, bool)
+}
+
+// PolicyFactory creates a new instance of an eviction policy, one for each cache shard
+type PolicyFactory func() Policy
+
+// ParsePolicy returns the factory of an eviction policy from its name, see Policies
+func ParsePolicy(name string) (PolicyFactory, error) {
+	switch strings.ToLower(name) {
+	case PolicyNoEviction:
+		return func() Policy { return noEviction{} }, nil
+	case PolicyAllKeysLRU:
+		return func() Policy { return newLRU(PolicyAllKeysLRU, false) }, nil
+	case PolicyAllKeysLFU:
+		return func() Policy { return newLFU(PolicyAllKeysLFU, false) }, nil
+	case PolicyAllKeysRandom:
+		return func() Policy { return &randomPolicy{name: PolicyAllKeysRandom} }, nil
+	case PolicyVolatileLRU:
+		return func() Policy { return newLRU(PolicyVolatileLRU, true) }, nil
+	case PolicyVolatileLFU:
+		return func() Policy { return newLFU(PolicyVolatileLFU, true) }, nil
+	case PolicyVolatileRandom:
+		return func() Policy { return &randomPolicy{name: PolicyVolatileRandom, volatile: true} }, nil
+	case PolicyVolatileTTL:
+		return func() Policy { return newTTL() }, nil
+	case PolicyWTinyLFU:
+		return func() Policy { return newTinyLFU() }, nil
+	}
+	return nil, fmt.Errorf("unknown eviction policy %q, expected one of %s", name, strings.Join(Policies, ", "))
+}
+
+// noEviction never evicts, writes over the memory bound fail instead
+type noEviction struct{}
+
+func (noEviction) Name() string                  { return PolicyNoEviction }
+func (noEviction) access(string, *entry)         {}
+func (noEviction) insert(string, *entry)         {}
+func (noEviction) remove(string)                 {}
+func (noEviction) victim(sampler) (string, bool) { return "", false }
+
+// sampledPolicy evicts the sampled entry with the lowest score, see sampleVictim
+type sampledPolicy struct {
+	name     string
+	volatile bool
+	score    scoreFunc
+	touch    func(e *entry)
+	pool     evictionPool
+}
+
+func (p *sampledPolicy) Name() string { return p.name }
+
+func (p *sampledPolicy) access(_ string, e *entry) {
+	if e != nil && p.touch != nil {
+		p.touch(e)
+	}
+}
+
+func (p *sampledPolicy) insert(string, *entry) {}
+
+func (p *sampledPolicy) remove(string) {}
+
+func (p *sampledPolicy) victim(s sampler) (string, bool) {
+	return sampleVictim(s, &p.pool, p.volatile, p.score)
+}
+
+// newLRU evicts the entry used the longest ago
+func newLRU(name string, volatile bool) *sampledPolicy {
+	return &sampledPolicy{
+		name:     name,
+		volatile: volatile,
+		touch:    (*entry).touch,
+		score: func(_ string, e *entry) (int64, bool) {
+			return e.access.Load(), true
+		},
+	}
+}
+
+// newLFU evicts the entry used the least often, see lfuIncrement
+func newLFU(name string, volatile bool) *sampledPolicy {
+	return &sampledPolicy{
+		name:     name,
+		volatile: volatile,
+		touch:    lfuIncrement,
+		score: func(_ string, e *entry) (int64, bool) {
+			return int64(lfuCount(e.freq.Load(), lfuMinutes())), true
+		},
+	}
+}
+
+// newTTL evicts the entry closest to its expiration
+func newTTL() *sampledPolicy {
+	return &sampledPolicy{
+		name:     PolicyVolatileTTL,
+		volatile: true,
+		score: func(_ string, e *entry) (int64, bool) {
+			return e.ttl, e.ttl != 0
+		},
+	}
+}
+
+// randomPolicy evicts a random entry
+type randomPolicy struct {
+	name     string
+	volatile bool
+}
+
+func (p *randomPolicy) Name() string          { return p.name }
+func (p *randomPolicy) access(string, *entry) {}
+func (p *randomPolicy) insert(string, *entry) {}
+func (p *randomPolicy) remove(string)         {}
+
+func (p *randomPolicy) victim(s sampler) (string, bool) {
+	var victim string
+	found := false
+	s.sample(1, p.volatile, func(key string, _ *entry) {
+		victim, found = key, true
+	})
+	return victim, found
+}
+
+const (
+	// lfuInitial is the counter of new entries so they are not evicted before they had a chance to be used
+	lfuInitial = 5
+	// lfuLogFactor slows down the counter growth, about a million hits saturate it at 255
+	lfuLogFactor = 10
+	// lfuDecay is the time it takes for an unused counter to decrease by one
+	lfuDecay = time.Minute
+)
+
+// lfuMinutes is the current time in lfuDecay periods truncated to the 24 bits kept by an entry
+func lfuMinutes() uint32 {
+	return uint32(time.Now().UnixNano()/int64(lfuDecay)) & 0xffffff
+}
+
+// lfuCount decodes the logarithmic access counter of an entry, decayed by the periods elapsed
+// since its last access. Like Redis, freq holds the period of the last access in its upper 24 bits
+// and the counter in its lower 8 bits, a zero freq is a new entry.
+func lfuCount(freq, now uint32) uint8 {
+	if freq == 0 {
+		return lfuInitial
+	}
+	counter := uint8(freq)
+	elapsed := (now - freq>>8) & 0xffffff
+	if elapsed >= uint32(counter) {
+		return 0
+	}
+	return counter - uint8(elapsed)
+}
+
+// lfuIncrement records an access, incrementing the counter with a probability that shrinks as it grows
+func lfuIncrement(e *entry) {
+	now := lfuMinutes()
+	for {
+		freq := e.freq.Load()
+		counter := lfuCount(freq, now)
+		if counter < 255 {
+			base := max(float64(counter)-lfuInitial, 0)
+			if rand.Float64() < 1/(base*lfuLogFactor+1) {
+				counter++
+			}
+		}
+		if e.freq.CompareAndSwap(freq, now<<8|uint32(counter)) {
+			return
+		}
+	}
+}
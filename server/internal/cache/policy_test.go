@@ -0,0 +1,177 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// valueSize is the size of the values stored by the tests
+const valueSize = 32
+
+// boundedCache returns a single shard cache evicting with the named policy once it holds about
+// entries values of valueSize bytes
+func boundedCache(t *testing.T, name string, entries int) *Cache {
+	t.Helper()
+
+	policy, err := ParsePolicy(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewCache(WithShards(1), WithPolicy(policy), WithMaxMemory(int64(entries*(valueSize+8+entryOverhead))))
+}
+
+func value() []byte {
+	return make([]byte, valueSize)
+}
+
+func TestVolatilePoliciesOnlyEvictKeysWithTTL(t *testing.T) {
+	policies := []string{PolicyVolatileLRU, PolicyVolatileLFU, PolicyVolatileRandom, PolicyVolatileTTL}
+
+	for _, name := range policies {
+		t.Run(name, func(t *testing.T) {
+			c := boundedCache(t, name, 50)
+			later := time.Now().Add(time.Hour).UnixMilli()
+
+			persistent := 0
+			set := func() error {
+				err := c.Set(fmt.Sprintf("p%07d", persistent), value(), 0)
+				if err == nil {
+					persistent++
+				}
+				return err
+			}
+			for range 20 {
+				if err := set(); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			for i := range 500 {
+				if err := c.Set(fmt.Sprintf("v%07d", i), value(), later+int64(i)); err != nil {
+					t.Fatalf("Set() of a volatile key error = %v", err)
+				}
+			}
+			if c.Stats().Evicted == 0 {
+				t.Fatal("no key was evicted, want the volatile keys over the bound evicted")
+			}
+
+			// persistent keys take the place of the volatile ones until none are left
+			var err error
+			for range 100 {
+				if err = set(); err != nil {
+					break
+				}
+			}
+			if !errors.Is(err, ErrOutOfMemory) {
+				t.Fatalf("Set() with only persistent keys left error = %v, want %v", err, ErrOutOfMemory)
+			}
+
+			stats := c.Stats()
+			if stats.Keys != persistent || stats.Volatile != 0 {
+				t.Errorf("cache holds %d keys, %d volatile, want the %d persistent ones only", stats.Keys, stats.Volatile, persistent)
+			}
+			for i := range persistent {
+				if _, err := c.Get(fmt.Sprintf("p%07d", i)); err != nil {
+					t.Errorf("Get() of persistent key %d error = %v, want it never evicted", i, err)
+				}
+			}
+		})
+	}
+}
+
+func TestNoEvictionFailsWrites(t *testing.T) {
+	c := boundedCache(t, PolicyNoEviction, 20)
+
+	stored := 0
+	var err error
+	for ; stored < 100; stored++ {
+		if err = c.Set(fmt.Sprintf("k%07d", stored), value(), 0); err != nil {
+			break
+		}
+	}
+	if !errors.Is(err, ErrOutOfMemory) {
+		t.Fatalf("Set() over the bound error = %v, want %v", err, ErrOutOfMemory)
+	}
+	if _, err := c.Get(fmt.Sprintf("k%07d", stored)); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get() of the failed key error = %v, want %v", err, ErrNotFound)
+	}
+
+	stats := c.Stats()
+	if stats.Evicted != 0 || stats.Keys != stored {
+		t.Errorf("cache evicted %d and holds %d keys, want none evicted and %d kept", stats.Evicted, stats.Keys, stored)
+	}
+
+	// replacing a value with one of the same size still fits
+	if err := c.Set("k0000000", value(), 0); err != nil {
+		t.Errorf("Set() replacing a key error = %v, want nil", err)
+	}
+}
+
+func TestTinyLFUKeepsFrequentKey(t *testing.T) {
+	c := boundedCache(t, PolicyWTinyLFU, 500)
+
+	if err := c.Set("hot", value(), 0); err != nil {
+		t.Fatal(err)
+	}
+	for range 10 {
+		if _, err := c.Get("hot"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the key is read less often than the whole cache turns over, which LRU would evict it for
+	for i := range 20000 {
+		if i%1000 == 0 {
+			if _, err := c.Get("hot"); err != nil {
+				t.Fatalf("Get() of the frequent key after %d one-off keys error = %v", i, err)
+			}
+		}
+		if err := c.Set(fmt.Sprintf("once%07d", i), value(), 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if c.Stats().Evicted < 19000 {
+		t.Errorf("evicted %d keys, want the one-off keys evicted", c.Stats().Evicted)
+	}
+}
+
+// TestTinyLFUChallengesCandidate fills the window of a shard so a sample of the main region is
+// likely to only hit window entries, the candidate leaving the window must still compete
+func TestTinyLFUChallengesCandidate(t *testing.T) {
+	c := boundedCache(t, PolicyWTinyLFU, 0)
+	s := c.shards[0]
+	p := s.policy.(*tinyLFU)
+
+	for i := range 1000 {
+		if err := c.Set(fmt.Sprintf("k%07d", i), value(), 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for range 10 {
+		if _, err := c.Get("k0000000"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := c.Set("cold", value(), 0); err != nil {
+		t.Fatal(err)
+	}
+
+	// every key but the frequent one is in the window, with the one-off key leaving it first
+	p.window = make(map[string]uint64)
+	p.queue = []windowSlot{{key: "cold", seq: 1}}
+	p.window["cold"] = 1
+	for key := range s.store {
+		if key != "cold" && key != "k0000000" {
+			p.seq++
+			p.window[key] = p.seq
+			p.queue = append(p.queue, windowSlot{key: key, seq: p.seq})
+		}
+	}
+
+	victim, ok := p.victim(sampler{s: s, skip: "new"})
+	if !ok || victim != "cold" {
+		t.Errorf("victim() = %q, %v, want the one-off key leaving the window", victim, ok)
+	}
+}
@@ -0,0 +1,34 @@
+package cache
+
+import (
+	"errors"
+	"hash/fnv"
+)
+
+var (
+	ErrNoItems            = errors.New("no items given")
+	ErrInvalidProbability = errors.New("probability must be between 0 and 1 excluded")
+	ErrInvalidCapacity    = errors.New("capacity is out of range")
+	ErrInvalidDimensions  = errors.New("sketch dimensions are out of range")
+)
+
+// hashItem returns two 64 bit hashes of an item of a probabilistic type. Unlike the shard seed
+// they are the same across restarts, since the types persist the bits the hashes select.
+func hashItem(item string) (uint64, uint64) {
+	h := fnv.New64a()
+	h.Write([]byte(item))
+	h1 := mix64(h.Sum64())
+	// the second hash is odd so the probes of double hashing cycle through every position
+	h2 := mix64(h1^0x9e3779b97f4a7c15) | 1
+	return h1, h2
+}
+
+// mix64 spreads the bits of x over the whole word, see the finalizer of MurmurHash3
+func mix64(x uint64) uint64 {
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+	return x
+}
@@ -0,0 +1,172 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// items returns n distinct items starting with prefix
+func items(prefix string, n int) []string {
+	items := make([]string, n)
+	for i := range items {
+		items[i] = fmt.Sprint(prefix, i)
+	}
+	return items
+}
+
+func TestBloomGrowsWithoutFalseNegatives(t *testing.T) {
+	const (
+		errorRate = 0.01
+		added     = 10000
+		probes    = 100000
+	)
+	c := NewCache()
+	if err := c.BFReserve("bf", errorRate, 100); err != nil {
+		t.Fatal(err)
+	}
+
+	// the filter holds 100 times its initial capacity, in several layers
+	for _, batch := range chunks(items("in", added), 1000) {
+		if _, err := c.BFAdd("bf", batch...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if layers := len(c.shard("bf").store["bf"].coll.(*bloom).layers); layers < 5 {
+		t.Fatalf("filter has %d layers, want it grown past its capacity", layers)
+	}
+
+	exists, err := c.BFExists("bf", items("in", added)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, ok := range exists {
+		if !ok {
+			t.Fatalf("BFExists(in%d) = false, want every added item found", i)
+		}
+	}
+
+	exists, err = c.BFExists("bf", items("out", probes)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	positives := 0
+	for _, ok := range exists {
+		if ok {
+			positives++
+		}
+	}
+	if rate := float64(positives) / probes; rate > errorRate*1.2 {
+		t.Errorf("false positive rate = %.4f, want at most about %v", rate, errorRate)
+	}
+}
+
+func TestPFCountError(t *testing.T) {
+	for _, n := range []int{10000, 1000000} {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			c := NewCache()
+			for _, batch := range chunks(items("e", n), 10000) {
+				if _, err := c.PFAdd("hll", batch...); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			count, err := c.PFCount("hll")
+			if err != nil {
+				t.Fatal(err)
+			}
+			// the standard error with 2^14 registers is about 0.8%
+			if e := math.Abs(float64(count)-float64(n)) / float64(n); e > 0.03 {
+				t.Errorf("PFCount() = %d, want %d within 3%%, off by %.2f%%", count, n, e*100)
+			}
+		})
+	}
+}
+
+func TestCMSIncrByOverflow(t *testing.T) {
+	tests := []struct {
+		name  string
+		items []CMSItem
+	}{
+		{"after an item that fits", []CMSItem{{"b", 1}, {"a", 2}}},
+		{"same item several times", []CMSItem{{"b", math.MaxUint64 / 2}, {"b", math.MaxUint64 / 2}, {"b", 2}}},
+		{"single item", []CMSItem{{"b", 2}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCache()
+			if err := c.CMSInitByDim("cms", 1000, 5); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := c.CMSIncrBy("cms", []CMSItem{{"a", math.MaxUint64 - 1}}); err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := c.CMSIncrBy("cms", tt.items); !errors.Is(err, ErrOverflow) {
+				t.Fatalf("CMSIncrBy() error = %v, want %v", err, ErrOverflow)
+			}
+			counts, total, err := c.CMSQuery("cms", "a", "b")
+			if err != nil || counts[0] != math.MaxUint64-1 || counts[1] != 0 || total != math.MaxUint64-1 {
+				t.Errorf("CMSQuery() = %v, %d, %v, want nothing counted by the failed increment", counts, total, err)
+			}
+		})
+	}
+}
+
+func TestProbabilisticEncodingRoundTrip(t *testing.T) {
+	bf := newBloom(0.01, 10)
+	for _, item := range items("i", 100) {
+		bf.add(item)
+	}
+	hll := newHyperLogLog()
+	for _, element := range items("e", 1000) {
+		hll.add(element)
+	}
+	sk := newCMS(100, 4)
+	for i, item := range items("i", 50) {
+		for _, cell := range sk.cells(item) {
+			sk.counters[cell] += uint64(i)
+		}
+		sk.total += uint64(i)
+	}
+
+	tests := []struct {
+		name string
+		coll collection
+	}{
+		{"bloom", bf},
+		{"empty bloom", newBloom(0.001, 1000)},
+		{"hyperloglog", hll},
+		{"empty hyperloglog", newHyperLogLog()},
+		{"cms", sk},
+		{"empty cms", newCMS(10, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decode(tt.coll.kind(), tt.coll.encode())
+			if err != nil {
+				t.Fatalf("decode() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.coll) {
+				t.Errorf("decode() = %+v, want %+v", got, tt.coll)
+			}
+			if got.size() != tt.coll.size() {
+				t.Errorf("decode() size = %d, want %d", got.size(), tt.coll.size())
+			}
+		})
+	}
+}
+
+// chunks splits items into batches of at most n items
+func chunks(items []string, n int) [][]string {
+	var batches [][]string
+	for len(items) > n {
+		batches = append(batches, items[:n])
+		items = items[n:]
+	}
+	return append(batches, items)
+}
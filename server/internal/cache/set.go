@@ -0,0 +1,489 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"github.com/Lucascluz/memora-server/internal/data"
+)
+
+// memberOverhead approximates the bookkeeping memory of a set member besides its name
+const memberOverhead = 40
+
+var (
+	ErrNoMembers = errors.New("no members given")
+	ErrNoKeys    = errors.New("no keys given")
+)
+
+// KeyError names the key an error of a command on several keys is about
+type KeyError struct {
+	Key string
+	Err error
+}
+
+func (e *KeyError) Error() string {
+	return fmt.Sprintf("key %s: %v", e.Key, e.Err)
+}
+
+func (e *KeyError) Unwrap() error {
+	return e.Err
+}
+
+// set holds distinct members in a slice indexed by a map, so a random member is picked in constant time
+type set struct {
+	members []string
+	index   map[string]int
+	bytes   int64
+}
+
+func newSet() *set {
+	return &set{index: make(map[string]int)}
+}
+
+func (st *set) kind() Kind {
+	return KindSet
+}
+
+func (st *set) size() int64 {
+	return st.bytes
+}
+
+// encode returns the members, see data.AppendFields
+func (st *set) encode() []byte {
+	var buf []byte
+	for _, member := range st.members {
+		buf = data.AppendFields(buf, []byte(member))
+	}
+	return buf
+}
+
+func decodeSet(buf []byte) (collection, error) {
+	members, err := data.SplitFields(buf)
+	if err != nil {
+		return nil, err
+	}
+	st := newSet()
+	for _, member := range members {
+		st.add(string(member))
+	}
+	return st, nil
+}
+
+func (st *set) has(member string) bool {
+	_, ok := st.index[member]
+	return ok
+}
+
+// add adds a member, reporting whether it is new
+func (st *set) add(member string) bool {
+	if st.has(member) {
+		return false
+	}
+	st.index[member] = len(st.members)
+	st.members = append(st.members, member)
+	st.bytes += memberSize(member)
+	return true
+}
+
+// rem removes a member by moving the last one in its place, reporting whether it existed
+func (st *set) rem(member string) bool {
+	i, ok := st.index[member]
+	if !ok {
+		return false
+	}
+	last := len(st.members) - 1
+	st.members[i] = st.members[last]
+	st.index[st.members[i]] = i
+	st.members = st.members[:last]
+	delete(st.index, member)
+	st.bytes -= memberSize(member)
+	return true
+}
+
+// memberSize is the memory accounted for a member of a set
+func memberSize(member string) int64 {
+	return int64(len(member) + memberOverhead)
+}
+
+// SAdd adds members to the set under key, creating the set without expiration when the key is
+// missing. It returns how many members were added.
+func (c *Cache) SAdd(key string, members ...string) (int, error) {
+	if len(members) == 0 {
+		return 0, ErrNoMembers
+	}
+
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	op := setOperation(data.OpSAdd, key, members)
+
+	e, err := s.collection(key, KindSet)
+	if errors.Is(err, ErrNotFound) {
+		// a missing key becomes a new set holding the members
+		st := newSet()
+		for _, member := range members {
+			st.add(member)
+		}
+		e = &entry{coll: st, version: c.nextVersion()}
+		if err := s.replace(key, e, op); err != nil {
+			return 0, err
+		}
+		return len(st.members), nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	st := e.coll.(*set)
+	added := make(map[string]struct{})
+	var delta int64
+	for _, member := range members {
+		if _, dup := added[member]; !dup && !st.has(member) {
+			added[member] = struct{}{}
+			delta += memberSize(member)
+		}
+	}
+	if len(added) == 0 {
+		return 0, nil
+	}
+	if err := s.resize(key, e, delta); err != nil {
+		return 0, err
+	}
+
+	// record the operation before applying it
+	if err := c.record(op); err != nil {
+		return 0, err
+	}
+
+	for member := range added {
+		st.add(member)
+	}
+	s.changed(key, e, delta)
+
+	return len(added), nil
+}
+
+// SRem removes members from the set under key and returns how many existed. A set left without
+// members is deleted.
+func (c *Cache) SRem(key string, members ...string) (int, error) {
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.collection(key, KindSet)
+	if err != nil {
+		return 0, err
+	}
+
+	st := e.coll.(*set)
+	present := make(map[string]struct{}, len(members))
+	for _, member := range members {
+		if st.has(member) {
+			present[member] = struct{}{}
+		}
+	}
+	if len(present) == 0 {
+		return 0, nil
+	}
+	if len(present) == len(st.members) {
+		return len(present), s.delete(key)
+	}
+
+	// record the operation before applying it
+	removed := make([]string, 0, len(present))
+	for member := range present {
+		removed = append(removed, member)
+	}
+	if err := c.record(setOperation(data.OpSRem, key, removed)); err != nil {
+		return 0, err
+	}
+
+	before := st.size()
+	for _, member := range removed {
+		st.rem(member)
+	}
+	s.changed(key, e, st.size()-before)
+
+	return len(present), nil
+}
+
+// SIsMember reports whether member belongs to the set under key, false when the key is missing
+func (c *Cache) SIsMember(key, member string) (bool, error) {
+	var ok bool
+	err := c.view(key, func(e *entry) error {
+		if e.kind() != KindSet {
+			return ErrWrongType
+		}
+		ok = e.coll.(*set).has(member)
+		return nil
+	})
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	return ok, err
+}
+
+// SCard returns the number of members of the set under key, 0 when the key is missing
+func (c *Cache) SCard(key string) (int, error) {
+	var n int
+	err := c.view(key, func(e *entry) error {
+		if e.kind() != KindSet {
+			return ErrWrongType
+		}
+		n = len(e.coll.(*set).members)
+		return nil
+	})
+	if errors.Is(err, ErrNotFound) {
+		return 0, nil
+	}
+	return n, err
+}
+
+// SMembers returns the members of the set under key in no particular order. A missing key is an empty set.
+func (c *Cache) SMembers(key string) ([]string, error) {
+	var members []string
+	err := c.view(key, func(e *entry) error {
+		if e.kind() != KindSet {
+			return ErrWrongType
+		}
+		members = slices.Clone(e.coll.(*set).members)
+		return nil
+	})
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	return members, err
+}
+
+// SRandMember returns up to count distinct members of the set under key picked at random, without
+// removing them. A missing key is an empty set.
+func (c *Cache) SRandMember(key string, count int) ([]string, error) {
+	if count < 1 {
+		return nil, ErrInvalidCount
+	}
+
+	var members []string
+	err := c.view(key, func(e *entry) error {
+		if e.kind() != KindSet {
+			return ErrWrongType
+		}
+		all := e.coll.(*set).members
+		if count >= len(all) {
+			members = slices.Clone(all)
+			rand.Shuffle(len(members), func(i, j int) { members[i], members[j] = members[j], members[i] })
+			return nil
+		}
+
+		// Floyd's algorithm picks count distinct indexes with as many draws
+		picked := make(map[int]struct{}, count)
+		members = make([]string, 0, count)
+		for j := len(all) - count; j < len(all); j++ {
+			i := rand.IntN(j + 1)
+			if _, ok := picked[i]; ok {
+				i = j
+			}
+			picked[i] = struct{}{}
+			members = append(members, all[i])
+		}
+		return nil
+	})
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	return members, err
+}
+
+// algebra is an operation combining the sets stored under several keys
+type algebra uint8
+
+const (
+	setInter algebra = iota
+	setUnion
+	setDiff
+)
+
+// SInter returns the members found in every set under keys. Missing keys are empty sets.
+func (c *Cache) SInter(keys ...string) ([]string, error) {
+	return c.combine(setInter, keys)
+}
+
+// SUnion returns the members found in any set under keys. Missing keys are empty sets.
+func (c *Cache) SUnion(keys ...string) ([]string, error) {
+	return c.combine(setUnion, keys)
+}
+
+// SDiff returns the members of the set under the first key found in none of the others.
+// Missing keys are empty sets.
+func (c *Cache) SDiff(keys ...string) ([]string, error) {
+	return c.combine(setDiff, keys)
+}
+
+// SInterStore stores the result of SInter under dest and returns its number of members
+func (c *Cache) SInterStore(dest string, keys ...string) (int, error) {
+	return c.combineStore(setInter, dest, keys)
+}
+
+// SUnionStore stores the result of SUnion under dest and returns its number of members
+func (c *Cache) SUnionStore(dest string, keys ...string) (int, error) {
+	return c.combineStore(setUnion, dest, keys)
+}
+
+// SDiffStore stores the result of SDiff under dest and returns its number of members
+func (c *Cache) SDiffStore(dest string, keys ...string) (int, error) {
+	return c.combineStore(setDiff, dest, keys)
+}
+
+// combine applies op to the sets under keys, read locking their shards together so the result
+// reflects a single point in time
+func (c *Cache) combine(op algebra, keys []string) ([]string, error) {
+	if len(keys) == 0 {
+		return nil, ErrNoKeys
+	}
+
+	shards := c.shardsOf(keys)
+	for _, s := range shards {
+		s.mu.RLock()
+	}
+	defer func() {
+		for _, s := range shards {
+			s.mu.RUnlock()
+		}
+	}()
+
+	sets, err := c.sets(keys)
+	if err != nil {
+		return nil, err
+	}
+	return combine(op, sets), nil
+}
+
+// combineStore applies op to the sets under keys and stores the result under dest in place of any
+// entry, without expiration. An empty result deletes dest.
+func (c *Cache) combineStore(op algebra, dest string, keys []string) (int, error) {
+	if len(keys) == 0 {
+		return 0, ErrNoKeys
+	}
+
+	shards := c.shardsOf(append([]string{dest}, keys...))
+	for _, s := range shards {
+		s.mu.Lock()
+	}
+	defer func() {
+		for _, s := range shards {
+			s.mu.Unlock()
+		}
+	}()
+
+	sets, err := c.sets(keys)
+	if err != nil {
+		return 0, err
+	}
+	members := combine(op, sets)
+
+	s := c.shard(dest)
+	if len(members) == 0 {
+		if _, ok := s.lookup(dest); ok {
+			return 0, s.delete(dest)
+		}
+		return 0, nil
+	}
+
+	st := newSet()
+	for _, member := range members {
+		st.add(member)
+	}
+	e := &entry{coll: st, version: c.nextVersion()}
+	val := append([]byte{byte(KindSet)}, st.encode()...)
+	if err := s.replace(dest, e, data.Operation{Op: data.OpRestore, Key: dest, Val: val}); err != nil {
+		return 0, err
+	}
+	return len(members), nil
+}
+
+// sets returns the sets stored under keys in the same order, nil for the missing and expired ones.
+// Callers must hold the locks of their shards, at least for reading.
+func (c *Cache) sets(keys []string) ([]*set, error) {
+	now := time.Now().UnixMilli()
+	sets := make([]*set, len(keys))
+	for i, key := range keys {
+		s := c.shard(key)
+		e, ok := s.store[key]
+		if !ok || e.expired(now) {
+			s.policy.access(key, nil)
+			continue
+		}
+		s.policy.access(key, e)
+		if e.kind() != KindSet {
+			return nil, &KeyError{Key: key, Err: ErrWrongType}
+		}
+		sets[i] = e.coll.(*set)
+	}
+	return sets, nil
+}
+
+// combine applies op to sets, nil sets being empty
+func combine(op algebra, sets []*set) []string {
+	var members []string
+	switch op {
+	case setInter:
+		// the smallest set bounds the result, so only its members are checked against the others
+		smallest := sets[0]
+		for _, st := range sets {
+			if st == nil {
+				return nil
+			}
+			if len(st.members) < len(smallest.members) {
+				smallest = st
+			}
+		}
+	inter:
+		for _, member := range smallest.members {
+			for _, st := range sets {
+				if !st.has(member) {
+					continue inter
+				}
+			}
+			members = append(members, member)
+		}
+	case setUnion:
+		seen := make(map[string]struct{})
+		for _, st := range sets {
+			if st == nil {
+				continue
+			}
+			for _, member := range st.members {
+				if _, ok := seen[member]; !ok {
+					seen[member] = struct{}{}
+					members = append(members, member)
+				}
+			}
+		}
+	case setDiff:
+		if sets[0] == nil {
+			return nil
+		}
+	diff:
+		for _, member := range sets[0].members {
+			for _, st := range sets[1:] {
+				if st != nil && st.has(member) {
+					continue diff
+				}
+			}
+			members = append(members, member)
+		}
+	}
+	return members
+}
+
+// setOperation returns the journal operation adding or removing members of the set under key
+func setOperation(name, key string, members []string) data.Operation {
+	var buf []byte
+	for _, member := range members {
+		buf = data.AppendFields(buf, []byte(member))
+	}
+	return data.Operation{Op: name, Key: key, Val: buf}
+}
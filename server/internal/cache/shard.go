@@ -0,0 +1,143 @@
+package cache
+
+import (
+	"hash/maphash"
+	"slices"
+	"sync"
+	"time"
+)
+
+// DefaultShards is the number of shards of a cache created without WithShards
+const DefaultShards = 16
+
+// shard holds the entries whose key hashes to it, all of its fields are guarded by mu
+type shard struct {
+	c  *Cache
+	mu sync.RWMutex
+
+	store map[string]*entry
+	// expires indexes the keys that have a ttl so the sweeper only samples those
+	expires map[string]struct{}
+	// blocked holds the pops waiting for a value to be pushed under each key, in the order they blocked
+	blocked map[string][]*waiter
+	// signals holds a channel per stream key closed once the stream changes, to wake the consumers
+	// waiting for entries
+	signals map[string]chan struct{}
+
+	// used is the memory taken by the entries, bounded by maxMemory unless it is 0
+	used      int64
+	maxMemory int64
+	policy    Policy
+}
+
+// shard returns the shard holding key
+func (c *Cache) shard(key string) *shard {
+	return c.shards[c.shardIndex(key)]
+}
+
+// shardIndex returns the index of the shard holding key
+func (c *Cache) shardIndex(key string) uint64 {
+	return maphash.String(c.seed, key) & uint64(len(c.shards)-1)
+}
+
+// shardsOf returns the distinct shards holding keys in the order lockAll locks them, so locking
+// them in turn never deadlocks
+func (c *Cache) shardsOf(keys []string) []*shard {
+	indexes := make([]uint64, len(keys))
+	for i, key := range keys {
+		indexes[i] = c.shardIndex(key)
+	}
+	slices.Sort(indexes)
+
+	shards := make([]*shard, 0, len(indexes))
+	for _, idx := range slices.Compact(indexes) {
+		shards = append(shards, c.shards[idx])
+	}
+	return shards
+}
+
+// lockAll locks every shard, always in the same order so it never deadlocks with itself
+func (c *Cache) lockAll() {
+	for _, s := range c.shards {
+		s.mu.Lock()
+	}
+}
+
+func (c *Cache) unlockAll() {
+	for _, s := range c.shards {
+		s.mu.Unlock()
+	}
+}
+
+// rlockAll read locks every shard, which keeps writes out of the whole cache
+func (c *Cache) rlockAll() {
+	for _, s := range c.shards {
+		s.mu.RLock()
+	}
+}
+
+func (c *Cache) runlockAll() {
+	for _, s := range c.shards {
+		s.mu.RUnlock()
+	}
+}
+
+// lookup returns the live entry stored under key, removing it when it expired. Callers must hold s.mu
+func (s *shard) lookup(key string) (*entry, bool) {
+	e, ok := s.store[key]
+	if !ok {
+		return nil, false
+	}
+
+	if e.expired(time.Now().UnixMilli()) {
+		s.remove(key)
+		s.c.expired.Add(1)
+		return nil, false
+	}
+
+	return e, true
+}
+
+// put stores the entry, keeping the expires index, memory usage and eviction policy in sync.
+// Callers must hold s.mu
+func (s *shard) put(key string, e *entry) {
+	old, exists := s.store[key]
+	if exists {
+		s.used -= entrySize(key, old)
+		// the key keeps its history when its value is replaced
+		e.freq.Store(old.freq.Load())
+	}
+
+	s.store[key] = e
+	s.used += entrySize(key, e)
+	s.index(key, e)
+	s.signal(key)
+
+	if !exists {
+		s.policy.insert(key, e)
+	}
+	s.policy.access(key, e)
+}
+
+// index adds or removes the key from the expires index depending on its ttl, callers must hold s.mu
+func (s *shard) index(key string, e *entry) {
+	if e.ttl != 0 {
+		s.expires[key] = struct{}{}
+	} else {
+		delete(s.expires, key)
+	}
+}
+
+// remove deletes the entry, its expires index and its eviction policy state, callers must hold s.mu
+func (s *shard) remove(key string) {
+	e, ok := s.store[key]
+	if !ok {
+		return
+	}
+
+	s.used -= entrySize(key, e)
+	delete(s.store, key)
+	delete(s.expires, key)
+	s.policy.remove(key)
+	s.signal(key)
+}
@@ -0,0 +1,204 @@
+package cache
+
+import "math/rand/v2"
+
+const (
+	// skiplistMaxLevel bounds the levels of a node, enough for 4^32 members
+	skiplistMaxLevel = 32
+	// skiplistP is the probability of a node reaching the next level
+	skiplistP = 0.25
+)
+
+// skiplist keeps the members of a sorted set ordered by score, then by member. Every link stores how
+// many nodes it skips, so the rank of a node is found along with the node in O(log n).
+type skiplist struct {
+	head   *skipnode
+	tail   *skipnode
+	length int
+	level  int
+}
+
+type skipnode struct {
+	member   string
+	score    float64
+	backward *skipnode
+	levels   []skiplink
+}
+
+type skiplink struct {
+	forward *skipnode
+	// span is the number of nodes between the node and forward, forward included
+	span int
+}
+
+func newSkiplist() *skiplist {
+	return &skiplist{head: &skipnode{levels: make([]skiplink, skiplistMaxLevel)}, level: 1}
+}
+
+// before reports whether n is ordered before a node with score and member
+func (n *skipnode) before(score float64, member string) bool {
+	return n.score < score || (n.score == score && n.member < member)
+}
+
+func randomLevel() int {
+	level := 1
+	for level < skiplistMaxLevel && rand.Float64() < skiplistP {
+		level++
+	}
+	return level
+}
+
+// insert adds a member that is not in the list yet
+func (l *skiplist) insert(score float64, member string) {
+	var update [skiplistMaxLevel]*skipnode
+	var rank [skiplistMaxLevel]int
+
+	x := l.head
+	for i := l.level - 1; i >= 0; i-- {
+		if i < l.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for x.levels[i].forward != nil && x.levels[i].forward.before(score, member) {
+			rank[i] += x.levels[i].span
+			x = x.levels[i].forward
+		}
+		update[i] = x
+	}
+
+	level := randomLevel()
+	if level > l.level {
+		for i := l.level; i < level; i++ {
+			rank[i] = 0
+			update[i] = l.head
+			update[i].levels[i].span = l.length
+		}
+		l.level = level
+	}
+
+	n := &skipnode{member: member, score: score, levels: make([]skiplink, level)}
+	for i := range level {
+		n.levels[i].forward = update[i].levels[i].forward
+		update[i].levels[i].forward = n
+		n.levels[i].span = update[i].levels[i].span - (rank[0] - rank[i])
+		update[i].levels[i].span = rank[0] - rank[i] + 1
+	}
+	// the levels above the node now skip one more node
+	for i := level; i < l.level; i++ {
+		update[i].levels[i].span++
+	}
+
+	if update[0] != l.head {
+		n.backward = update[0]
+	}
+	if n.levels[0].forward != nil {
+		n.levels[0].forward.backward = n
+	} else {
+		l.tail = n
+	}
+	l.length++
+}
+
+// delete removes a member with the given score, reporting whether it was found
+func (l *skiplist) delete(score float64, member string) bool {
+	var update [skiplistMaxLevel]*skipnode
+
+	x := l.head
+	for i := l.level - 1; i >= 0; i-- {
+		for x.levels[i].forward != nil && x.levels[i].forward.before(score, member) {
+			x = x.levels[i].forward
+		}
+		update[i] = x
+	}
+
+	x = x.levels[0].forward
+	if x == nil || x.score != score || x.member != member {
+		return false
+	}
+
+	for i := range l.level {
+		if update[i].levels[i].forward == x {
+			update[i].levels[i].span += x.levels[i].span - 1
+			update[i].levels[i].forward = x.levels[i].forward
+		} else {
+			update[i].levels[i].span--
+		}
+	}
+	if x.levels[0].forward != nil {
+		x.levels[0].forward.backward = x.backward
+	} else {
+		l.tail = x.backward
+	}
+	for l.level > 1 && l.head.levels[l.level-1].forward == nil {
+		l.level--
+	}
+	l.length--
+	return true
+}
+
+// rank returns the 0 based rank of a member with the given score, -1 when it is not in the list
+func (l *skiplist) rank(score float64, member string) int {
+	rank := 0
+	x := l.head
+	for i := l.level - 1; i >= 0; i-- {
+		for x.levels[i].forward != nil && (x.levels[i].forward.before(score, member) ||
+			x.levels[i].forward.score == score && x.levels[i].forward.member == member) {
+			rank += x.levels[i].span
+			x = x.levels[i].forward
+		}
+		if x != l.head && x.score == score && x.member == member {
+			return rank - 1
+		}
+	}
+	return -1
+}
+
+// byRank returns the node of the given 0 based rank, nil when it is out of the list
+func (l *skiplist) byRank(rank int) *skipnode {
+	if rank < 0 || rank >= l.length {
+		return nil
+	}
+
+	traversed := 0
+	x := l.head
+	for i := l.level - 1; i >= 0; i-- {
+		for x.levels[i].forward != nil && traversed+x.levels[i].span <= rank+1 {
+			traversed += x.levels[i].span
+			x = x.levels[i].forward
+		}
+		if traversed == rank+1 {
+			return x
+		}
+	}
+	return nil
+}
+
+// first returns the first node for which below is false along with its 0 based rank, nil when
+// below holds for every node. below must hold for a prefix of the list.
+func (l *skiplist) first(below func(n *skipnode) bool) (*skipnode, int) {
+	rank := 0
+	x := l.head
+	for i := l.level - 1; i >= 0; i-- {
+		for x.levels[i].forward != nil && below(x.levels[i].forward) {
+			rank += x.levels[i].span
+			x = x.levels[i].forward
+		}
+	}
+	return x.levels[0].forward, rank
+}
+
+// last returns the last node for which within is true along with its 0 based rank, nil when within
+// holds for no node. within must hold for a prefix of the list.
+func (l *skiplist) last(within func(n *skipnode) bool) (*skipnode, int) {
+	rank := 0
+	x := l.head
+	for i := l.level - 1; i >= 0; i-- {
+		for x.levels[i].forward != nil && within(x.levels[i].forward) {
+			rank += x.levels[i].span
+			x = x.levels[i].forward
+		}
+	}
+	if x == l.head {
+		return nil, -1
+	}
+	return x, rank - 1
+}
@@ -0,0 +1,887 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Lucascluz/memora-server/internal/data"
+)
+
+const (
+	// streamEntryOverhead approximates the bookkeeping memory of a stream entry besides its fields
+	streamEntryOverhead = 48
+	// pendingOverhead approximates the memory of a pending entry of a consumer group besides its consumer name
+	pendingOverhead = 64
+	// groupOverhead approximates the memory of a consumer group besides its name and pending entries
+	groupOverhead = 64
+)
+
+var (
+	ErrInvalidStreamID = errors.New("invalid stream id")
+	ErrGroupNotFound   = errors.New("consumer group not found")
+	ErrGroupExists     = errors.New("consumer group already exists")
+)
+
+// StreamID identifies an entry of a stream by the unix millisecond time it was added at, and a
+// sequence number telling apart the entries added in the same millisecond
+type StreamID struct {
+	Ms  uint64
+	Seq uint64
+}
+
+// MaxStreamID is greater than the id of any entry
+var MaxStreamID = StreamID{Ms: math.MaxUint64, Seq: math.MaxUint64}
+
+func (id StreamID) String() string {
+	return strconv.FormatUint(id.Ms, 10) + "-" + strconv.FormatUint(id.Seq, 10)
+}
+
+// Less reports whether id comes before other
+func (id StreamID) Less(other StreamID) bool {
+	return id.Ms < other.Ms || (id.Ms == other.Ms && id.Seq < other.Seq)
+}
+
+func (id StreamID) compare(other StreamID) int {
+	switch {
+	case id.Less(other):
+		return -1
+	case other.Less(id):
+		return 1
+	}
+	return 0
+}
+
+// ParseStreamID parses an id formatted as <ms>-<seq>. An id without sequence number takes seq, e.g.
+// 0 for the start of a range and math.MaxUint64 for its end.
+func ParseStreamID(s string, seq uint64) (StreamID, error) {
+	msText, seqText, hasSeq := strings.Cut(s, "-")
+	ms, err := strconv.ParseUint(msText, 10, 64)
+	if err != nil {
+		return StreamID{}, fmt.Errorf("%w: %q", ErrInvalidStreamID, s)
+	}
+	if hasSeq {
+		if seq, err = strconv.ParseUint(seqText, 10, 64); err != nil {
+			return StreamID{}, fmt.Errorf("%w: %q", ErrInvalidStreamID, s)
+		}
+	}
+	return StreamID{Ms: ms, Seq: seq}, nil
+}
+
+// StreamEntry is an entry of a stream. Fields are never mutated, so they can be handed out.
+type StreamEntry struct {
+	ID     StreamID
+	Fields map[string][]byte
+}
+
+// PendingEntry is an entry delivered to a consumer of a group and not acknowledged yet
+type PendingEntry struct {
+	ID       StreamID
+	Consumer string
+	// Delivered is the unix millisecond time of the last delivery
+	Delivered int64
+	// Deliveries counts how many times the entry was delivered
+	Deliveries int
+}
+
+// stream is an append only log of entries ordered by id. The entries before head were trimmed.
+type stream struct {
+	entries []StreamEntry
+	head    int
+	// last is the id of the last entry ever added, ids keep growing once it is trimmed
+	last   StreamID
+	groups map[string]*group
+	bytes  int64
+}
+
+// group tracks the entries delivered to the consumers of a consumer group
+type group struct {
+	// last is the id of the last entry delivered to the group
+	last    StreamID
+	pending map[StreamID]*PendingEntry
+	// order holds the ids of the pending entries in ascending order
+	order []StreamID
+}
+
+func newStream() *stream {
+	return &stream{groups: make(map[string]*group)}
+}
+
+func (st *stream) kind() Kind {
+	return KindStream
+}
+
+func (st *stream) size() int64 {
+	return st.bytes
+}
+
+// live returns the entries that were not trimmed
+func (st *stream) live() []StreamEntry {
+	return st.entries[st.head:]
+}
+
+// search returns the index in live of the first entry with an id not below id
+func (st *stream) search(id StreamID) int {
+	i, _ := slices.BinarySearchFunc(st.live(), id, func(e StreamEntry, id StreamID) int { return e.ID.compare(id) })
+	return i
+}
+
+// nextID returns the id of an entry added now, after every id ever added
+func (st *stream) nextID() StreamID {
+	now := uint64(time.Now().UnixMilli())
+	if now > st.last.Ms {
+		return StreamID{Ms: now}
+	}
+	if st.last.Seq == math.MaxUint64 {
+		return StreamID{Ms: st.last.Ms + 1}
+	}
+	return StreamID{Ms: st.last.Ms, Seq: st.last.Seq + 1}
+}
+
+// trimmed returns how many of the oldest entries adding one more would trim to keep maxLen of them,
+// along with their size
+func (st *stream) trimmed(maxLen int) (int, int64) {
+	if maxLen <= 0 {
+		return 0, 0
+	}
+	n := max(len(st.live())+1-maxLen, 0)
+	var size int64
+	for _, e := range st.live()[:min(n, len(st.live()))] {
+		size += streamEntrySize(e.Fields)
+	}
+	return n, size
+}
+
+// add appends an entry with an id above last, then trims the oldest entries to keep maxLen of them
+func (st *stream) add(id StreamID, fields map[string][]byte, maxLen int) {
+	st.entries = append(st.entries, StreamEntry{ID: id, Fields: fields})
+	st.last = id
+	st.bytes += streamEntrySize(fields)
+
+	if maxLen > 0 {
+		for len(st.live()) > maxLen {
+			st.bytes -= streamEntrySize(st.entries[st.head].Fields)
+			st.entries[st.head] = StreamEntry{}
+			st.head++
+		}
+	}
+	// release the trimmed entries once they take most of the slice
+	if st.head > 32 && st.head > len(st.entries)/2 {
+		st.entries = slices.Clone(st.live())
+		st.head = 0
+	}
+}
+
+// deliver hands the entries of ids to consumer at the unix millisecond time now, adding them to
+// the pending entries of the group or moving them from another consumer, and returns the change of
+// size of the stream
+func (st *stream) deliver(g *group, consumer string, now int64, ids []StreamID) int64 {
+	var delta int64
+	for _, id := range ids {
+		p, ok := g.pending[id]
+		if !ok {
+			p = &PendingEntry{ID: id}
+			g.pending[id] = p
+			i, _ := slices.BinarySearchFunc(g.order, id, StreamID.compare)
+			g.order = slices.Insert(g.order, i, id)
+			delta += pendingOverhead + int64(len(consumer))
+		} else {
+			delta += int64(len(consumer) - len(p.Consumer))
+		}
+		p.Consumer, p.Delivered = consumer, now
+		p.Deliveries++
+		if g.last.Less(id) {
+			g.last = id
+		}
+	}
+	st.bytes += delta
+	return delta
+}
+
+// ack removes ids from the pending entries of the group and returns how many were pending
+func (st *stream) ack(g *group, ids []StreamID) int {
+	acked := 0
+	for _, id := range ids {
+		p, ok := g.pending[id]
+		if !ok {
+			continue
+		}
+		delete(g.pending, id)
+		if i, found := slices.BinarySearchFunc(g.order, id, StreamID.compare); found {
+			g.order = slices.Delete(g.order, i, i+1)
+		}
+		st.bytes -= pendingOverhead + int64(len(p.Consumer))
+		acked++
+	}
+	return acked
+}
+
+// entry returns the entry of id, false when it was trimmed
+func (st *stream) entry(id StreamID) (StreamEntry, bool) {
+	live := st.live()
+	i := st.search(id)
+	if i < len(live) && live[i].ID == id {
+		return live[i], true
+	}
+	return StreamEntry{}, false
+}
+
+// encode returns the last id, the entries and the consumer groups, see data.AppendFields
+func (st *stream) encode() []byte {
+	var entries []byte
+	for _, e := range st.live() {
+		entries = data.AppendFields(entries, []byte(e.ID.String()), encodePairs(e.Fields))
+	}
+
+	var groups []byte
+	for name, g := range st.groups {
+		var pending []byte
+		for _, id := range g.order {
+			p := g.pending[id]
+			pending = data.AppendFields(pending, []byte(id.String()), []byte(p.Consumer),
+				strconv.AppendInt(nil, p.Delivered, 10), strconv.AppendInt(nil, int64(p.Deliveries), 10))
+		}
+		groups = data.AppendFields(groups, []byte(name), []byte(g.last.String()), pending)
+	}
+
+	return data.AppendFields(nil, []byte(st.last.String()), entries, groups)
+}
+
+func decodeStream(buf []byte) (collection, error) {
+	sections, err := data.SplitFields(buf)
+	if err != nil {
+		return nil, err
+	}
+	if len(sections) != 3 {
+		return nil, errors.New("stream without entries or groups")
+	}
+
+	st := newStream()
+	items, err := data.SplitFields(sections[1])
+	if err != nil || len(items)%2 != 0 {
+		return nil, errors.New("invalid stream entries")
+	}
+	for i := 0; i < len(items); i += 2 {
+		id, err := ParseStreamID(string(items[i]), 0)
+		if err != nil {
+			return nil, err
+		}
+		fields, err := decodePairs(items[i+1])
+		if err != nil {
+			return nil, err
+		}
+		st.add(id, fields, 0)
+	}
+	if st.last, err = ParseStreamID(string(sections[0]), 0); err != nil {
+		return nil, err
+	}
+
+	items, err = data.SplitFields(sections[2])
+	if err != nil || len(items)%3 != 0 {
+		return nil, errors.New("invalid stream groups")
+	}
+	for i := 0; i < len(items); i += 3 {
+		last, err := ParseStreamID(string(items[i+1]), 0)
+		if err != nil {
+			return nil, err
+		}
+		g := st.addGroup(string(items[i]), last)
+
+		pending, err := data.SplitFields(items[i+2])
+		if err != nil || len(pending)%4 != 0 {
+			return nil, errors.New("invalid pending entries")
+		}
+		for j := 0; j < len(pending); j += 4 {
+			id, err := ParseStreamID(string(pending[j]), 0)
+			if err != nil {
+				return nil, err
+			}
+			delivered, err1 := strconv.ParseInt(string(pending[j+2]), 10, 64)
+			deliveries, err2 := strconv.Atoi(string(pending[j+3]))
+			if err1 != nil || err2 != nil {
+				return nil, errors.New("invalid pending entry")
+			}
+			st.deliver(g, string(pending[j+1]), delivered, []StreamID{id})
+			g.pending[id].Deliveries = deliveries
+		}
+		// delivering the pending entries does not move the group past its recorded last id
+		g.last = last
+	}
+	return st, nil
+}
+
+// addGroup creates a consumer group delivering the entries after last
+func (st *stream) addGroup(name string, last StreamID) *group {
+	g := &group{last: last, pending: make(map[StreamID]*PendingEntry)}
+	st.groups[name] = g
+	st.bytes += groupSize(name)
+	return g
+}
+
+// streamEntrySize is the memory accounted for an entry of a stream
+func streamEntrySize(fields map[string][]byte) int64 {
+	size := int64(streamEntryOverhead)
+	for field, value := range fields {
+		size += fieldSize(field, value)
+	}
+	return size
+}
+
+// groupSize is the memory accounted for a consumer group without pending entries
+func groupSize(name string) int64 {
+	return int64(len(name) + groupOverhead)
+}
+
+// XAdd appends an entry with the given fields to the stream under key and returns its id, creating
+// the stream without expiration when the key is missing. Ids grow with time and never repeat within
+// a stream. A positive maxLen trims the oldest entries to keep at most maxLen of them.
+func (c *Cache) XAdd(key string, fields map[string][]byte, maxLen int) (StreamID, error) {
+	if len(fields) == 0 {
+		return StreamID{}, ErrNoFields
+	}
+	for _, value := range fields {
+		if value == nil {
+			return StreamID{}, ErrNilValue
+		}
+	}
+
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.xadd(key, nil, fields, maxLen)
+}
+
+// xadd appends an entry to the stream under key with the given id, or the next one when id is nil.
+// Callers must hold s.mu
+func (s *shard) xadd(key string, id *StreamID, fields map[string][]byte, maxLen int) (StreamID, error) {
+	e, err := s.collection(key, KindStream)
+	if errors.Is(err, ErrNotFound) {
+		e = &entry{coll: newStream(), version: s.c.nextVersion()}
+		err = nil
+	} else if err != nil {
+		return StreamID{}, err
+	}
+	_, exists := s.store[key]
+
+	st := e.coll.(*stream)
+	next := st.nextID()
+	if id != nil {
+		if !st.last.Less(*id) {
+			return StreamID{}, fmt.Errorf("%w: %s is not above the last id %s", ErrInvalidStreamID, id, st.last)
+		}
+		next = *id
+	}
+
+	op := data.Operation{Op: data.OpXAdd, Key: key, Val: data.AppendFields(nil,
+		[]byte(next.String()), strconv.AppendInt(nil, int64(maxLen), 10), encodePairs(fields))}
+
+	if !exists {
+		// a missing key becomes a new stream holding the entry
+		st.add(next, fields, maxLen)
+		if err := s.replace(key, e, op); err != nil {
+			return StreamID{}, err
+		}
+	} else {
+		_, trimmed := st.trimmed(maxLen)
+		delta := streamEntrySize(fields) - trimmed
+		if err := s.resize(key, e, delta); err != nil {
+			return StreamID{}, err
+		}
+
+		// record the operation before applying it
+		if err := s.c.record(op); err != nil {
+			return StreamID{}, err
+		}
+
+		st.add(next, fields, maxLen)
+		s.changed(key, e, delta)
+	}
+
+	s.signal(key)
+	return next, nil
+}
+
+// XLen returns the number of entries of the stream under key, 0 when the key is missing
+func (c *Cache) XLen(key string) (int, error) {
+	var n int
+	err := c.view(key, func(e *entry) error {
+		if e.kind() != KindStream {
+			return ErrWrongType
+		}
+		n = len(e.coll.(*stream).live())
+		return nil
+	})
+	if errors.Is(err, ErrNotFound) {
+		return 0, nil
+	}
+	return n, err
+}
+
+// XRange returns up to count entries of the stream under key with an id from start to end included,
+// all of them when count is 0, in ascending order or descending order when reverse is set.
+// A missing key is an empty stream.
+func (c *Cache) XRange(key string, start, end StreamID, count int, reverse bool) ([]StreamEntry, error) {
+	var entries []StreamEntry
+	err := c.view(key, func(e *entry) error {
+		if e.kind() != KindStream {
+			return ErrWrongType
+		}
+		st := e.coll.(*stream)
+		live := st.live()
+		from, to := st.search(start), len(live)
+		if end != MaxStreamID {
+			to = st.search(StreamID{Ms: end.Ms, Seq: end.Seq + 1})
+			if end.Seq == math.MaxUint64 {
+				to = st.search(StreamID{Ms: end.Ms + 1})
+			}
+		}
+		if from >= to {
+			return nil
+		}
+
+		n := to - from
+		if count > 0 {
+			n = min(n, count)
+		}
+		entries = make([]StreamEntry, n)
+		for i := range entries {
+			if reverse {
+				entries[i] = live[to-1-i]
+			} else {
+				entries[i] = live[from+i]
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	return entries, err
+}
+
+// XGroupCreate creates a consumer group of the stream under key delivering the entries with an id
+// above start, creating an empty stream without expiration when the key is missing. A nil start
+// delivers the entries added from now on. It fails with ErrGroupExists when the group exists.
+func (c *Cache) XGroupCreate(key, name string, start *StreamID) error {
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.collection(key, KindStream)
+	if errors.Is(err, ErrNotFound) {
+		e = &entry{coll: newStream(), version: c.nextVersion()}
+	} else if err != nil {
+		return err
+	}
+
+	st := e.coll.(*stream)
+	if _, ok := st.groups[name]; ok {
+		return ErrGroupExists
+	}
+	last := st.last
+	if start != nil {
+		last = *start
+	}
+	op := data.Operation{Op: data.OpXGroupCreate, Key: key, Val: data.AppendFields(nil, []byte(name), []byte(last.String()))}
+
+	if _, exists := s.store[key]; !exists {
+		st.addGroup(name, last)
+		return s.replace(key, e, op)
+	}
+
+	delta := groupSize(name)
+	if err := s.resize(key, e, delta); err != nil {
+		return err
+	}
+
+	// record the operation before applying it
+	if err := c.record(op); err != nil {
+		return err
+	}
+
+	st.addGroup(name, last)
+	s.changed(key, e, delta)
+	return nil
+}
+
+// XGroupDestroy deletes a consumer group of the stream under key along with its pending entries,
+// reporting whether it existed
+func (c *Cache) XGroupDestroy(key, name string) (bool, error) {
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.collection(key, KindStream)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	st := e.coll.(*stream)
+	g, ok := st.groups[name]
+	if !ok {
+		return false, nil
+	}
+
+	// record the operation before applying it
+	if err := c.record(data.Operation{Op: data.OpXGroupDestroy, Key: key, Val: data.AppendFields(nil, []byte(name))}); err != nil {
+		return false, err
+	}
+
+	before := st.size()
+	st.ack(g, slices.Clone(g.order))
+	delete(st.groups, name)
+	st.bytes -= groupSize(name)
+	s.changed(key, e, st.size()-before)
+
+	// wake the consumers reading from the group so they find out
+	s.signal(key)
+	return true, nil
+}
+
+// XReadGroup delivers up to count entries of the stream under key that the group did not deliver
+// yet to consumer, adding them to the pending entries of the group until they are acknowledged.
+// When there are none it waits for an entry to be added until ctx is done, returning ctx.Err().
+// No lock is held while waiting.
+func (c *Cache) XReadGroup(ctx context.Context, key, name, consumer string, count int) ([]StreamEntry, error) {
+	if count < 1 {
+		return nil, ErrInvalidCount
+	}
+
+	for {
+		entries, wait, err := c.readGroup(key, name, consumer, count)
+		if err != nil || len(entries) > 0 {
+			return entries, err
+		}
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// readGroup delivers the entries of XReadGroup without waiting. When there are none it returns a
+// channel closed once the stream under key changes.
+func (c *Cache) readGroup(key, name, consumer string, count int) ([]StreamEntry, <-chan struct{}, error) {
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, g, err := s.group(key, name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	st := e.coll.(*stream)
+	live := st.live()
+	i := st.search(StreamID{Ms: g.last.Ms, Seq: g.last.Seq + 1})
+	if g.last.Seq == math.MaxUint64 {
+		i = st.search(StreamID{Ms: g.last.Ms + 1})
+	}
+	if i >= len(live) {
+		// the channel is registered under the same lock as the check, so no entry is missed
+		wait, ok := s.signals[key]
+		if !ok {
+			wait = make(chan struct{})
+			s.signals[key] = wait
+		}
+		return nil, wait, nil
+	}
+
+	entries := slices.Clone(live[i:min(i+count, len(live))])
+	ids := make([]StreamID, len(entries))
+	for j, entry := range entries {
+		ids[j] = entry.ID
+	}
+	if err := s.deliver(key, e, name, consumer, time.Now().UnixMilli(), ids); err != nil {
+		return nil, nil, err
+	}
+	return entries, nil, nil
+}
+
+// deliver hands the entries of ids to a consumer of the group at the unix millisecond time now,
+// recording the delivery first. Callers must hold s.mu
+func (s *shard) deliver(key string, e *entry, name, consumer string, now int64, ids []StreamID) error {
+	st := e.coll.(*stream)
+	g := st.groups[name]
+
+	var delta int64
+	for _, id := range ids {
+		if p, ok := g.pending[id]; ok {
+			delta += int64(len(consumer) - len(p.Consumer))
+		} else {
+			delta += pendingOverhead + int64(len(consumer))
+		}
+	}
+	if err := s.resize(key, e, delta); err != nil {
+		return err
+	}
+
+	// record the operation before applying it
+	if err := s.c.record(deliverOperation(key, name, consumer, now, ids)); err != nil {
+		return err
+	}
+
+	st.deliver(g, consumer, now, ids)
+	s.changed(key, e, delta)
+	return nil
+}
+
+// XAck removes ids from the pending entries of a consumer group of the stream under key and
+// returns how many were pending
+func (c *Cache) XAck(key, name string, ids ...StreamID) (int, error) {
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, g, err := s.group(key, name)
+	if err != nil {
+		return 0, err
+	}
+
+	var pending []StreamID
+	for _, id := range ids {
+		if _, ok := g.pending[id]; ok && !slices.Contains(pending, id) {
+			pending = append(pending, id)
+		}
+	}
+	if len(pending) == 0 {
+		return 0, nil
+	}
+	return len(pending), s.ack(key, e, name, pending)
+}
+
+// ack removes pending ids from the pending entries of the group, recording it first. Callers must hold s.mu
+func (s *shard) ack(key string, e *entry, name string, ids []StreamID) error {
+	val := data.AppendFields(nil, []byte(name))
+	for _, id := range ids {
+		val = data.AppendFields(val, []byte(id.String()))
+	}
+	if err := s.c.record(data.Operation{Op: data.OpXAck, Key: key, Val: val}); err != nil {
+		return err
+	}
+
+	st := e.coll.(*stream)
+	before := st.size()
+	st.ack(st.groups[name], ids)
+	s.changed(key, e, st.size()-before)
+	return nil
+}
+
+// XPending returns up to count pending entries of a consumer group of the stream under key in
+// ascending id order, all of them when count is 0, only those of consumer unless it is empty
+func (c *Cache) XPending(key, name, consumer string, count int) ([]PendingEntry, error) {
+	var pending []PendingEntry
+	err := c.view(key, func(e *entry) error {
+		if e.kind() != KindStream {
+			return ErrWrongType
+		}
+		g, ok := e.coll.(*stream).groups[name]
+		if !ok {
+			return ErrGroupNotFound
+		}
+		for _, id := range g.order {
+			if count > 0 && len(pending) == count {
+				break
+			}
+			if p := g.pending[id]; consumer == "" || p.Consumer == consumer {
+				pending = append(pending, *p)
+			}
+		}
+		return nil
+	})
+	return pending, err
+}
+
+// XAutoClaim hands to consumer up to count pending entries of a consumer group of the stream under
+// key, starting at id start, that were delivered at least minIdle milliseconds ago, e.g. to a
+// consumer that crashed. It returns the claimed entries, the ids of the pending entries that were
+// trimmed from the stream meanwhile, which it acknowledges, and the id to start the next call at,
+// 0-0 once every pending entry was scanned.
+func (c *Cache) XAutoClaim(key, name, consumer string, minIdle int64, start StreamID, count int) ([]StreamEntry, []StreamID, StreamID, error) {
+	if count < 1 {
+		return nil, nil, StreamID{}, ErrInvalidCount
+	}
+
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, g, err := s.group(key, name)
+	if err != nil {
+		return nil, nil, StreamID{}, err
+	}
+
+	st := e.coll.(*stream)
+	now := time.Now().UnixMilli()
+	var claimed []StreamEntry
+	var ids, deleted []StreamID
+	var next StreamID
+
+	i, _ := slices.BinarySearchFunc(g.order, start, StreamID.compare)
+	for ; i < len(g.order); i++ {
+		if len(claimed) == count {
+			next = g.order[i]
+			break
+		}
+		p := g.pending[g.order[i]]
+		if now-p.Delivered < minIdle {
+			continue
+		}
+		if entry, ok := st.entry(p.ID); ok {
+			claimed = append(claimed, entry)
+			ids = append(ids, p.ID)
+		} else {
+			deleted = append(deleted, p.ID)
+		}
+	}
+
+	if len(deleted) > 0 {
+		if err := s.ack(key, e, name, deleted); err != nil {
+			return nil, nil, StreamID{}, err
+		}
+	}
+	if len(ids) > 0 {
+		if err := s.deliver(key, e, name, consumer, now, ids); err != nil {
+			return nil, nil, StreamID{}, err
+		}
+	}
+	return claimed, deleted, next, nil
+}
+
+// group returns the live entry of the stream under key along with one of its consumer groups,
+// failing with ErrGroupNotFound when the group is missing. Callers must hold s.mu
+func (s *shard) group(key, name string) (*entry, *group, error) {
+	e, err := s.collection(key, KindStream)
+	if err != nil {
+		return nil, nil, err
+	}
+	g, ok := e.coll.(*stream).groups[name]
+	if !ok {
+		return nil, nil, ErrGroupNotFound
+	}
+	return e, g, nil
+}
+
+// signal wakes the consumers waiting for the stream under key to change. Callers must hold s.mu
+func (s *shard) signal(key string) {
+	if len(s.signals) == 0 {
+		return
+	}
+	if wait, ok := s.signals[key]; ok {
+		close(wait)
+		delete(s.signals, key)
+	}
+}
+
+// deliverOperation returns the journal operation delivering ids to a consumer of a group at the
+// unix millisecond time now
+func deliverOperation(key, name, consumer string, now int64, ids []StreamID) data.Operation {
+	val := data.AppendFields(nil, []byte(name), []byte(consumer), strconv.AppendInt(nil, now, 10))
+	for _, id := range ids {
+		val = data.AppendFields(val, []byte(id.String()))
+	}
+	return data.Operation{Op: data.OpXDeliver, Key: key, Val: val}
+}
+
+// applyStream replays a journal operation of a stream
+func (c *Cache) applyStream(op data.Operation) error {
+	items, err := data.SplitFields(op.Val)
+	if err != nil {
+		return fmt.Errorf("invalid %s operation of key %q: %w", op.Op, op.Key, err)
+	}
+	invalid := fmt.Errorf("invalid %s operation of key %q", op.Op, op.Key)
+
+	switch op.Op {
+	case data.OpXAdd:
+		if len(items) != 3 {
+			return invalid
+		}
+		id, err1 := ParseStreamID(string(items[0]), 0)
+		maxLen, err2 := strconv.Atoi(string(items[1]))
+		fields, err3 := decodePairs(items[2])
+		if err1 != nil || err2 != nil || err3 != nil {
+			return invalid
+		}
+		s := c.shard(op.Key)
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		_, err := s.xadd(op.Key, &id, fields, maxLen)
+		return err
+	case data.OpXGroupCreate:
+		if len(items) != 2 {
+			return invalid
+		}
+		start, err := ParseStreamID(string(items[1]), 0)
+		if err != nil {
+			return invalid
+		}
+		return c.XGroupCreate(op.Key, string(items[0]), &start)
+	case data.OpXGroupDestroy:
+		if len(items) != 1 {
+			return invalid
+		}
+		_, err := c.XGroupDestroy(op.Key, string(items[0]))
+		return err
+	case data.OpXDeliver:
+		if len(items) < 3 {
+			return invalid
+		}
+		now, err := strconv.ParseInt(string(items[2]), 10, 64)
+		if err != nil {
+			return invalid
+		}
+		ids, err := parseStreamIDs(items[3:])
+		if err != nil {
+			return invalid
+		}
+		s := c.shard(op.Key)
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		e, _, err := s.group(op.Key, string(items[0]))
+		if errors.Is(err, ErrNotFound) {
+			// the key was deleted or expired after the operation was recorded
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return s.deliver(op.Key, e, string(items[0]), string(items[1]), now, ids)
+	case data.OpXAck:
+		if len(items) < 1 {
+			return invalid
+		}
+		ids, err := parseStreamIDs(items[1:])
+		if err != nil {
+			return invalid
+		}
+		// a missing key means it was deleted or expired after the operation was recorded
+		if _, err := c.XAck(op.Key, string(items[0]), ids...); err != nil && !errors.Is(err, ErrNotFound) {
+			return err
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown operation %q", op.Op)
+}
+
+// parseStreamIDs parses the ids ending a journal operation
+func parseStreamIDs(items [][]byte) ([]StreamID, error) {
+	ids := make([]StreamID, len(items))
+	for i, item := range items {
+		id, err := ParseStreamID(string(item), 0)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
@@ -0,0 +1,230 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+)
+
+// addEntries appends n entries to the stream under key and returns their ids
+func addEntries(t *testing.T, c *Cache, key string, n, maxLen int) []StreamID {
+	t.Helper()
+
+	ids := make([]StreamID, n)
+	for i := range ids {
+		id, err := c.XAdd(key, map[string][]byte{"n": []byte(fmt.Sprint(i))}, maxLen)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids[i] = id
+	}
+	return ids
+}
+
+func entryIDs(entries []StreamEntry) []StreamID {
+	ids := make([]StreamID, len(entries))
+	for i, entry := range entries {
+		ids[i] = entry.ID
+	}
+	return ids
+}
+
+func pendingIDs(pending []PendingEntry) []StreamID {
+	ids := make([]StreamID, len(pending))
+	for i, p := range pending {
+		ids[i] = p.ID
+	}
+	return ids
+}
+
+func readGroup(t *testing.T, c *Cache, key, group, consumer string, count int) []StreamID {
+	t.Helper()
+
+	entries, err := c.XReadGroup(context.Background(), key, group, consumer, count)
+	if err != nil {
+		t.Fatalf("XReadGroup(%s, %s) error = %v", group, consumer, err)
+	}
+	return entryIDs(entries)
+}
+
+func TestXReadGroupDeliversOncePerGroup(t *testing.T) {
+	c := NewCache()
+	for _, group := range []string{"g1", "g2"} {
+		if err := c.XGroupCreate("s", group, &StreamID{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ids := addEntries(t, c, "s", 5, 0)
+
+	// the consumers of a group share its entries, every group gets all of them
+	if got := readGroup(t, c, "s", "g1", "alice", 3); !slices.Equal(got, ids[:3]) {
+		t.Errorf("XReadGroup(g1, alice) = %v, want %v", got, ids[:3])
+	}
+	if got := readGroup(t, c, "s", "g1", "bob", 10); !slices.Equal(got, ids[3:]) {
+		t.Errorf("XReadGroup(g1, bob) = %v, want %v", got, ids[3:])
+	}
+	if got := readGroup(t, c, "s", "g2", "alice", 10); !slices.Equal(got, ids) {
+		t.Errorf("XReadGroup(g2, alice) = %v, want %v", got, ids)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if entries, err := c.XReadGroup(ctx, "s", "g1", "alice", 10); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("XReadGroup() with every entry delivered = %v, %v, want %v", entryIDs(entries), err, context.DeadlineExceeded)
+	}
+}
+
+func TestXAckRemovesPending(t *testing.T) {
+	c := NewCache()
+	if err := c.XGroupCreate("s", "g", &StreamID{}); err != nil {
+		t.Fatal(err)
+	}
+	ids := addEntries(t, c, "s", 4, 0)
+	readGroup(t, c, "s", "g", "alice", 2)
+	readGroup(t, c, "s", "g", "bob", 2)
+
+	pending, err := c.XPending("s", "g", "", 0)
+	if err != nil || !slices.Equal(pendingIDs(pending), ids) {
+		t.Fatalf("XPending() = %v, %v, want %v", pendingIDs(pending), err, ids)
+	}
+	if pending[0].Consumer != "alice" || pending[3].Consumer != "bob" || pending[0].Deliveries != 1 {
+		t.Errorf("XPending() = %+v, want the first two delivered once to alice and the others to bob", pending)
+	}
+
+	// entries stay pending until acknowledged, acknowledging twice has no effect
+	if n, err := c.XAck("s", "g", ids[0], ids[2], ids[0]); err != nil || n != 2 {
+		t.Errorf("XAck() = %d, %v, want 2", n, err)
+	}
+	if n, err := c.XAck("s", "g", ids[0]); err != nil || n != 0 {
+		t.Errorf("XAck() of an acknowledged entry = %d, %v, want 0", n, err)
+	}
+	pending, err = c.XPending("s", "g", "bob", 0)
+	if err != nil || !slices.Equal(pendingIDs(pending), ids[3:]) {
+		t.Errorf("XPending(bob) = %v, %v, want %v", pendingIDs(pending), err, ids[3:])
+	}
+	if _, err := c.XAck("s", "missing", ids[1]); !errors.Is(err, ErrGroupNotFound) {
+		t.Errorf("XAck() of a missing group error = %v, want %v", err, ErrGroupNotFound)
+	}
+}
+
+func TestXAutoClaimIdleEntries(t *testing.T) {
+	c := NewCache()
+	if err := c.XGroupCreate("s", "g", &StreamID{}); err != nil {
+		t.Fatal(err)
+	}
+	ids := addEntries(t, c, "s", 4, 0)
+	readGroup(t, c, "s", "g", "alice", 4)
+
+	// the first two entries were delivered a minute ago
+	g := c.shard("s").store["s"].coll.(*stream).groups["g"]
+	for _, id := range ids[:2] {
+		g.pending[id].Delivered -= time.Minute.Milliseconds()
+	}
+
+	claimed, deleted, next, err := c.XAutoClaim("s", "g", "bob", time.Second.Milliseconds(), StreamID{}, 10)
+	if err != nil {
+		t.Fatalf("XAutoClaim() error = %v", err)
+	}
+	if !slices.Equal(entryIDs(claimed), ids[:2]) || len(deleted) != 0 || next != (StreamID{}) {
+		t.Errorf("XAutoClaim() = %v, %v, %v, want %v claimed and the scan done", entryIDs(claimed), deleted, next, ids[:2])
+	}
+
+	pending, err := c.XPending("s", "g", "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, p := range pending {
+		wantConsumer, wantDeliveries := "alice", 1
+		if i < 2 {
+			wantConsumer, wantDeliveries = "bob", 2
+		}
+		if p.Consumer != wantConsumer || p.Deliveries != wantDeliveries {
+			t.Errorf("pending %s = %s delivered %d times, want %s %d times", p.ID, p.Consumer, p.Deliveries, wantConsumer, wantDeliveries)
+		}
+	}
+
+	// the claim delivered the entries again, so they are not idle anymore
+	claimed, _, _, err = c.XAutoClaim("s", "g", "carol", time.Second.Milliseconds(), StreamID{}, 10)
+	if err != nil || len(claimed) != 0 {
+		t.Errorf("XAutoClaim() right after a claim = %v, %v, want nothing", entryIDs(claimed), err)
+	}
+}
+
+func TestXGroupDestroyWakesReader(t *testing.T) {
+	c := NewCache()
+	if err := c.XGroupCreate("s", "g", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	read := make(chan error, 1)
+	go func() {
+		_, err := c.XReadGroup(context.Background(), "s", "g", "alice", 1)
+		read <- err
+	}()
+	waitSignal(t, c, "s")
+
+	if ok, err := c.XGroupDestroy("s", "g"); err != nil || !ok {
+		t.Fatalf("XGroupDestroy() = %v, %v, want true", ok, err)
+	}
+	select {
+	case err := <-read:
+		if !errors.Is(err, ErrGroupNotFound) {
+			t.Errorf("XReadGroup() of a destroyed group error = %v, want %v", err, ErrGroupNotFound)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("XReadGroup() still blocked after XGroupDestroy()")
+	}
+	if ok, err := c.XGroupDestroy("s", "g"); err != nil || ok {
+		t.Errorf("XGroupDestroy() of a destroyed group = %v, %v, want false", ok, err)
+	}
+}
+
+func TestXAddTrimsToMaxLen(t *testing.T) {
+	c := NewCache()
+	if err := c.XGroupCreate("s", "g", &StreamID{}); err != nil {
+		t.Fatal(err)
+	}
+	ids := addEntries(t, c, "s", 2, 0)
+	readGroup(t, c, "s", "g", "alice", 2)
+	ids = append(ids, addEntries(t, c, "s", 4, 3)...)
+
+	if n, err := c.XLen("s"); err != nil || n != 3 {
+		t.Errorf("XLen() = %d, %v, want 3", n, err)
+	}
+	entries, err := c.XRange("s", StreamID{}, MaxStreamID, 0, false)
+	if err != nil || !slices.Equal(entryIDs(entries), ids[3:]) {
+		t.Errorf("XRange() = %v, %v, want the newest %v", entryIDs(entries), err, ids[3:])
+	}
+
+	// the pending entries that were trimmed are acknowledged by a claim
+	claimed, deleted, _, err := c.XAutoClaim("s", "g", "bob", 0, StreamID{}, 10)
+	if err != nil || len(claimed) != 0 || !slices.Equal(deleted, ids[:2]) {
+		t.Errorf("XAutoClaim() = %v, %v, %v, want %v deleted", entryIDs(claimed), deleted, err, ids[:2])
+	}
+	if pending, err := c.XPending("s", "g", "", 0); err != nil || len(pending) != 0 {
+		t.Errorf("XPending() = %v, %v, want none", pendingIDs(pending), err)
+	}
+}
+
+// waitSignal waits until a consumer waits for the stream under key to change
+func waitSignal(t *testing.T, c *Cache, key string) {
+	t.Helper()
+
+	s := c.shard(key)
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		s.mu.Lock()
+		_, waiting := s.signals[key]
+		s.mu.Unlock()
+		if waiting {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("no consumer waits for %s", key)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
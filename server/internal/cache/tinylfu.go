@@ -0,0 +1,225 @@
+package cache
+
+import (
+	"hash/maphash"
+	"sync"
+)
+
+const (
+	// windowShare makes the admission window hold 1 in windowShare entries
+	windowShare = 100
+
+	// sketchDepth and sketchWidth size the frequency sketch, its counters saturate at sketchMax
+	sketchDepth = 4
+	sketchWidth = 1 << 14
+	sketchMax   = 15
+	// sketchSample is how many increments are counted before every counter is halved
+	sketchSample = 10 * sketchWidth
+)
+
+// tinyLFU implements W-TinyLFU: new entries enter a small window first, and an entry leaving
+// the window is only admitted to the rest of the cache when it was used more often than the entry
+// it would replace. Otherwise it is evicted itself. Frequencies are estimated with a count-min
+// sketch that also counts misses, so keys that keep coming back get in while one-off keys do not
+// push out popular ones. The main region is evicted with the sampled LRU.
+type tinyLFU struct {
+	sketch *sketch
+
+	// window maps the keys in the window to their insertion sequence, queue holds them in
+	// insertion order, entries whose sequence no longer matches were removed since
+	window map[string]uint64
+	queue  []windowSlot
+	seq    uint64
+	keys   int
+
+	pool evictionPool
+}
+
+type windowSlot struct {
+	key string
+	seq uint64
+}
+
+func newTinyLFU() *tinyLFU {
+	return &tinyLFU{
+		sketch: newSketch(),
+		window: make(map[string]uint64),
+	}
+}
+
+func (p *tinyLFU) Name() string { return PolicyWTinyLFU }
+
+func (p *tinyLFU) access(key string, e *entry) {
+	if e != nil {
+		e.touch()
+	}
+	p.sketch.increment(key)
+}
+
+func (p *tinyLFU) insert(key string, _ *entry) {
+	p.keys++
+	p.seq++
+	p.window[key] = p.seq
+	p.queue = append(p.queue, windowSlot{key: key, seq: p.seq})
+
+	// while there is memory left the window simply overflows into the main region
+	for len(p.window) > p.windowSize() {
+		p.leaveWindow()
+	}
+}
+
+func (p *tinyLFU) remove(key string) {
+	p.keys--
+	delete(p.window, key)
+}
+
+func (p *tinyLFU) victim(s sampler) (string, bool) {
+	// the write about to happen would overflow the window, the oldest window entry and
+	// the main region victim compete for the place
+	for len(p.window) >= p.windowSize() {
+		candidate, ok := p.leaveWindow()
+		if !ok {
+			break
+		}
+		if candidate == s.skip {
+			continue
+		}
+
+		// the candidate itself is now in the main region and may turn out to be the victim
+		victim, ok := p.mainVictim(s)
+		if !ok {
+			continue
+		}
+
+		if p.sketch.estimate(candidate) > p.sketch.estimate(victim) {
+			return victim, true
+		}
+		return candidate, true
+	}
+
+	if victim, ok := p.mainVictim(s); ok {
+		return victim, true
+	}
+
+	// only window entries are left
+	for {
+		candidate, ok := p.leaveWindow()
+		if !ok {
+			return "", false
+		}
+		if candidate != s.skip {
+			return candidate, true
+		}
+	}
+}
+
+// mainVictim picks the main region entry to evict, false when the main region holds no entry
+// but the one being written. A sample may hit window entries only, so sampling goes on as long
+// as the main region is not empty.
+func (p *tinyLFU) mainVictim(s sampler) (string, bool) {
+	for p.mainSize(s) > 0 {
+		if victim, ok := sampleVictim(s, &p.pool, false, p.mainScore); ok {
+			return victim, true
+		}
+	}
+	return "", false
+}
+
+// mainSize is the number of main region entries that may be evicted
+func (p *tinyLFU) mainSize(s sampler) int {
+	n := p.keys - len(p.window)
+	if _, ok := s.s.store[s.skip]; ok {
+		if _, ok := p.window[s.skip]; !ok {
+			n--
+		}
+	}
+	return n
+}
+
+// windowSize is the number of entries the window holds
+func (p *tinyLFU) windowSize() int {
+	return max(1, p.keys/windowShare)
+}
+
+// leaveWindow moves the oldest window entry to the main region and returns its key
+func (p *tinyLFU) leaveWindow() (string, bool) {
+	for len(p.queue) > 0 {
+		slot := p.queue[0]
+		p.queue = p.queue[1:]
+
+		if seq, ok := p.window[slot.key]; ok && seq == slot.seq {
+			delete(p.window, slot.key)
+			return slot.key, true
+		}
+	}
+	return "", false
+}
+
+// mainScore ranks main region entries by their last access, window entries are not evicted by it
+func (p *tinyLFU) mainScore(key string, e *entry) (int64, bool) {
+	if _, ok := p.window[key]; ok {
+		return 0, false
+	}
+	return e.access.Load(), true
+}
+
+// sketch is a count-min sketch estimating how often keys were seen. Its counters are halved
+// every sketchSample increments so old popularity fades away.
+type sketch struct {
+	mu       sync.Mutex
+	seed     maphash.Seed
+	counters [sketchDepth][]uint8
+	added    int
+}
+
+func newSketch() *sketch {
+	s := &sketch{seed: maphash.MakeSeed()}
+	for i := range s.counters {
+		s.counters[i] = make([]uint8, sketchWidth)
+	}
+	return s
+}
+
+func (s *sketch) increment(key string) {
+	h1, h2 := s.hash(key)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := range s.counters {
+		idx := (h1 + uint64(i)*h2) % sketchWidth
+		if s.counters[i][idx] < sketchMax {
+			s.counters[i][idx]++
+		}
+	}
+
+	s.added++
+	if s.added == sketchSample {
+		for i := range s.counters {
+			for j := range s.counters[i] {
+				s.counters[i][j] /= 2
+			}
+		}
+		s.added /= 2
+	}
+}
+
+func (s *sketch) estimate(key string) uint8 {
+	h1, h2 := s.hash(key)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	estimate := uint8(sketchMax)
+	for i := range s.counters {
+		idx := (h1 + uint64(i)*h2) % sketchWidth
+		estimate = min(estimate, s.counters[i][idx])
+	}
+	return estimate
+}
+
+// hash derives the row indexes from a single hash by double hashing
+func (s *sketch) hash(key string) (uint64, uint64) {
+	h := maphash.String(s.seed, key)
+	return h, h>>32 | h<<32 | 1
+}
@@ -0,0 +1,132 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrWrongType = errors.New("operation against a key holding the wrong type of value")
+
+// Kind is the type of the value stored under a key
+type Kind uint8
+
+const (
+	// KindString is an opaque byte string, stored by Set
+	KindString Kind = iota
+	// KindHash maps fields to values, stored by HSet
+	KindHash
+	// KindList is a sequence of values, stored by LPush and RPush
+	KindList
+	// KindSet is a collection of distinct members, stored by SAdd
+	KindSet
+	// KindZSet is a collection of distinct members ordered by score, stored by ZAdd
+	KindZSet
+	// KindStream is an append only log of entries read by consumer groups, stored by XAdd
+	KindStream
+	// KindHyperLogLog estimates the number of distinct elements added, stored by PFAdd
+	KindHyperLogLog
+	// KindBloom tells whether an item may have been added, stored by BFReserve and BFAdd
+	KindBloom
+	// KindCMS estimates how many times every item was counted, stored by CMSInitByDim
+	KindCMS
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindString:
+		return "string"
+	case KindHash:
+		return "hash"
+	case KindList:
+		return "list"
+	case KindSet:
+		return "set"
+	case KindZSet:
+		return "zset"
+	case KindStream:
+		return "stream"
+	case KindHyperLogLog:
+		return "hyperloglog"
+	case KindBloom:
+		return "bloom"
+	case KindCMS:
+		return "cms"
+	}
+	return fmt.Sprintf("Kind(%d)", uint8(k))
+}
+
+// collection is the value of the keys holding a type other than a string. Collections are changed
+// in place under the lock of their shard, so they are encoded while the lock is held.
+type collection interface {
+	kind() Kind
+	// size approximates the memory taken by the elements, it is kept up to date on every change
+	size() int64
+	// encode serializes the elements so decode rebuilds them
+	encode() []byte
+}
+
+// decode rebuilds a collection of the given kind from its encoding
+func decode(kind Kind, value []byte) (collection, error) {
+	switch kind {
+	case KindHash:
+		return decodeHash(value)
+	case KindList:
+		return decodeList(value)
+	case KindSet:
+		return decodeSet(value)
+	case KindZSet:
+		return decodeZSet(value)
+	case KindStream:
+		return decodeStream(value)
+	case KindHyperLogLog:
+		return decodeHyperLogLog(value)
+	case KindBloom:
+		return decodeBloom(value)
+	case KindCMS:
+		return decodeCMS(value)
+	}
+	return nil, fmt.Errorf("cannot decode a value of type %s", kind)
+}
+
+// kind returns the type of the value held by the entry
+func (e *entry) kind() Kind {
+	if e.coll == nil {
+		return KindString
+	}
+	return e.coll.kind()
+}
+
+// encode returns the value of the entry, encoded for collections
+func (e *entry) encode() []byte {
+	if e.coll == nil {
+		return e.value
+	}
+	return e.coll.encode()
+}
+
+// collection returns the live entry stored under key, failing with ErrNotFound when it is missing
+// and ErrWrongType when it holds another type. Callers must hold s.mu
+func (s *shard) collection(key string, kind Kind) (*entry, error) {
+	e, ok := s.lookup(key)
+	if !ok {
+		return nil, ErrNotFound
+	}
+	if e.kind() != kind {
+		return nil, ErrWrongType
+	}
+	return e, nil
+}
+
+// resize makes room for the collection stored under key to grow by delta bytes, evicting other
+// entries if needed. Callers must hold s.mu
+func (s *shard) resize(key string, e *entry, delta int64) error {
+	size := entrySize(key, e)
+	return s.grow(key, size, size+delta)
+}
+
+// changed accounts for a collection that was changed in place by delta bytes. Callers must hold s.mu
+func (s *shard) changed(key string, e *entry, delta int64) {
+	s.used += delta
+	e.version = s.c.nextVersion()
+	s.policy.access(key, e)
+}
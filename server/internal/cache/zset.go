@@ -0,0 +1,552 @@
+package cache
+
+import (
+	"errors"
+	"math"
+	"strconv"
+
+	"github.com/Lucascluz/memora-server/internal/data"
+)
+
+// zmemberOverhead approximates the bookkeeping memory of a sorted set member besides its name,
+// its score and skiplist node included
+const zmemberOverhead = 96
+
+var (
+	ErrMemberNotFound = errors.New("member not found")
+	ErrInvalidScore   = errors.New("score is not a number")
+	ErrInvalidFlags   = errors.New("incompatible flags")
+)
+
+// ZMember is a member of a sorted set along with its score
+type ZMember struct {
+	Member string
+	Score  float64
+}
+
+// ZAddFlags tells ZAdd which members to add or update, the zero value adds and updates them all
+type ZAddFlags struct {
+	// NX only adds new members, XX only updates existing ones
+	NX, XX bool
+	// GT and LT only update members to a greater or lower score, new members are still added
+	GT, LT bool
+}
+
+// ScoreBound is the minimum or maximum score of a range, infinite values leaving it unbounded
+type ScoreBound struct {
+	Value     float64
+	Exclusive bool
+}
+
+// below reports whether score comes before the range starting at the bound
+func (b ScoreBound) below(score float64) bool {
+	return score < b.Value || (b.Exclusive && score == b.Value)
+}
+
+// above reports whether score comes after the range ending at the bound
+func (b ScoreBound) above(score float64) bool {
+	return score > b.Value || (b.Exclusive && score == b.Value)
+}
+
+// LexBound is the minimum or maximum member of a range, compared byte by byte
+type LexBound struct {
+	Value     string
+	Exclusive bool
+	// Unbounded leaves the range open on the side of the bound, Value is ignored
+	Unbounded bool
+}
+
+// below reports whether member comes before the range starting at the bound
+func (b LexBound) below(member string) bool {
+	return !b.Unbounded && (member < b.Value || (b.Exclusive && member == b.Value))
+}
+
+// above reports whether member comes after the range ending at the bound
+func (b LexBound) above(member string) bool {
+	return !b.Unbounded && (member > b.Value || (b.Exclusive && member == b.Value))
+}
+
+// zset keeps the scores of the members of a sorted set in a map, and the members ordered by score
+// in a skiplist, so members are found in constant time and ranges in O(log n + k)
+type zset struct {
+	scores map[string]float64
+	list   *skiplist
+	bytes  int64
+}
+
+func newZSet() *zset {
+	return &zset{scores: make(map[string]float64), list: newSkiplist()}
+}
+
+func (z *zset) kind() Kind {
+	return KindZSet
+}
+
+func (z *zset) size() int64 {
+	return z.bytes
+}
+
+// encode returns the members in order with their scores
+func (z *zset) encode() []byte {
+	members := make([]ZMember, 0, z.list.length)
+	for n := z.list.head.levels[0].forward; n != nil; n = n.levels[0].forward {
+		members = append(members, ZMember{Member: n.member, Score: n.score})
+	}
+	return encodeScores(members)
+}
+
+func decodeZSet(buf []byte) (collection, error) {
+	members, err := decodeScores(buf)
+	if err != nil {
+		return nil, err
+	}
+	z := newZSet()
+	for _, m := range members {
+		z.set(m.Member, m.Score)
+	}
+	return z, nil
+}
+
+// set stores the score of a member, reporting whether the member is new
+func (z *zset) set(member string, score float64) bool {
+	old, exists := z.scores[member]
+	if exists {
+		if old == score {
+			return false
+		}
+		z.list.delete(old, member)
+	} else {
+		z.bytes += zmemberSize(member)
+	}
+	z.scores[member] = score
+	z.list.insert(score, member)
+	return !exists
+}
+
+// rem removes a member, reporting whether it existed
+func (z *zset) rem(member string) bool {
+	score, ok := z.scores[member]
+	if !ok {
+		return false
+	}
+	z.list.delete(score, member)
+	delete(z.scores, member)
+	z.bytes -= zmemberSize(member)
+	return true
+}
+
+// between returns the members from the first one for which below is false to the last one for which
+// above is false, skipping offset of them and returning up to limit, all of them when limit is 0.
+// reverse walks the range from its end. below must hold for a prefix of the list and above for a suffix.
+func (z *zset) between(below, above func(n *skipnode) bool, reverse bool, offset, limit int) []ZMember {
+	var n *skipnode
+	var rank int
+	if reverse {
+		n, rank = z.list.last(func(n *skipnode) bool { return !above(n) })
+		rank -= offset
+	} else {
+		n, rank = z.list.first(below)
+		rank += offset
+	}
+	if n == nil {
+		return nil
+	}
+	if offset > 0 {
+		n = z.list.byRank(rank)
+	}
+
+	var members []ZMember
+	for n != nil && (limit == 0 || len(members) < limit) {
+		if reverse {
+			if below(n) {
+				break
+			}
+			members = append(members, ZMember{Member: n.member, Score: n.score})
+			n = n.backward
+		} else {
+			if above(n) {
+				break
+			}
+			members = append(members, ZMember{Member: n.member, Score: n.score})
+			n = n.levels[0].forward
+		}
+	}
+	return members
+}
+
+// zmemberSize is the memory accounted for a member of a sorted set
+func zmemberSize(member string) int64 {
+	return int64(len(member) + zmemberOverhead)
+}
+
+// ZAdd sets the scores of members of the sorted set under key as flags allow, creating the sorted
+// set without expiration when the key is missing. It returns how many members were added and how
+// many had their score changed.
+func (c *Cache) ZAdd(key string, members []ZMember, flags ZAddFlags) (int, int, error) {
+	if len(members) == 0 {
+		return 0, 0, ErrNoMembers
+	}
+	if (flags.NX && flags.XX) || (flags.GT && flags.LT) || (flags.NX && (flags.GT || flags.LT)) {
+		return 0, 0, ErrInvalidFlags
+	}
+	for _, m := range members {
+		if math.IsNaN(m.Score) {
+			return 0, 0, ErrInvalidScore
+		}
+	}
+
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.zadd(key, members, flags)
+}
+
+// zadd sets the scores of members of the sorted set under key, callers must hold s.mu
+func (s *shard) zadd(key string, members []ZMember, flags ZAddFlags) (int, int, error) {
+	var z *zset
+	e, err := s.collection(key, KindZSet)
+	switch {
+	case err == nil:
+		z = e.coll.(*zset)
+	case !errors.Is(err, ErrNotFound):
+		return 0, 0, err
+	}
+
+	// work out the changes before applying any, so memory and the journal only account for those.
+	// Members given twice are applied in order.
+	scores := make(map[string]float64, len(members))
+	var changes []ZMember
+	for _, m := range members {
+		old, exists := scores[m.Member]
+		if !exists && z != nil {
+			old, exists = z.scores[m.Member]
+		}
+		switch {
+		case flags.NX && exists, flags.XX && !exists:
+			continue
+		case exists && (old == m.Score || (flags.GT && m.Score < old) || (flags.LT && m.Score > old)):
+			continue
+		}
+		if _, seen := scores[m.Member]; !seen {
+			changes = append(changes, ZMember{Member: m.Member})
+		}
+		scores[m.Member] = m.Score
+	}
+	if len(changes) == 0 {
+		return 0, 0, nil
+	}
+
+	added := 0
+	var delta int64
+	kept := changes[:0]
+	for _, m := range changes {
+		m.Score = scores[m.Member]
+		if z == nil {
+			added++
+		} else if old, ok := z.scores[m.Member]; !ok {
+			added++
+			delta += zmemberSize(m.Member)
+		} else if old == m.Score {
+			// given twice, the member ends up with its score unchanged
+			continue
+		}
+		kept = append(kept, m)
+	}
+	changes = kept
+	if len(changes) == 0 {
+		return 0, 0, nil
+	}
+	op := data.Operation{Op: data.OpZAdd, Key: key, Val: encodeScores(changes)}
+
+	if z == nil {
+		// a missing key becomes a new sorted set holding the members
+		z = newZSet()
+		for _, m := range changes {
+			z.set(m.Member, m.Score)
+		}
+		e = &entry{coll: z, version: s.c.nextVersion()}
+		if err := s.replace(key, e, op); err != nil {
+			return 0, 0, err
+		}
+		return added, 0, nil
+	}
+
+	if err := s.resize(key, e, delta); err != nil {
+		return 0, 0, err
+	}
+
+	// record the operation before applying it
+	if err := s.c.record(op); err != nil {
+		return 0, 0, err
+	}
+
+	for _, m := range changes {
+		z.set(m.Member, m.Score)
+	}
+	s.changed(key, e, delta)
+
+	return added, len(changes) - added, nil
+}
+
+// ZIncrBy adds delta to the score of a member of the sorted set under key and returns the new score.
+// Missing keys and members are created at 0.
+func (c *Cache) ZIncrBy(key, member string, delta float64) (float64, error) {
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var score float64
+	e, err := s.collection(key, KindZSet)
+	switch {
+	case err == nil:
+		score = e.coll.(*zset).scores[member]
+	case !errors.Is(err, ErrNotFound):
+		return 0, err
+	}
+
+	score += delta
+	if math.IsNaN(score) {
+		return 0, ErrInvalidScore
+	}
+	if _, _, err := s.zadd(key, []ZMember{{Member: member, Score: score}}, ZAddFlags{}); err != nil {
+		return 0, err
+	}
+	return score, nil
+}
+
+// ZRem removes members from the sorted set under key and returns how many existed. A sorted set
+// left without members is deleted.
+func (c *Cache) ZRem(key string, members ...string) (int, error) {
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.collection(key, KindZSet)
+	if err != nil {
+		return 0, err
+	}
+
+	z := e.coll.(*zset)
+	present := make(map[string]struct{}, len(members))
+	for _, member := range members {
+		if _, ok := z.scores[member]; ok {
+			present[member] = struct{}{}
+		}
+	}
+	removed := make([]string, 0, len(present))
+	for member := range present {
+		removed = append(removed, member)
+	}
+	return s.zrem(key, e, removed)
+}
+
+// zrem removes members of the sorted set under key that all exist, deleting the key along with its
+// last member. Callers must hold s.mu
+func (s *shard) zrem(key string, e *entry, members []string) (int, error) {
+	z := e.coll.(*zset)
+	if len(members) == 0 {
+		return 0, nil
+	}
+	if len(members) == len(z.scores) {
+		return len(members), s.delete(key)
+	}
+
+	// record the operation before applying it
+	if err := s.c.record(setOperation(data.OpZRem, key, members)); err != nil {
+		return 0, err
+	}
+
+	before := z.size()
+	for _, member := range members {
+		z.rem(member)
+	}
+	s.changed(key, e, z.size()-before)
+
+	return len(members), nil
+}
+
+// ZScore returns the score of a member of the sorted set under key. It fails with ErrNotFound when
+// the key is missing and ErrMemberNotFound when the member is.
+func (c *Cache) ZScore(key, member string) (float64, error) {
+	var score float64
+	err := c.view(key, func(e *entry) error {
+		if e.kind() != KindZSet {
+			return ErrWrongType
+		}
+		s, ok := e.coll.(*zset).scores[member]
+		if !ok {
+			return ErrMemberNotFound
+		}
+		score = s
+		return nil
+	})
+	return score, err
+}
+
+// ZCard returns the number of members of the sorted set under key, 0 when the key is missing
+func (c *Cache) ZCard(key string) (int, error) {
+	var n int
+	err := c.view(key, func(e *entry) error {
+		if e.kind() != KindZSet {
+			return ErrWrongType
+		}
+		n = len(e.coll.(*zset).scores)
+		return nil
+	})
+	if errors.Is(err, ErrNotFound) {
+		return 0, nil
+	}
+	return n, err
+}
+
+// ZRank returns the 0 based rank of a member of the sorted set under key by ascending score, or
+// descending score when reverse is set, along with its score. It fails with ErrNotFound when the
+// key is missing and ErrMemberNotFound when the member is.
+func (c *Cache) ZRank(key, member string, reverse bool) (int, float64, error) {
+	var rank int
+	var score float64
+	err := c.view(key, func(e *entry) error {
+		if e.kind() != KindZSet {
+			return ErrWrongType
+		}
+		z := e.coll.(*zset)
+		s, ok := z.scores[member]
+		if !ok {
+			return ErrMemberNotFound
+		}
+		rank, score = z.list.rank(s, member), s
+		if reverse {
+			rank = z.list.length - 1 - rank
+		}
+		return nil
+	})
+	return rank, score, err
+}
+
+// ZRange returns the members of the sorted set under key from rank start to stop included, by
+// ascending score or descending score when reverse is set. Negative ranks count from the last
+// member. A missing key is an empty sorted set.
+func (c *Cache) ZRange(key string, start, stop int, reverse bool) ([]ZMember, error) {
+	var members []ZMember
+	err := c.view(key, func(e *entry) error {
+		if e.kind() != KindZSet {
+			return ErrWrongType
+		}
+		l := e.coll.(*zset).list
+		from, to, ok := span(start, stop, l.length)
+		if !ok {
+			return nil
+		}
+
+		members = make([]ZMember, 0, to-from+1)
+		if reverse {
+			for n := l.byRank(l.length - 1 - from); len(members) < cap(members); n = n.backward {
+				members = append(members, ZMember{Member: n.member, Score: n.score})
+			}
+		} else {
+			for n := l.byRank(from); len(members) < cap(members); n = n.levels[0].forward {
+				members = append(members, ZMember{Member: n.member, Score: n.score})
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	return members, err
+}
+
+// ZRangeByScore returns the members of the sorted set under key with a score from min to max, by
+// ascending score or descending score when reverse is set. It skips offset members of the range
+// and returns up to limit of them, all of them when limit is 0. A missing key is an empty sorted set.
+func (c *Cache) ZRangeByScore(key string, min, max ScoreBound, reverse bool, offset, limit int) ([]ZMember, error) {
+	return c.zrange(key, func(z *zset) []ZMember {
+		below := func(n *skipnode) bool { return min.below(n.score) }
+		above := func(n *skipnode) bool { return max.above(n.score) }
+		return z.between(below, above, reverse, offset, limit)
+	})
+}
+
+// ZRangeByLex returns the members of the sorted set under key from min to max like ZRangeByScore,
+// comparing members byte by byte. The order of members only follows the comparison when they all
+// have the same score, e.g. for autocompletion, the result being unspecified otherwise.
+func (c *Cache) ZRangeByLex(key string, min, max LexBound, reverse bool, offset, limit int) ([]ZMember, error) {
+	return c.zrange(key, func(z *zset) []ZMember {
+		below := func(n *skipnode) bool { return min.below(n.member) }
+		above := func(n *skipnode) bool { return max.above(n.member) }
+		return z.between(below, above, reverse, offset, limit)
+	})
+}
+
+// zrange runs fn with the sorted set under key under the read lock of its shard
+func (c *Cache) zrange(key string, fn func(z *zset) []ZMember) ([]ZMember, error) {
+	var members []ZMember
+	err := c.view(key, func(e *entry) error {
+		if e.kind() != KindZSet {
+			return ErrWrongType
+		}
+		members = fn(e.coll.(*zset))
+		return nil
+	})
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	return members, err
+}
+
+// ZRemRangeByScore removes the members of the sorted set under key with a score from min to max and
+// returns how many were removed. A sorted set left without members is deleted.
+func (c *Cache) ZRemRangeByScore(key string, min, max ScoreBound) (int, error) {
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.collection(key, KindZSet)
+	if errors.Is(err, ErrNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	below := func(n *skipnode) bool { return min.below(n.score) }
+	above := func(n *skipnode) bool { return max.above(n.score) }
+	inRange := e.coll.(*zset).between(below, above, false, 0, 0)
+
+	members := make([]string, len(inRange))
+	for i, m := range inRange {
+		members[i] = m.Member
+	}
+	return s.zrem(key, e, members)
+}
+
+// encodeScores encodes members as member score pairs, scores as decimal text, see data.AppendFields
+func encodeScores(members []ZMember) []byte {
+	var buf []byte
+	for _, m := range members {
+		buf = data.AppendFields(buf, []byte(m.Member), strconv.AppendFloat(nil, m.Score, 'g', -1, 64))
+	}
+	return buf
+}
+
+// decodeScores decodes the member score pairs of encodeScores
+func decodeScores(buf []byte) ([]ZMember, error) {
+	items, err := data.SplitFields(buf)
+	if err != nil {
+		return nil, err
+	}
+	if len(items)%2 != 0 {
+		return nil, errors.New("member without a score")
+	}
+
+	members := make([]ZMember, 0, len(items)/2)
+	for i := 0; i < len(items); i += 2 {
+		score, err := strconv.ParseFloat(string(items[i+1]), 64)
+		if err != nil || math.IsNaN(score) {
+			return nil, ErrInvalidScore
+		}
+		members = append(members, ZMember{Member: string(items[i]), Score: score})
+	}
+	return members, nil
+}
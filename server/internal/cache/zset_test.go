@@ -0,0 +1,442 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// zsetOf returns the sorted set stored under key
+func zsetOf(t *testing.T, c *Cache, key string) *zset {
+	t.Helper()
+
+	e, ok := c.shard(key).store[key]
+	if !ok {
+		t.Fatalf("key %s is missing", key)
+	}
+	return e.coll.(*zset)
+}
+
+// checkSkiplist verifies the order, the backward links and the span of every link of l
+func checkSkiplist(t *testing.T, l *skiplist) {
+	t.Helper()
+
+	// the rank of every node, the head being 0
+	ranks := map[*skipnode]int{l.head: 0}
+	var prev *skipnode
+	for n := l.head.levels[0].forward; n != nil; n = n.levels[0].forward {
+		if prev != nil && !prev.before(n.score, n.member) {
+			t.Fatalf("node %s %v is not ordered after %s %v", n.member, n.score, prev.member, prev.score)
+		}
+		if n.backward != prev {
+			t.Fatalf("node %s links back to the wrong node", n.member)
+		}
+		ranks[n] = len(ranks)
+		prev = n
+	}
+	if len(ranks)-1 != l.length || l.tail != prev {
+		t.Fatalf("list of length %d holds %d nodes, or its tail is wrong", l.length, len(ranks)-1)
+	}
+
+	for i := range skiplistMaxLevel {
+		for x := l.head; x.levels[i].forward != nil; x = x.levels[i].forward {
+			if i >= l.level {
+				t.Fatalf("level %d is linked above the list level %d", i, l.level)
+			}
+			next := x.levels[i].forward
+			if span := ranks[next] - ranks[x]; x.levels[i].span != span {
+				t.Fatalf("level %d link from rank %d spans %d, want %d", i, ranks[x], x.levels[i].span, span)
+			}
+		}
+	}
+}
+
+func names(members []ZMember) []string {
+	out := make([]string, len(members))
+	for i, m := range members {
+		out[i] = m.Member
+	}
+	return out
+}
+
+func zadd(t *testing.T, c *Cache, key string, members ...ZMember) {
+	t.Helper()
+
+	if _, _, err := c.ZAdd(key, members, ZAddFlags{}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestZRangeByRank(t *testing.T) {
+	c := NewCache()
+	zadd(t, c, "z", ZMember{"a", 1}, ZMember{"b", 2}, ZMember{"c", 3}, ZMember{"d", 4}, ZMember{"e", 5})
+	checkSkiplist(t, zsetOf(t, c, "z").list)
+
+	tests := []struct {
+		name        string
+		start, stop int
+		reverse     bool
+		want        []string
+	}{
+		{"all", 0, -1, false, []string{"a", "b", "c", "d", "e"}},
+		{"middle", 1, 2, false, []string{"b", "c"}},
+		{"negative", -2, -1, false, []string{"d", "e"}},
+		{"start before first", -10, 1, false, []string{"a", "b"}},
+		{"stop after last", 3, 10, false, []string{"d", "e"}},
+		{"start after stop", 3, 1, false, []string{}},
+		{"start after last", 5, 10, false, []string{}},
+		{"reverse", 0, 1, true, []string{"e", "d"}},
+		{"reverse negative", -2, -1, true, []string{"b", "a"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.ZRange("z", tt.start, tt.stop, tt.reverse)
+			if err != nil {
+				t.Fatalf("ZRange() error = %v", err)
+			}
+			if !slices.Equal(names(got), tt.want) {
+				t.Errorf("ZRange(%d, %d) = %v, want %v", tt.start, tt.stop, names(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestZRank(t *testing.T) {
+	c := NewCache()
+	zadd(t, c, "z", ZMember{"a", 1}, ZMember{"b", 2}, ZMember{"c", 2}, ZMember{"d", 4})
+
+	tests := []struct {
+		member   string
+		reverse  bool
+		wantRank int
+		wantErr  error
+	}{
+		{"a", false, 0, nil},
+		{"c", false, 2, nil},
+		{"d", false, 3, nil},
+		{"a", true, 3, nil},
+		{"b", true, 2, nil},
+		{"missing", false, 0, ErrMemberNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.member, tt.reverse), func(t *testing.T) {
+			rank, _, err := c.ZRank("z", tt.member, tt.reverse)
+			if !errors.Is(err, tt.wantErr) || rank != tt.wantRank {
+				t.Errorf("ZRank(%s) = %d, %v, want %d, %v", tt.member, rank, err, tt.wantRank, tt.wantErr)
+			}
+		})
+	}
+	if _, _, err := c.ZRank("missing", "a", false); !errors.Is(err, ErrNotFound) {
+		t.Errorf("ZRank() of a missing key error = %v, want %v", err, ErrNotFound)
+	}
+}
+
+func TestZRangeByScore(t *testing.T) {
+	c := NewCache()
+	zadd(t, c, "z", ZMember{"a", 1}, ZMember{"b", 2}, ZMember{"c", 3}, ZMember{"d", 4}, ZMember{"e", 5})
+
+	inf := math.Inf(1)
+	tests := []struct {
+		name          string
+		min, max      ScoreBound
+		reverse       bool
+		offset, limit int
+		want          []string
+	}{
+		{"inclusive", ScoreBound{2, false}, ScoreBound{4, false}, false, 0, 0, []string{"b", "c", "d"}},
+		{"exclusive", ScoreBound{2, true}, ScoreBound{4, true}, false, 0, 0, []string{"c"}},
+		{"exclusive min", ScoreBound{2, true}, ScoreBound{4, false}, false, 0, 0, []string{"c", "d"}},
+		{"empty exclusive", ScoreBound{3, true}, ScoreBound{3, false}, false, 0, 0, nil},
+		{"unbounded", ScoreBound{-inf, false}, ScoreBound{inf, false}, false, 0, 0, []string{"a", "b", "c", "d", "e"}},
+		{"offset and limit", ScoreBound{-inf, false}, ScoreBound{inf, false}, false, 1, 2, []string{"b", "c"}},
+		{"offset past range", ScoreBound{2, false}, ScoreBound{3, false}, false, 2, 0, nil},
+		{"limit past range", ScoreBound{4, false}, ScoreBound{inf, false}, false, 0, 10, []string{"d", "e"}},
+		{"reverse", ScoreBound{1, true}, ScoreBound{5, true}, true, 0, 0, []string{"d", "c", "b"}},
+		{"reverse offset and limit", ScoreBound{1, false}, ScoreBound{5, false}, true, 1, 2, []string{"d", "c"}},
+		{"reverse exclusive max", ScoreBound{-inf, false}, ScoreBound{3, true}, true, 1, 0, []string{"a"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.ZRangeByScore("z", tt.min, tt.max, tt.reverse, tt.offset, tt.limit)
+			if err != nil {
+				t.Fatalf("ZRangeByScore() error = %v", err)
+			}
+			if !slices.Equal(names(got), tt.want) {
+				t.Errorf("ZRangeByScore() = %v, want %v", names(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestZRangeByLex(t *testing.T) {
+	c := NewCache()
+	zadd(t, c, "z", ZMember{"a", 0}, ZMember{"b", 0}, ZMember{"c", 0}, ZMember{"d", 0}, ZMember{"e", 0})
+
+	open := LexBound{Unbounded: true}
+	tests := []struct {
+		name          string
+		min, max      LexBound
+		reverse       bool
+		offset, limit int
+		want          []string
+	}{
+		{"inclusive", LexBound{Value: "b"}, LexBound{Value: "d"}, false, 0, 0, []string{"b", "c", "d"}},
+		{"exclusive", LexBound{Value: "b", Exclusive: true}, LexBound{Value: "d", Exclusive: true}, false, 0, 0, []string{"c"}},
+		{"between members", LexBound{Value: "bb"}, LexBound{Value: "dd"}, false, 0, 0, []string{"c", "d"}},
+		{"unbounded min", open, LexBound{Value: "c", Exclusive: true}, false, 0, 0, []string{"a", "b"}},
+		{"offset and limit", open, open, false, 2, 2, []string{"c", "d"}},
+		{"reverse", LexBound{Value: "b", Exclusive: true}, open, true, 0, 0, []string{"e", "d", "c"}},
+		{"reverse offset and limit", open, open, true, 1, 2, []string{"d", "c"}},
+		{"empty", LexBound{Value: "c", Exclusive: true}, LexBound{Value: "c"}, false, 0, 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.ZRangeByLex("z", tt.min, tt.max, tt.reverse, tt.offset, tt.limit)
+			if err != nil {
+				t.Fatalf("ZRangeByLex() error = %v", err)
+			}
+			if !slices.Equal(names(got), tt.want) {
+				t.Errorf("ZRangeByLex() = %v, want %v", names(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestZAddFlags(t *testing.T) {
+	tests := []struct {
+		name        string
+		members     []ZMember
+		flags       ZAddFlags
+		wantAdded   int
+		wantChanged int
+		wantErr     error
+		// want is the sorted set afterwards, which starts as a:1 b:2
+		want []ZMember
+	}{
+		{"none", []ZMember{{"a", 3}, {"c", 1}}, ZAddFlags{}, 1, 1, nil,
+			[]ZMember{{"c", 1}, {"b", 2}, {"a", 3}}},
+		{"NX", []ZMember{{"a", 3}, {"c", 1}}, ZAddFlags{NX: true}, 1, 0, nil,
+			[]ZMember{{"a", 1}, {"c", 1}, {"b", 2}}},
+		{"XX", []ZMember{{"a", 3}, {"c", 1}}, ZAddFlags{XX: true}, 0, 1, nil,
+			[]ZMember{{"b", 2}, {"a", 3}}},
+		{"GT", []ZMember{{"a", 0}, {"b", 5}, {"c", 1}}, ZAddFlags{GT: true}, 1, 1, nil,
+			[]ZMember{{"a", 1}, {"c", 1}, {"b", 5}}},
+		{"LT", []ZMember{{"a", 0}, {"b", 5}}, ZAddFlags{LT: true}, 0, 1, nil,
+			[]ZMember{{"a", 0}, {"b", 2}}},
+		{"XX GT", []ZMember{{"a", 3}, {"b", 1}, {"c", 1}}, ZAddFlags{XX: true, GT: true}, 0, 1, nil,
+			[]ZMember{{"b", 2}, {"a", 3}}},
+		{"same score", []ZMember{{"a", 1}}, ZAddFlags{}, 0, 0, nil,
+			[]ZMember{{"a", 1}, {"b", 2}}},
+		{"given twice", []ZMember{{"a", 5}, {"a", 1}}, ZAddFlags{}, 0, 0, nil,
+			[]ZMember{{"a", 1}, {"b", 2}}},
+		{"NX and XX", []ZMember{{"a", 3}}, ZAddFlags{NX: true, XX: true}, 0, 0, ErrInvalidFlags,
+			[]ZMember{{"a", 1}, {"b", 2}}},
+		{"NX and GT", []ZMember{{"a", 3}}, ZAddFlags{NX: true, GT: true}, 0, 0, ErrInvalidFlags,
+			[]ZMember{{"a", 1}, {"b", 2}}},
+		{"GT and LT", []ZMember{{"a", 3}}, ZAddFlags{GT: true, LT: true}, 0, 0, ErrInvalidFlags,
+			[]ZMember{{"a", 1}, {"b", 2}}},
+		{"NaN", []ZMember{{"a", math.NaN()}}, ZAddFlags{}, 0, 0, ErrInvalidScore,
+			[]ZMember{{"a", 1}, {"b", 2}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCache()
+			zadd(t, c, "z", ZMember{"a", 1}, ZMember{"b", 2})
+
+			added, changed, err := c.ZAdd("z", tt.members, tt.flags)
+			if !errors.Is(err, tt.wantErr) || added != tt.wantAdded || changed != tt.wantChanged {
+				t.Errorf("ZAdd() = %d, %d, %v, want %d, %d, %v", added, changed, err, tt.wantAdded, tt.wantChanged, tt.wantErr)
+			}
+			got, err := c.ZRange("z", 0, -1, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("sorted set after ZAdd() = %v, want %v", got, tt.want)
+			}
+			checkSkiplist(t, zsetOf(t, c, "z").list)
+		})
+	}
+}
+
+// reference is a sorted set kept as a slice ordered by score, then member
+type reference []ZMember
+
+func (r reference) set(member string, score float64) reference {
+	r = slices.DeleteFunc(r, func(m ZMember) bool { return m.Member == member })
+	i, _ := slices.BinarySearchFunc(r, ZMember{member, score}, compareZMembers)
+	return slices.Insert(r, i, ZMember{member, score})
+}
+
+func (r reference) rem(member string) reference {
+	return slices.DeleteFunc(r, func(m ZMember) bool { return m.Member == member })
+}
+
+func compareZMembers(a, b ZMember) int {
+	switch {
+	case a.Score < b.Score:
+		return -1
+	case a.Score > b.Score:
+		return 1
+	case a.Member < b.Member:
+		return -1
+	case a.Member > b.Member:
+		return 1
+	}
+	return 0
+}
+
+// window applies offset and limit to members, reversed first when reverse is set
+func window(members []ZMember, reverse bool, offset, limit int) []ZMember {
+	members = slices.Clone(members)
+	if reverse {
+		slices.Reverse(members)
+	}
+	if offset >= len(members) {
+		return nil
+	}
+	members = members[offset:]
+	if limit > 0 && limit < len(members) {
+		members = members[:limit]
+	}
+	return members
+}
+
+func (r reference) byRank(start, stop int, reverse bool) []ZMember {
+	n := len(r)
+	if start < 0 {
+		start += n
+	}
+	if stop < 0 {
+		stop += n
+	}
+	start, stop = max(start, 0), min(stop, n-1)
+	if start > stop {
+		return nil
+	}
+	return window(r, reverse, start, stop-start+1)
+}
+
+func (r reference) byScore(min, max ScoreBound, reverse bool, offset, limit int) []ZMember {
+	var in []ZMember
+	for _, m := range r {
+		if !min.below(m.Score) && !max.above(m.Score) {
+			in = append(in, m)
+		}
+	}
+	return window(in, reverse, offset, limit)
+}
+
+func (r reference) byLex(min, max LexBound, reverse bool, offset, limit int) []ZMember {
+	var in []ZMember
+	for _, m := range r {
+		if !min.below(m.Member) && !max.above(m.Member) {
+			in = append(in, m)
+		}
+	}
+	return window(in, reverse, offset, limit)
+}
+
+// TestZSetMatchesReference runs random writes and reads against a sorted set and a sorted slice
+func TestZSetMatchesReference(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	c := NewCache()
+
+	// scores are few so many members tie and are ordered by name, the lex set has a single score
+	member := func() string { return fmt.Sprintf("m%02d", rng.IntN(60)) }
+	score := func() float64 { return float64(rng.IntN(20) - 10) }
+	scoreBound := func() ScoreBound { return ScoreBound{Value: score(), Exclusive: rng.IntN(2) == 0} }
+	lexBound := func() LexBound {
+		return LexBound{Value: member(), Exclusive: rng.IntN(2) == 0, Unbounded: rng.IntN(8) == 0}
+	}
+
+	var ref, lex reference
+	equal := func(what string, got, want []ZMember) {
+		t.Helper()
+		if !slices.Equal(got, want) {
+			t.Fatalf("%s = %v\nwant %v", what, got, want)
+		}
+	}
+
+	for round := range 3000 {
+		switch op := rng.IntN(10); {
+		case op < 5:
+			m, s := member(), score()
+			zadd(t, c, "z", ZMember{m, s})
+			ref = ref.set(m, s)
+			zadd(t, c, "lex", ZMember{m, 0})
+			lex = lex.set(m, 0)
+		case op < 8:
+			m := member()
+			if _, err := c.ZRem("z", m); err != nil && !errors.Is(err, ErrNotFound) {
+				t.Fatal(err)
+			}
+			ref = ref.rem(m)
+		default:
+			m, delta := member(), score()
+			got, err := c.ZIncrBy("z", m, delta)
+			if err != nil {
+				t.Fatal(err)
+			}
+			old := 0.0
+			if i := slices.IndexFunc(ref, func(z ZMember) bool { return z.Member == m }); i >= 0 {
+				old = ref[i].Score
+			}
+			if got != old+delta {
+				t.Fatalf("ZIncrBy(%s, %v) = %v, want %v", m, delta, got, old+delta)
+			}
+			ref = ref.set(m, old+delta)
+		}
+
+		if len(ref) > 0 {
+			checkSkiplist(t, zsetOf(t, c, "z").list)
+		}
+		all, err := c.ZRange("z", 0, -1, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		equal(fmt.Sprint("round ", round, " ZRange(0, -1)"), all, ref)
+
+		for i, m := range ref {
+			rank, _, err := c.ZRank("z", m.Member, i%2 == 1)
+			want := i
+			if i%2 == 1 {
+				want = len(ref) - 1 - i
+			}
+			if err != nil || rank != want {
+				t.Fatalf("ZRank(%s, %v) = %d, %v, want %d", m.Member, i%2 == 1, rank, err, want)
+			}
+		}
+
+		start, stop, reverse := rng.IntN(30)-15, rng.IntN(30)-15, rng.IntN(2) == 0
+		got, err := c.ZRange("z", start, stop, reverse)
+		if err != nil {
+			t.Fatal(err)
+		}
+		equal(fmt.Sprintf("ZRange(%d, %d, %v)", start, stop, reverse), got, ref.byRank(start, stop, reverse))
+
+		min, max, offset, limit := scoreBound(), scoreBound(), rng.IntN(4), rng.IntN(4)
+		got, err = c.ZRangeByScore("z", min, max, reverse, offset, limit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		equal(fmt.Sprintf("ZRangeByScore(%v, %v, %v, %d, %d)", min, max, reverse, offset, limit), got,
+			ref.byScore(min, max, reverse, offset, limit))
+
+		lmin, lmax := lexBound(), lexBound()
+		got, err = c.ZRangeByLex("lex", lmin, lmax, reverse, offset, limit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		equal(fmt.Sprintf("ZRangeByLex(%v, %v, %v, %d, %d)", lmin, lmax, reverse, offset, limit), got,
+			lex.byLex(lmin, lmax, reverse, offset, limit))
+	}
+}
@@ -0,0 +1,149 @@
+// Package certs serves the server TLS certificate and the CA pool verifying client certificates,
+// reloading them from disk when the files change so they can be rotated without a restart.
+package certs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var ErrNoCertificates = errors.New("certs: no certificates found in client CA file")
+
+// Reloader holds the current certificate and client CA pool
+type Reloader struct {
+	certFile string
+	keyFile  string
+	caFile   string
+
+	cert atomic.Pointer[tls.Certificate]
+	pool atomic.Pointer[x509.CertPool]
+	// modified is the latest modification time in unix nanoseconds of the files that were loaded
+	modified atomic.Int64
+
+	done chan struct{}
+	stop sync.Once
+	wg   sync.WaitGroup
+}
+
+// NewReloader loads the certificate and key, and the client CA file unless it is empty.
+// With a client CA file the server requires clients to present a certificate signed by it.
+func NewReloader(certFile, keyFile, caFile string) (*Reloader, error) {
+	r := &Reloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+		caFile:   caFile,
+		done:     make(chan struct{}),
+	}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload reads the files again, the previous certificate and pool are kept when they are invalid
+func (r *Reloader) Reload() error {
+	modified, err := r.lastModified()
+	if err != nil {
+		return err
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("certs: failed to load certificate: %w", err)
+	}
+
+	var pool *x509.CertPool
+	if r.caFile != "" {
+		pem, err := os.ReadFile(r.caFile)
+		if err != nil {
+			return fmt.Errorf("certs: failed to read client CA file: %w", err)
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return ErrNoCertificates
+		}
+	}
+
+	r.cert.Store(&cert)
+	r.pool.Store(pool)
+	r.modified.Store(modified.UnixNano())
+
+	return nil
+}
+
+// Config returns a server TLS config always using the current certificate and client CA pool
+func (r *Reloader) Config() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			config := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*r.cert.Load()},
+			}
+			if pool := r.pool.Load(); pool != nil {
+				config.ClientCAs = pool
+				config.ClientAuth = tls.RequireAndVerifyClientCert
+			}
+			return config, nil
+		},
+	}
+}
+
+// Start checks the files for changes every interval and reloads them when they were modified
+func (r *Reloader) Start(interval time.Duration) {
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				modified, err := r.lastModified()
+				if err != nil || modified.UnixNano() <= r.modified.Load() {
+					continue
+				}
+				if err := r.Reload(); err != nil {
+					log.Printf("Failed to reload TLS certificates: %v", err)
+					continue
+				}
+				log.Printf("Reloaded TLS certificates")
+			case <-r.done:
+				return
+			}
+		}
+	}()
+}
+
+// Stop ends the checks started by Start
+func (r *Reloader) Stop() {
+	r.stop.Do(func() { close(r.done) })
+	r.wg.Wait()
+}
+
+// lastModified returns the latest modification time of the files
+func (r *Reloader) lastModified() (time.Time, error) {
+	var latest time.Time
+	for _, path := range []string{r.certFile, r.keyFile, r.caFile} {
+		if path == "" {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("certs: %w", err)
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest, nil
+}
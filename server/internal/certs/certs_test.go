@@ -0,0 +1,318 @@
+package certs
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// authority is a self-signed CA issuing the certificates of a test
+type authority struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newAuthority(t *testing.T) *authority {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &authority{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+func (a *authority) pool() *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(a.cert)
+	return pool
+}
+
+// issue returns the certificate and key PEM of a leaf certificate named name, for a server or a client
+func (a *authority) issue(t *testing.T, name string, usage x509.ExtKeyUsage) ([]byte, []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, a.cert, &key.PublicKey, a.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+// clientCertificate returns a client certificate named name issued by a
+func (a *authority) clientCertificate(t *testing.T, name string) tls.Certificate {
+	t.Helper()
+
+	certPEM, keyPEM := a.issue(t, name, x509.ExtKeyUsageClientAuth)
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+// files are the paths of the certificate, key and client CA files of a test
+type files struct {
+	cert, key, ca string
+}
+
+func newFiles(t *testing.T) files {
+	dir := t.TempDir()
+	return files{
+		cert: filepath.Join(dir, "server.pem"),
+		key:  filepath.Join(dir, "server.key"),
+		ca:   filepath.Join(dir, "ca.pem"),
+	}
+}
+
+// writeServer writes a server certificate named name issued by a, modified at the given time so
+// changes are seen regardless of the resolution of the file system clock
+func (f files) writeServer(t *testing.T, a *authority, name string, modified time.Time) {
+	t.Helper()
+
+	certPEM, keyPEM := a.issue(t, name, x509.ExtKeyUsageServerAuth)
+	writeFile(t, f.cert, certPEM, modified)
+	writeFile(t, f.key, keyPEM, modified)
+}
+
+func writeFile(t *testing.T, path string, data []byte, modified time.Time) {
+	t.Helper()
+
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, modified, modified); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// serve accepts TLS connections with the config of r until the test ends
+func serve(t *testing.T, r *Reloader) string {
+	t.Helper()
+
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", r.Config())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				// a byte is only written once the handshake, client certificate included, succeeded
+				if err := conn.(*tls.Conn).Handshake(); err == nil {
+					conn.Write([]byte{1})
+				}
+			}()
+		}
+	}()
+	return ln.Addr().String()
+}
+
+// dial connects to addr and returns the name of the server certificate. With TLS 1.3 a rejected
+// client certificate only fails the first read, so a byte is read from the server.
+func dial(addr string, roots *x509.CertPool, certs ...tls.Certificate) (string, error) {
+	conn, err := tls.Dial("tcp", addr, &tls.Config{RootCAs: roots, Certificates: certs})
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+	if _, err := conn.Read(make([]byte, 1)); err != nil {
+		return "", err
+	}
+	return conn.ConnectionState().PeerCertificates[0].Subject.CommonName, nil
+}
+
+func TestReloadServesNewCertificate(t *testing.T) {
+	ca := newAuthority(t)
+	f := newFiles(t)
+	now := time.Now()
+	f.writeServer(t, ca, "one", now)
+
+	r, err := NewReloader(f.cert, f.key, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := serve(t, r)
+
+	if name, err := dial(addr, ca.pool()); err != nil || name != "one" {
+		t.Fatalf("dial() = %q, %v, want one", name, err)
+	}
+
+	f.writeServer(t, ca, "two", now.Add(time.Second))
+	if err := r.Reload(); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+	if name, err := dial(addr, ca.pool()); err != nil || name != "two" {
+		t.Fatalf("dial() after Reload() = %q, %v, want two", name, err)
+	}
+}
+
+func TestStartReloadsChangedFiles(t *testing.T) {
+	ca := newAuthority(t)
+	f := newFiles(t)
+	now := time.Now()
+	f.writeServer(t, ca, "one", now)
+
+	r, err := NewReloader(f.cert, f.key, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Start(10 * time.Millisecond)
+	defer r.Stop()
+	addr := serve(t, r)
+
+	f.writeServer(t, ca, "two", now.Add(time.Second))
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		name, err := dial(addr, ca.pool())
+		if err != nil {
+			t.Fatalf("dial() error = %v", err)
+		}
+		if name == "two" {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("dial() = %q long after the files changed, want two", name)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestReloadKeepsCertificateOnInvalidFiles(t *testing.T) {
+	ca := newAuthority(t)
+	f := newFiles(t)
+	now := time.Now()
+	f.writeServer(t, ca, "one", now)
+
+	r, err := NewReloader(f.cert, f.key, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Start(10 * time.Millisecond)
+	defer r.Stop()
+	addr := serve(t, r)
+
+	// the new certificate is written but its key only halfway
+	certPEM, keyPEM := ca.issue(t, "two", x509.ExtKeyUsageServerAuth)
+	writeFile(t, f.cert, certPEM, now.Add(time.Second))
+	writeFile(t, f.key, keyPEM[:len(keyPEM)/2], now.Add(time.Second))
+
+	if err := r.Reload(); err == nil {
+		t.Fatal("Reload() of a half written key succeeded, want an error")
+	}
+	// give the polling a few chances to pick up the broken pair
+	time.Sleep(50 * time.Millisecond)
+	if name, err := dial(addr, ca.pool()); err != nil || name != "one" {
+		t.Fatalf("dial() after a failed reload = %q, %v, want one", name, err)
+	}
+
+	// the pair is picked up once the key is complete, although its time did not change since
+	writeFile(t, f.key, keyPEM, now.Add(time.Second))
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		name, err := dial(addr, ca.pool())
+		if err != nil {
+			t.Fatalf("dial() error = %v", err)
+		}
+		if name == "two" {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("dial() = %q long after the key was completed, want two", name)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestClientCertificateRequired(t *testing.T) {
+	ca := newAuthority(t)
+	f := newFiles(t)
+	f.writeServer(t, ca, "server", time.Now())
+	writeFile(t, f.ca, ca.pem, time.Now())
+
+	r, err := NewReloader(f.cert, f.key, f.ca)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := serve(t, r)
+
+	if _, err := dial(addr, ca.pool()); err == nil {
+		t.Error("dial() without a client certificate succeeded, want an error")
+	}
+	if _, err := dial(addr, ca.pool(), newAuthority(t).clientCertificate(t, "stranger")); err == nil {
+		t.Error("dial() with a client certificate of another CA succeeded, want an error")
+	}
+	if name, err := dial(addr, ca.pool(), ca.clientCertificate(t, "client")); err != nil || name != "server" {
+		t.Errorf("dial() with a client certificate = %q, %v, want server", name, err)
+	}
+}
+
+func TestNewReloaderRejectsEmptyCAFile(t *testing.T) {
+	ca := newAuthority(t)
+	f := newFiles(t)
+	f.writeServer(t, ca, "server", time.Now())
+	writeFile(t, f.ca, []byte("no certificates here\n"), time.Now())
+
+	if _, err := NewReloader(f.cert, f.key, f.ca); !errors.Is(err, ErrNoCertificates) {
+		t.Fatalf("NewReloader() error = %v, want %v", err, ErrNoCertificates)
+	}
+}
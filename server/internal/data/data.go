@@ -1,9 +1,160 @@
 package data
 
-import "time"
+import (
+	"encoding/binary"
+	"errors"
+	"time"
+)
+
+// Operation names recorded in the append only file
+const (
+	OpSet    = "set"
+	OpDelete = "del"
+	// OpExpire changes the ttl of an existing key, a zero Ttl removes its expiration
+	OpExpire = "expire"
+	// OpRestore replaces the key with a value of any type, Val holds the type followed by its encoding
+	OpRestore = "restore"
+	// OpHSet sets fields of the hash stored under the key, Val holds the field value pairs, see AppendFields
+	OpHSet = "hset"
+	// OpHDel deletes fields of the hash stored under the key, Val holds the field names
+	OpHDel = "hdel"
+	// OpLPush and OpRPush push values to the head or tail of the list stored under the key, Val holds the values
+	OpLPush = "lpush"
+	OpRPush = "rpush"
+	// OpLPop and OpRPop pop values from the head or tail of the list stored under the key, Val holds
+	// how many as decimal text
+	OpLPop = "lpop"
+	OpRPop = "rpop"
+	// OpLTrim trims the list stored under the key to a range, Val holds the start and stop indexes as decimal text
+	OpLTrim = "ltrim"
+	// OpSAdd and OpSRem add or remove members of the set stored under the key, Val holds the members
+	OpSAdd = "sadd"
+	OpSRem = "srem"
+	// OpZAdd sets the scores of members of the sorted set stored under the key, Val holds the member
+	// score pairs with scores as decimal text
+	OpZAdd = "zadd"
+	// OpZRem removes members of the sorted set stored under the key, Val holds the members
+	OpZRem = "zrem"
+	// OpXAdd appends an entry to the stream stored under the key, Val holds its id, the maximum
+	// length of the stream as decimal text and the field value pairs
+	OpXAdd = "xadd"
+	// OpXGroupCreate creates a consumer group of the stream stored under the key, Val holds its name
+	// and the id of the last entry delivered to it
+	OpXGroupCreate = "xgroupcreate"
+	// OpXGroupDestroy deletes a consumer group of the stream stored under the key, Val holds its name
+	OpXGroupDestroy = "xgroupdestroy"
+	// OpXDeliver hands entries of the stream stored under the key to a consumer of a group, Val holds
+	// the group, the consumer, the unix millisecond time of the delivery and the ids of the entries
+	OpXDeliver = "xdeliver"
+	// OpXAck acknowledges pending entries of a consumer group, Val holds the group and the ids
+	OpXAck = "xack"
+	// OpPFAdd adds elements to the HyperLogLog stored under the key, Val holds the elements
+	OpPFAdd = "pfadd"
+	// OpBFReserve creates a Bloom filter under the key, Val holds its error rate and capacity as decimal text
+	OpBFReserve = "bfreserve"
+	// OpBFAdd adds items to the Bloom filter stored under the key, Val holds the items
+	OpBFAdd = "bfadd"
+	// OpCMSInit creates a Count-Min Sketch under the key, Val holds its width and depth as decimal text
+	OpCMSInit = "cmsinit"
+	// OpCMSIncrBy counts items in the Count-Min Sketch stored under the key, Val holds the item
+	// increment pairs with increments as decimal text
+	OpCMSIncrBy = "cmsincrby"
+)
+
+var ErrShortOperation = errors.New("operation payload is truncated")
 
 type Operation struct {
 	Op  string
+	Key string
 	Val []byte
 	Ttl time.Time
 }
+
+// MarshalBinary encodes the operation as length prefixed fields followed by the ttl in unix nanoseconds
+func (o Operation) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, 0, len(o.Op)+len(o.Key)+len(o.Val)+4*binary.MaxVarintLen64)
+
+	buf = appendBytes(buf, []byte(o.Op))
+	buf = appendBytes(buf, []byte(o.Key))
+	buf = appendBytes(buf, o.Val)
+
+	// zero time is kept as zero so it round trips to a zero time
+	var ttl int64
+	if !o.Ttl.IsZero() {
+		ttl = o.Ttl.UnixNano()
+	}
+	buf = binary.AppendVarint(buf, ttl)
+
+	return buf, nil
+}
+
+// UnmarshalBinary decodes an operation previously encoded with MarshalBinary
+func (o *Operation) UnmarshalBinary(buf []byte) error {
+	op, buf, err := readBytes(buf)
+	if err != nil {
+		return err
+	}
+	key, buf, err := readBytes(buf)
+	if err != nil {
+		return err
+	}
+	val, buf, err := readBytes(buf)
+	if err != nil {
+		return err
+	}
+	ttl, n := binary.Varint(buf)
+	if n <= 0 {
+		return ErrShortOperation
+	}
+
+	o.Op = string(op)
+	o.Key = string(key)
+	o.Val = val
+	o.Ttl = time.Time{}
+	if ttl != 0 {
+		o.Ttl = time.Unix(0, ttl)
+	}
+
+	return nil
+}
+
+// AppendFields appends the fields to buf as length prefixed byte strings
+func AppendFields(buf []byte, fields ...[]byte) []byte {
+	for _, field := range fields {
+		buf = appendBytes(buf, field)
+	}
+	return buf
+}
+
+// SplitFields decodes the fields appended with AppendFields
+func SplitFields(buf []byte) ([][]byte, error) {
+	var fields [][]byte
+	for len(buf) > 0 {
+		field, rest, err := readBytes(buf)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, field)
+		buf = rest
+	}
+	return fields, nil
+}
+
+func appendBytes(buf, b []byte) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(b)))
+	return append(buf, b...)
+}
+
+func readBytes(buf []byte) ([]byte, []byte, error) {
+	size, n := binary.Uvarint(buf)
+	if n <= 0 || uint64(len(buf)-n) < size {
+		return nil, nil, ErrShortOperation
+	}
+	buf = buf[n:]
+
+	// copy so the operation does not alias the read buffer
+	b := make([]byte, size)
+	copy(b, buf[:size])
+
+	return b, buf[size:], nil
+}
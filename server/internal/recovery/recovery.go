@@ -0,0 +1,116 @@
+// Package recovery restores the cache on startup from the newest snapshot and the
+// append only file operations written after it.
+package recovery
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/Lucascluz/memora-server/internal/aof"
+	"github.com/Lucascluz/memora-server/internal/cache"
+	"github.com/Lucascluz/memora-server/internal/snapshot"
+)
+
+type Config struct {
+	// SnapshotDir holds the snapshot files, empty when snapshots are disabled
+	SnapshotDir string
+	// AOFPath is the append only file, empty when it is disabled
+	AOFPath string
+}
+
+// Report describes what was restored
+type Report struct {
+	// Snapshot is the path of the loaded snapshot, empty when none was used
+	Snapshot string
+	// Loaded is the number of entries loaded from the snapshot
+	Loaded int
+	// Replayed is the number of append only file operations applied on top of it
+	Replayed int
+	// Truncated is the number of bytes of an incomplete final record cut off the append only file
+	Truncated int64
+	// Rewrite is set when the append only file does not hold everything the cache
+	// was restored with and has to be rewritten before new writes are appended
+	Rewrite bool
+}
+
+// Recover fills c from the persisted state described by cfg.
+//
+// When the newest snapshot was taken from the current append only file, it is loaded and only
+// the operations after the offset it covers are replayed. When the file was rewritten since, it
+// holds the whole dataset on its own and the snapshot is ignored. A damaged record in the middle
+// of the append only file is returned as an error instead of silently dropping the rest.
+func Recover(c *cache.Cache, cfg Config) (Report, error) {
+	var report Report
+
+	var snap *snapshot.File
+	if cfg.SnapshotDir != "" {
+		f, err := snapshot.LoadLatest(cfg.SnapshotDir)
+		if err != nil && !errors.Is(err, snapshot.ErrNotFound) {
+			return report, err
+		}
+		snap = f
+	}
+
+	if cfg.AOFPath == "" {
+		if snap != nil {
+			report.load(c, snap)
+		}
+		return report, nil
+	}
+
+	id, err := aof.ReadID(cfg.AOFPath)
+	if err != nil {
+		return report, err
+	}
+
+	switch {
+	case snap != nil && id != 0 && snap.JournalID == id:
+		// the snapshot covers the append only file up to its offset, replay the tail only
+		report.load(c, snap)
+		if err := report.replay(c, cfg.AOFPath, snap.JournalOffset); err != nil {
+			return report, err
+		}
+
+	case snap != nil && (id == 0 || newerThan(snap, cfg.AOFPath)):
+		// there is no append only file yet, or it is older than a snapshot taken while it was disabled
+		report.load(c, snap)
+		report.Rewrite = true
+
+	case id != 0:
+		// the append only file was rewritten after the snapshot and holds the whole dataset
+		if err := report.replay(c, cfg.AOFPath, 0); err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+func (r *Report) load(c *cache.Cache, snap *snapshot.File) {
+	c.Load(snap.Items)
+	r.Snapshot = snap.Path
+	r.Loaded = len(snap.Items)
+}
+
+func (r *Report) replay(c *cache.Cache, path string, offset int64) error {
+	rec, err := aof.Replay(path, offset, c.Apply)
+	r.Replayed = rec.Replayed
+	r.Truncated = rec.Truncated
+	if err != nil {
+		return fmt.Errorf("failed to replay append only file after %d operations: %w", rec.Replayed, err)
+	}
+	return nil
+}
+
+// newerThan reports whether snap was taken without a journal after the last write to path
+func newerThan(snap *snapshot.File, path string) bool {
+	if snap.JournalID != 0 {
+		return false
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+	return snap.Created.After(info.ModTime())
+}
@@ -0,0 +1,243 @@
+package recovery
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Lucascluz/memora-server/internal/aof"
+	"github.com/Lucascluz/memora-server/internal/cache"
+	"github.com/Lucascluz/memora-server/internal/snapshot"
+)
+
+// opsPerWrite is the number of journal operations every iteration of write appends
+const opsPerWrite = 4
+
+// write runs the iterations from to to of a mix of operations against c
+func write(t *testing.T, c *cache.Cache, from, to int) {
+	t.Helper()
+
+	for i := from; i < to; i++ {
+		if err := c.Set(fmt.Sprint("key", i), []byte(fmt.Sprint("value", i)), 0); err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err := c.IncrBy(fmt.Sprint("counter", i%3), 1, 0, false); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.RPush("list", []byte(fmt.Sprint(i))); err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err := c.HSet("hash", map[string][]byte{fmt.Sprint("field", i%5): []byte(fmt.Sprint(i))}); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// state describes every live entry of c, reading hashes back since their encoding is unordered
+func state(t *testing.T, c *cache.Cache) []string {
+	t.Helper()
+
+	var entries []string
+	for _, item := range c.Items() {
+		value := string(item.Value)
+		if item.Kind == cache.KindHash {
+			fields, _, err := c.HGetAll(item.Key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var pairs []string
+			for field, v := range fields {
+				pairs = append(pairs, field+"="+string(v))
+			}
+			slices.Sort(pairs)
+			value = strings.Join(pairs, ",")
+		}
+		entries = append(entries, fmt.Sprintf("%s %s %q %d", item.Key, item.Kind, value, item.Ttl))
+	}
+	slices.Sort(entries)
+	return entries
+}
+
+// store is a cache journaling into an append only file and saving snapshots of itself
+type store struct {
+	cfg     Config
+	cache   *cache.Cache
+	journal *aof.AOF
+	snaps   *snapshot.Snapshotter
+}
+
+func newStore(t *testing.T) *store {
+	t.Helper()
+
+	dir := t.TempDir()
+	s := &store{
+		cfg:   Config{SnapshotDir: filepath.Join(dir, "snapshots"), AOFPath: filepath.Join(dir, "memora.aof")},
+		cache: cache.NewCache(),
+	}
+	s.snaps = snapshot.New(s.cfg.SnapshotDir, 2, s.cache)
+	s.open(t)
+	return s
+}
+
+// open attaches the append only file to the cache and the snapshots
+func (s *store) open(t *testing.T) {
+	t.Helper()
+
+	journal, err := aof.Open(s.cfg.AOFPath, aof.FsyncNever)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.journal = journal
+	s.cache.SetJournal(journal)
+	s.snaps.SetJournal(journal)
+}
+
+func (s *store) save(t *testing.T) string {
+	t.Helper()
+
+	path, err := s.snaps.Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func (s *store) close(t *testing.T) {
+	t.Helper()
+
+	if err := s.journal.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// recover restores a new cache from the files of s and checks it matches the cache of s
+func (s *store) recover(t *testing.T) Report {
+	t.Helper()
+
+	restored := cache.NewCache()
+	report, err := Recover(restored, s.cfg)
+	if err != nil {
+		t.Fatalf("Recover() error = %v", err)
+	}
+	if got, want := state(t, restored), state(t, s.cache); !slices.Equal(got, want) {
+		t.Errorf("recovered entries = %v\nwant %v", got, want)
+	}
+	return report
+}
+
+func TestRecoverReplaysJournalTail(t *testing.T) {
+	s := newStore(t)
+	write(t, s.cache, 0, 10)
+	path := s.save(t)
+	loaded := len(s.cache.Items())
+	write(t, s.cache, 10, 15)
+	s.close(t)
+
+	report := s.recover(t)
+	if report.Snapshot != path || report.Loaded != loaded {
+		t.Errorf("Recover() loaded %d entries from %q, want %d from %q", report.Loaded, report.Snapshot, loaded, path)
+	}
+	if report.Replayed != 5*opsPerWrite {
+		t.Errorf("Recover() replayed %d operations, want the %d after the snapshot", report.Replayed, 5*opsPerWrite)
+	}
+	if report.Truncated != 0 || report.Rewrite {
+		t.Errorf("Recover() = %+v, want nothing truncated and no rewrite", report)
+	}
+}
+
+func TestRecoverReplaysRewrittenJournal(t *testing.T) {
+	s := newStore(t)
+	write(t, s.cache, 0, 10)
+	s.save(t)
+
+	// a key of the snapshot only comes back if the snapshot is wrongly loaded
+	if err := s.cache.Delete("key0"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.journal.Rewrite(s.cache.Dump); err != nil {
+		t.Fatal(err)
+	}
+	write(t, s.cache, 10, 15)
+	s.close(t)
+
+	report := s.recover(t)
+	if report.Snapshot != "" {
+		t.Errorf("Recover() loaded snapshot %s, want the rewritten journal alone", report.Snapshot)
+	}
+	if report.Replayed <= 5*opsPerWrite {
+		t.Errorf("Recover() replayed %d operations, want the whole rewritten journal", report.Replayed)
+	}
+}
+
+func TestRecoverTruncatesTornTail(t *testing.T) {
+	s := newStore(t)
+	write(t, s.cache, 0, 10)
+	s.save(t)
+	write(t, s.cache, 10, 15)
+	want := state(t, s.cache)
+
+	// the last operation is torn by a crash and must be dropped
+	if err := s.cache.Set("torn", []byte("value"), 0); err != nil {
+		t.Fatal(err)
+	}
+	s.close(t)
+	info, err := os.Stat(s.cfg.AOFPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Truncate(s.cfg.AOFPath, info.Size()-3); err != nil {
+		t.Fatal(err)
+	}
+
+	restored := cache.NewCache()
+	report, err := Recover(restored, s.cfg)
+	if err != nil {
+		t.Fatalf("Recover() error = %v", err)
+	}
+	if got := state(t, restored); !slices.Equal(got, want) {
+		t.Errorf("recovered entries = %v\nwant %v", got, want)
+	}
+	if report.Replayed != 5*opsPerWrite || report.Truncated == 0 {
+		t.Errorf("Recover() = %d replayed, %d truncated, want %d and the torn record", report.Replayed, report.Truncated, 5*opsPerWrite)
+	}
+}
+
+func TestRecoverLoadsSnapshotTakenWithoutJournal(t *testing.T) {
+	s := newStore(t)
+	write(t, s.cache, 0, 10)
+	s.close(t)
+
+	// the server is restarted with the journal disabled, which leaves the old file behind
+	old := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(s.cfg.AOFPath, old, old); err != nil {
+		t.Fatal(err)
+	}
+	s.cache.SetJournal(nil)
+	s.snaps.SetJournal(nil)
+	write(t, s.cache, 10, 15)
+	path := s.save(t)
+
+	report := s.recover(t)
+	if report.Snapshot != path || report.Replayed != 0 || !report.Rewrite {
+		t.Errorf("Recover() = %+v, want snapshot %s loaded alone and a rewrite", report, path)
+	}
+
+	// once rewritten the journal holds the whole dataset and is newer than the snapshot. The
+	// restart is not instant, file times come from a coarser clock than the snapshot time.
+	time.Sleep(50 * time.Millisecond)
+	s.open(t)
+	if err := s.journal.Rewrite(s.cache.Dump); err != nil {
+		t.Fatal(err)
+	}
+	write(t, s.cache, 15, 20)
+	s.close(t)
+
+	report = s.recover(t)
+	if report.Snapshot != "" || report.Rewrite {
+		t.Errorf("Recover() after the rewrite = %+v, want the journal alone and no rewrite", report)
+	}
+}
@@ -0,0 +1,148 @@
+package server
+
+import (
+	"errors"
+
+	pb "github.com/Lucascluz/memora-proto/gen"
+	"github.com/Lucascluz/memora-server/internal/cache"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorDomain is the domain of the ErrorInfo detail attached to every error of the server
+const errorDomain = "memora"
+
+// reasons of the ErrorInfo details, they tell apart the errors sharing a status code
+const (
+	reasonNotConnected       = "NOT_CONNECTED"
+	reasonSessionExpired     = "SESSION_EXPIRED"
+	reasonInvalidCredentials = "INVALID_CREDENTIALS"
+	reasonPermissionDenied   = "PERMISSION_DENIED"
+	reasonKeyNotFound        = "KEY_NOT_FOUND"
+	reasonKeyExists          = "KEY_EXISTS"
+	reasonFieldNotFound      = "FIELD_NOT_FOUND"
+	reasonMemberNotFound     = "MEMBER_NOT_FOUND"
+	reasonGroupNotFound      = "GROUP_NOT_FOUND"
+	reasonGroupExists        = "GROUP_EXISTS"
+	reasonWrongType          = "WRONG_TYPE"
+	reasonVersionMismatch    = "VERSION_MISMATCH"
+	reasonUserNotFound       = "USER_NOT_FOUND"
+	reasonInvalidArgument    = "INVALID_ARGUMENT"
+	reasonOutOfMemory        = "OUT_OF_MEMORY"
+	reasonNotANumber         = "NOT_A_NUMBER"
+	reasonOverflow           = "OVERFLOW"
+	reasonTimeout            = "TIMEOUT"
+	reasonShuttingDown       = "SHUTTING_DOWN"
+	reasonDisabled           = "DISABLED"
+	reasonInProgress         = "IN_PROGRESS"
+	reasonInvalidFile        = "INVALID_FILE"
+	reasonInternal           = "INTERNAL"
+)
+
+var (
+	// errNotConnected rejects requests whose client key is unknown, the client has to connect again
+	errNotConnected = newError(codes.Unauthenticated, reasonNotConnected, "client not connected", nil)
+	// errSessionExpired rejects requests whose client key expired, the client has to connect again
+	errSessionExpired = newError(codes.Unauthenticated, reasonSessionExpired, "client key expired", nil)
+	// errShuttingDown ends the blocking commands once the server shuts down
+	errShuttingDown = newError(codes.Unavailable, reasonShuttingDown, "server is shutting down", nil)
+)
+
+// newError returns a status error with an ErrorInfo detail carrying reason and metadata
+func newError(code codes.Code, reason, message string, metadata map[string]string) error {
+	st := status.New(code, message)
+	if detailed, err := st.WithDetails(&errdetails.ErrorInfo{Reason: reason, Domain: errorDomain, Metadata: metadata}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// invalidArgument rejects a request field
+func invalidArgument(field string, err error) error {
+	return newError(codes.InvalidArgument, reasonInvalidArgument, err.Error(), map[string]string{"field": field})
+}
+
+// disabled rejects requests for a feature the server runs without
+func disabled(feature string) error {
+	return newError(codes.FailedPrecondition, reasonDisabled, feature+" disabled", nil)
+}
+
+// internalError reports a failure of the server itself
+func internalError(err error) error {
+	return newError(codes.Internal, reasonInternal, err.Error(), nil)
+}
+
+// keyError converts an error of the cache about key into a status error, or about the key named
+// by a cache.KeyError for the commands on several keys
+func keyError(err error, key string) error {
+	var keyErr *cache.KeyError
+	if errors.As(err, &keyErr) {
+		err, key = keyErr.Err, keyErr.Key
+	}
+	metadata := map[string]string{"key": key}
+	switch {
+	case errors.Is(err, cache.ErrNotFound):
+		return newError(codes.NotFound, reasonKeyNotFound, "key not found", metadata)
+	case errors.Is(err, cache.ErrWrongType):
+		return newError(codes.FailedPrecondition, reasonWrongType, err.Error(), metadata)
+	case errors.Is(err, cache.ErrExists):
+		return newError(codes.AlreadyExists, reasonKeyExists, err.Error(), metadata)
+	case errors.Is(err, cache.ErrVersionMismatch):
+		return newError(codes.Aborted, reasonVersionMismatch, err.Error(), metadata)
+	case errors.Is(err, cache.ErrNotInteger), errors.Is(err, cache.ErrNotFloat), errors.Is(err, cache.ErrInvalidScore):
+		return newError(codes.InvalidArgument, reasonNotANumber, err.Error(), metadata)
+	case errors.Is(err, cache.ErrOverflow):
+		return newError(codes.InvalidArgument, reasonOverflow, err.Error(), metadata)
+	case errors.Is(err, cache.ErrOutOfMemory):
+		return newError(codes.ResourceExhausted, reasonOutOfMemory, err.Error(), metadata)
+	case errors.Is(err, cache.ErrNilValue), errors.Is(err, cache.ErrExpired), errors.Is(err, cache.ErrInvalidTTL),
+		errors.Is(err, cache.ErrNoFields), errors.Is(err, cache.ErrNoValues), errors.Is(err, cache.ErrInvalidCount),
+		errors.Is(err, cache.ErrNoMembers), errors.Is(err, cache.ErrNoKeys), errors.Is(err, cache.ErrInvalidFlags),
+		errors.Is(err, cache.ErrInvalidStreamID), errors.Is(err, cache.ErrNoItems), errors.Is(err, cache.ErrInvalidProbability),
+		errors.Is(err, cache.ErrInvalidCapacity), errors.Is(err, cache.ErrInvalidDimensions):
+		return newError(codes.InvalidArgument, reasonInvalidArgument, err.Error(), metadata)
+	}
+	return internalError(err)
+}
+
+// fieldError converts an error of the cache about a field of the hash under key into a status error
+func fieldError(err error, key, field string) error {
+	if errors.Is(err, cache.ErrFieldNotFound) {
+		return newError(codes.NotFound, reasonFieldNotFound, "field not found", map[string]string{"key": key, "field": field})
+	}
+	return keyError(err, key)
+}
+
+// memberError converts an error of the cache about a member of the sorted set under key into a status error
+func memberError(err error, key, member string) error {
+	if errors.Is(err, cache.ErrMemberNotFound) {
+		return newError(codes.NotFound, reasonMemberNotFound, "member not found", map[string]string{"key": key, "member": member})
+	}
+	return keyError(err, key)
+}
+
+// groupError converts an error of the cache about a consumer group of the stream under key into a status error
+func groupError(err error, key, group string) error {
+	metadata := map[string]string{"key": key, "group": group}
+	switch {
+	case errors.Is(err, cache.ErrGroupNotFound):
+		return newError(codes.NotFound, reasonGroupNotFound, "consumer group not found", metadata)
+	case errors.Is(err, cache.ErrGroupExists):
+		return newError(codes.AlreadyExists, reasonGroupExists, err.Error(), metadata)
+	}
+	return keyError(err, key)
+}
+
+// entryResult converts the status error of a single entry of a batch into its result
+func entryResult(err error) *pb.EntryResult {
+	st := status.Convert(err)
+	result := &pb.EntryResult{Code: int32(st.Code()), Message: st.Message()}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			result.Reason = info.Reason
+			break
+		}
+	}
+	return result
+}
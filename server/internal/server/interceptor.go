@@ -0,0 +1,180 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"path"
+	"strings"
+
+	pb "github.com/Lucascluz/memora-proto/gen"
+	"github.com/Lucascluz/memora-server/internal/session"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// authorizationHeader carries the client key as a bearer token
+	authorizationHeader = "authorization"
+	bearerPrefix        = "Bearer "
+)
+
+// publicMethods are served without a session
+var publicMethods = map[string]bool{
+	pb.MemoraService_Connect_FullMethodName: true,
+}
+
+// unrestrictedMethods are served to every connected client regardless of its ACL rules
+var unrestrictedMethods = map[string]bool{
+	pb.MemoraService_Disconnect_FullMethodName: true,
+}
+
+// entryKeyRequest is implemented by the request messages naming a single key
+type entryKeyRequest interface {
+	GetEntryKey() string
+}
+
+// entryKeysRequest is implemented by the request messages naming several keys
+type entryKeysRequest interface {
+	GetEntryKeys() []string
+}
+
+// destinationRequest is implemented by the request messages storing the result of several keys
+// under a destination key
+type destinationRequest interface {
+	GetDestination() string
+	GetEntryKeys() []string
+}
+
+// clientKeyRequest is implemented by the request messages with the deprecated clientKey field
+type clientKeyRequest interface {
+	GetClientKey() string
+}
+
+// UnaryInterceptor authenticates every unary request but Connect, authorizes it against the ACL
+// and stores its session in the request context, see session.FromContext
+func (s *Server) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if publicMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		sess, err := s.authenticate(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.authorize(sess, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		return handler(session.NewContext(ctx, sess), req)
+	}
+}
+
+// StreamInterceptor authenticates and authorizes every stream from its metadata, like UnaryInterceptor.
+// The keys of stream messages are not known yet, so the command is authorized first and the keys of
+// every message once it is received.
+func (s *Server) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if publicMethods[info.FullMethod] {
+			return handler(srv, ss)
+		}
+
+		sess, err := s.authenticate(ss.Context(), nil)
+		if err != nil {
+			return err
+		}
+		if err := s.authorize(sess, info.FullMethod, nil); err != nil {
+			return err
+		}
+		return handler(srv, &sessionStream{
+			ServerStream: ss,
+			ctx:          session.NewContext(ss.Context(), sess),
+			authorize:    func(m any) error { return s.authorize(sess, info.FullMethod, m) },
+		})
+	}
+}
+
+// authenticate finds the session of the bearer token in the request metadata. Requests without
+// one fall back to the deprecated clientKey field of the message.
+func (s *Server) authenticate(ctx context.Context, req any) (*session.Session, error) {
+	key := bearerToken(ctx)
+	if key == "" {
+		if r, ok := req.(clientKeyRequest); ok {
+			key = r.GetClientKey()
+		}
+	}
+	if key == "" {
+		return nil, errNotConnected
+	}
+
+	sess, err := s.sessions.Lookup(key)
+	if errors.Is(err, session.ErrExpired) {
+		return nil, errSessionExpired
+	}
+	if err != nil {
+		return nil, errNotConnected
+	}
+	return sess, nil
+}
+
+// authorize checks the ACL rules of the session user for the method and the keys of the request
+func (s *Server) authorize(sess *session.Session, method string, req any) error {
+	if s.acl == nil || unrestrictedMethods[method] {
+		return nil
+	}
+
+	// a batch is denied as a whole when one of its keys is
+	var keys []string
+	switch r := req.(type) {
+	case destinationRequest:
+		// the destination goes first, the ACL checks it for writing and the sources for reading
+		keys = append([]string{r.GetDestination()}, r.GetEntryKeys()...)
+	case entryKeyRequest:
+		keys = append(keys, r.GetEntryKey())
+	case entryKeysRequest:
+		keys = r.GetEntryKeys()
+	case *pb.MSetRequest:
+		for _, entry := range r.GetEntries() {
+			keys = append(keys, entry.GetEntryKey())
+		}
+	}
+
+	command := path.Base(method)
+	if err := s.acl.Check(sess.User, command, keys...); err != nil {
+		return newError(codes.PermissionDenied, reasonPermissionDenied, err.Error(), map[string]string{"command": command})
+	}
+	return nil
+}
+
+// bearerToken returns the token of the authorization metadata, empty when there is none
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, value := range md.Get(authorizationHeader) {
+		if len(value) > len(bearerPrefix) && strings.EqualFold(value[:len(bearerPrefix)], bearerPrefix) {
+			return value[len(bearerPrefix):]
+		}
+	}
+	return ""
+}
+
+// sessionStream overrides the context of a server stream with one carrying its session, and
+// authorizes the keys of the messages it receives
+type sessionStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	authorize func(m any) error
+}
+
+func (s *sessionStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *sessionStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.authorize(m)
+}
@@ -4,108 +4,1451 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
+	"path/filepath"
+	"sync"
 	"time"
 
 	pb "github.com/Lucascluz/memora-proto/gen"
+	"github.com/Lucascluz/memora-server/internal/acl"
+	"github.com/Lucascluz/memora-server/internal/aof"
+	"github.com/Lucascluz/memora-server/internal/auth"
 	"github.com/Lucascluz/memora-server/internal/cache"
+	"github.com/Lucascluz/memora-server/internal/session"
+	"github.com/Lucascluz/memora-server/internal/snapshot"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 )
 
 type Server struct {
 	pb.UnimplementedMemoraServiceServer
 
-	cache cache.Cache
-	conns map[string]string
+	cache     *cache.Cache
+	sessions  *session.Registry
+	users     *auth.Users
+	acl       *acl.ACL
+	snapshots *snapshot.Snapshotter
+	aof       *aof.AOF
+
+	// done is closed by Close to end the blocking pops and the group reads
+	done chan struct{}
+	stop sync.Once
+}
+
+// Option configures optional server features
+type Option func(*Server)
+
+// WithSnapshotter enables on demand snapshots through the Snapshot RPC
+func WithSnapshotter(s *snapshot.Snapshotter) Option {
+	return func(srv *Server) {
+		srv.snapshots = s
+	}
+}
+
+// WithAOF enables on demand append only file rewrites through the RewriteAOF RPC
+func WithAOF(a *aof.AOF) Option {
+	return func(srv *Server) {
+		srv.aof = a
+	}
+}
+
+// WithSessions tracks the connected clients in the given registry, e.g. to expire idle sessions
+func WithSessions(r *session.Registry) Option {
+	return func(srv *Server) {
+		srv.sessions = r
+	}
+}
+
+// WithAuth requires clients to connect with credentials found in the given users
+func WithAuth(u *auth.Users) Option {
+	return func(srv *Server) {
+		srv.users = u
+	}
+}
+
+// WithACL authorizes every request against the rules of the user of its session
+func WithACL(a *acl.ACL) Option {
+	return func(srv *Server) {
+		srv.acl = a
+	}
 }
 
-func NewServer() *Server {
-	return &Server{
-		cache: *cache.NewCache(),
-		conns: make(map[string]string),
+// NewServer creates a server that serves requests from the given cache
+func NewServer(c *cache.Cache, opts ...Option) *Server {
+	s := &Server{
+		cache:    c,
+		sessions: session.NewRegistry(),
+		done:     make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
+}
+
+// Close ends the blocking pops waiting for a value and the group reads waiting for entries, and
+// makes the following ones fail right away, so a graceful stop of the gRPC server does not wait for them
+func (s *Server) Close() {
+	s.stop.Do(func() { close(s.done) })
 }
 
 func (s *Server) Connect(ctx context.Context, req *pb.ConnectionRequest) (*pb.ConnectionResponse, error) {
 
-	// check if client is already connected
-	clientKey, exists := s.conns[req.ClientIP]
-	if exists {
-		return &pb.ConnectionResponse{
-			Success:   true,
-			ClientKey: clientKey,
-		}, errors.New("client already connected")
+	// the remote address is kept along the address the client reported, and a verified
+	// client certificate identifies the client by its subject
+	var remote, user string
+	if p, ok := peer.FromContext(ctx); ok {
+		remote = p.Addr.String()
+		user = certificateUser(p)
 	}
 
-	// generate key for the the client
-	clientKey = genKey(req.ClientIP)
+	// check the credentials when authentication is enabled, unless the certificate identified the client
+	if s.users != nil && (user == "" || req.Username != "" || req.ApiKey != "") {
+		name, err := s.users.Authenticate(req.Username, req.Password, req.ApiKey)
+		if err != nil {
+			return nil, newError(codes.Unauthenticated, reasonInvalidCredentials, err.Error(), nil)
+		}
+		user = name
+	}
 
-	// entry the client and key to the conn map
-	s.conns[req.ClientIP] = clientKey
+	// every connection gets its own session and client key
+	sess, err := s.sessions.Create(user, req.ClientIP, remote)
+	if err != nil {
+		return nil, internalError(err)
+	}
 
-	// return the new client key
+	// return the new client key and when it expires
+	var expiresAt int64
+	if !sess.Expires.IsZero() {
+		expiresAt = sess.Expires.UnixMilli()
+	}
 	return &pb.ConnectionResponse{
 		Success:   true,
-		ClientKey: clientKey,
+		ClientKey: sess.Key,
+		ExpiresAt: expiresAt,
 	}, nil
 }
 
+func (s *Server) Disconnect(ctx context.Context, req *pb.DisconnectRequest) (*pb.DisconnectResponse, error) {
+
+	// end the session the request was authenticated with
+	sess, ok := session.FromContext(ctx)
+	if !ok || !s.sessions.Remove(sess.Key) {
+		return nil, errNotConnected
+	}
+
+	return &pb.DisconnectResponse{Success: true}, nil
+}
+
 func (s *Server) Set(ctx context.Context, req *pb.SetRequest) (*pb.SetResponse, error) {
 
-	// verify the clientKey
-	if !s.isValidClientKey(req.ClientKey) {
-		return &pb.SetResponse{Success: false, Status: "client key not found"}, errors.New("client not connected")
+	// resolve the ttl into an absolute expiration time
+	ttl, err := expiration(req.Ttl, req.TtlMode)
+	if err != nil {
+		return nil, invalidArgument("ttl", err)
 	}
 
-	// set cache entry
-	err := s.cache.Set(req.EntryKey, req.Value, req.Ttl)
+	cond, err := condition(req.Condition)
 	if err != nil {
-		return nil, err
+		return nil, invalidArgument("condition", err)
 	}
 
-	return &pb.SetResponse{Success: true, Status: "success"}, nil
+	// set cache entry when the condition holds
+	version, err := s.cache.SetIf(req.EntryKey, req.Value, ttl, cond, req.Version)
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.SetResponse{Success: true, Status: "success", Version: version}, nil
 }
 
 func (s *Server) Get(ctx context.Context, req *pb.GetRequest) (*pb.GetResponse, error) {
 
-	// verify the clientKey
-	if !s.isValidClientKey(req.ClientKey) {
-		return &pb.GetResponse{Status: "client key not found", Value: nil}, errors.New("client not connected")
+	// get cache entry
+	value, version, err := s.cache.GetWithVersion(req.EntryKey)
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
 	}
 
-	// get cache entry
-	value, err := s.cache.Get(req.EntryKey)
+	return &pb.GetResponse{Status: "found", Value: value, Version: version}, nil
+}
+
+func (s *Server) GetSet(ctx context.Context, req *pb.GetSetRequest) (*pb.GetSetResponse, error) {
+
+	// resolve the ttl into an absolute expiration time
+	ttl, err := expiration(req.Ttl, req.TtlMode)
+	if err != nil {
+		return nil, invalidArgument("ttl", err)
+	}
+
+	// replace the cache entry, returning the old value
+	old, version, err := s.cache.GetSet(req.EntryKey, req.Value, ttl)
 	if err != nil {
-		return &pb.GetResponse{Status: "not found", Value: nil}, nil
+		return nil, keyError(err, req.EntryKey)
 	}
 
-	return &pb.GetResponse{Status: "found", Value: value}, nil
+	return &pb.GetSetResponse{Found: old != nil, OldValue: old, Version: version}, nil
 }
 
-func (s *Server) Delete(ctx context.Context, req *pb.DeleteRequest) (*pb.DeleteResponse, error) {
+func (s *Server) GetDel(ctx context.Context, req *pb.GetDelRequest) (*pb.GetDelResponse, error) {
 
-	// verify the clientKey
-	if !s.isValidClientKey(req.ClientKey) {
-		return &pb.DeleteResponse{Found: false, Status: "client key not found"}, errors.New("client not connected")
+	// delete the cache entry, returning its value
+	value, version, err := s.cache.GetDel(req.EntryKey)
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
 	}
 
+	return &pb.GetDelResponse{Value: value, Version: version}, nil
+}
+
+func (s *Server) Delete(ctx context.Context, req *pb.DeleteRequest) (*pb.DeleteResponse, error) {
+
 	// delete cache entry
 	err := s.cache.Delete(req.EntryKey)
 	if err != nil {
-		return &pb.DeleteResponse{Found: false, Status: "not found"}, nil
+		return nil, keyError(err, req.EntryKey)
 	}
 
 	return &pb.DeleteResponse{Found: true, Status: "deleted"}, nil
 }
 
-// isValidClientKey checks if the provided client key exists in the connections map
-func (s *Server) isValidClientKey(clientKey string) bool {
-	for _, key := range s.conns {
-		if key == clientKey {
-			return true
+func (s *Server) IncrBy(ctx context.Context, req *pb.IncrByRequest) (*pb.IncrByResponse, error) {
+
+	// resolve the ttl into an absolute expiration time
+	ttl, err := expiration(req.Ttl, req.TtlMode)
+	if err != nil {
+		return nil, invalidArgument("ttl", err)
+	}
+
+	// increment the counter, creating it at 0 when missing
+	value, version, err := s.cache.IncrBy(req.EntryKey, req.Delta, ttl, req.ReplaceTtl)
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.IncrByResponse{Value: value, Version: version}, nil
+}
+
+func (s *Server) IncrByFloat(ctx context.Context, req *pb.IncrByFloatRequest) (*pb.IncrByFloatResponse, error) {
+
+	// resolve the ttl into an absolute expiration time
+	ttl, err := expiration(req.Ttl, req.TtlMode)
+	if err != nil {
+		return nil, invalidArgument("ttl", err)
+	}
+
+	// the delta has to be a finite number for the result to be stored
+	if math.IsNaN(req.Delta) || math.IsInf(req.Delta, 0) {
+		return nil, invalidArgument("delta", errors.New("delta must be a finite number"))
+	}
+
+	// increment the counter, creating it at 0 when missing
+	value, version, err := s.cache.IncrByFloat(req.EntryKey, req.Delta, ttl, req.ReplaceTtl)
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.IncrByFloatResponse{Value: value, Version: version}, nil
+}
+
+func (s *Server) HSet(ctx context.Context, req *pb.HSetRequest) (*pb.HSetResponse, error) {
+
+	// a hash cannot be created without fields
+	if len(req.Fields) == 0 {
+		return nil, invalidArgument("fields", errors.New("at least one field is required"))
+	}
+
+	// map values cannot be null, an empty value is an empty byte string
+	for field, value := range req.Fields {
+		if value == nil {
+			req.Fields[field] = []byte{}
+		}
+	}
+
+	// set the fields, creating the hash when missing
+	added, version, err := s.cache.HSet(req.EntryKey, req.Fields)
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.HSetResponse{Added: int64(added), Version: version}, nil
+}
+
+func (s *Server) HGet(ctx context.Context, req *pb.HGetRequest) (*pb.HGetResponse, error) {
+
+	// get the field of the hash
+	value, version, err := s.cache.HGet(req.EntryKey, req.Field)
+	if err != nil {
+		return nil, fieldError(err, req.EntryKey, req.Field)
+	}
+
+	return &pb.HGetResponse{Value: value, Version: version}, nil
+}
+
+func (s *Server) HGetAll(ctx context.Context, req *pb.HGetAllRequest) (*pb.HGetAllResponse, error) {
+
+	// get every field of the hash
+	fields, version, err := s.cache.HGetAll(req.EntryKey)
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.HGetAllResponse{Fields: fields, Version: version}, nil
+}
+
+func (s *Server) HDel(ctx context.Context, req *pb.HDelRequest) (*pb.HDelResponse, error) {
+
+	// delete the fields, and the hash with its last field
+	deleted, err := s.cache.HDel(req.EntryKey, req.Fields...)
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.HDelResponse{Deleted: int64(deleted)}, nil
+}
+
+func (s *Server) HIncrBy(ctx context.Context, req *pb.HIncrByRequest) (*pb.HIncrByResponse, error) {
+
+	// increment the field, creating the hash and the field at 0 when missing
+	value, version, err := s.cache.HIncrBy(req.EntryKey, req.Field, req.Delta)
+	if err != nil {
+		return nil, fieldError(err, req.EntryKey, req.Field)
+	}
+
+	return &pb.HIncrByResponse{Value: value, Version: version}, nil
+}
+
+func (s *Server) LPush(ctx context.Context, req *pb.PushRequest) (*pb.PushResponse, error) {
+	return s.push(req, true)
+}
+
+func (s *Server) RPush(ctx context.Context, req *pb.PushRequest) (*pb.PushResponse, error) {
+	return s.push(req, false)
+}
+
+func (s *Server) push(req *pb.PushRequest, front bool) (*pb.PushResponse, error) {
+
+	// a list cannot be created without values
+	if len(req.Values) == 0 {
+		return nil, invalidArgument("values", errors.New("at least one value is required"))
+	}
+
+	// values cannot be null, an empty value is an empty byte string
+	for i, value := range req.Values {
+		if value == nil {
+			req.Values[i] = []byte{}
+		}
+	}
+
+	// push the values, handing them to the blocked pops first
+	var length int
+	var err error
+	if front {
+		length, err = s.cache.LPush(req.EntryKey, req.Values...)
+	} else {
+		length, err = s.cache.RPush(req.EntryKey, req.Values...)
+	}
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.PushResponse{Length: int64(length)}, nil
+}
+
+func (s *Server) LPop(ctx context.Context, req *pb.PopRequest) (*pb.PopResponse, error) {
+	return s.pop(req, true)
+}
+
+func (s *Server) RPop(ctx context.Context, req *pb.PopRequest) (*pb.PopResponse, error) {
+	return s.pop(req, false)
+}
+
+func (s *Server) pop(req *pb.PopRequest, front bool) (*pb.PopResponse, error) {
+
+	// a count of 0 pops a single value
+	count := req.Count
+	if count < 0 || count > math.MaxInt32 {
+		return nil, invalidArgument("count", errors.New("count is out of range"))
+	}
+	count = max(count, 1)
+
+	var values [][]byte
+	var err error
+	if front {
+		values, err = s.cache.LPop(req.EntryKey, int(count))
+	} else {
+		values, err = s.cache.RPop(req.EntryKey, int(count))
+	}
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.PopResponse{Values: values}, nil
+}
+
+func (s *Server) BLPop(ctx context.Context, req *pb.BlockingPopRequest) (*pb.BlockingPopResponse, error) {
+	return s.blockingPop(ctx, req, true)
+}
+
+func (s *Server) BRPop(ctx context.Context, req *pb.BlockingPopRequest) (*pb.BlockingPopResponse, error) {
+	return s.blockingPop(ctx, req, false)
+}
+
+func (s *Server) blockingPop(ctx context.Context, req *pb.BlockingPopRequest, front bool) (*pb.BlockingPopResponse, error) {
+
+	if len(req.EntryKeys) == 0 {
+		return nil, invalidArgument("entryKeys", errors.New("at least one key is required"))
+	}
+	if req.Timeout < 0 || req.Timeout > math.MaxInt64/int64(time.Millisecond) {
+		return nil, invalidArgument("timeout", errors.New("timeout is out of range"))
+	}
+
+	wait, cancel := s.waitContext(ctx, time.Duration(req.Timeout)*time.Millisecond)
+	defer cancel()
+
+	key, value, err := s.cache.BPop(wait, req.EntryKeys, front)
+	switch {
+	case err == nil:
+		return &pb.BlockingPopResponse{EntryKey: key, Value: value}, nil
+	case ctx.Err() != nil:
+		return nil, status.FromContextError(ctx.Err()).Err()
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		if s.closed() {
+			return nil, errShuttingDown
+		}
+		return nil, newError(codes.NotFound, reasonTimeout, "no value was pushed before the timeout", nil)
+	}
+	return nil, keyError(err, key)
+}
+
+// waitContext returns the context of a blocking command, done at the timeout unless it is 0, the
+// deadline of the call or the shutdown of the server, whichever comes first
+func (s *Server) waitContext(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	wait, cancel := context.WithCancel(ctx)
+	cancelWait := cancel
+	if timeout > 0 {
+		var cancelTimeout context.CancelFunc
+		wait, cancelTimeout = context.WithTimeout(wait, timeout)
+		cancelWait = func() {
+			cancelTimeout()
+			cancel()
+		}
+	}
+	go func() {
+		select {
+		case <-s.done:
+			cancel()
+		case <-wait.Done():
 		}
+	}()
+	return wait, cancelWait
+}
+
+// closed reports whether the server is shutting down
+func (s *Server) closed() bool {
+	select {
+	case <-s.done:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *Server) LRange(ctx context.Context, req *pb.LRangeRequest) (*pb.LRangeResponse, error) {
+
+	// read the range, empty for a missing key
+	values, err := s.cache.LRange(req.EntryKey, clampIndex(req.Start), clampIndex(req.Stop))
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.LRangeResponse{Values: values}, nil
+}
+
+func (s *Server) LTrim(ctx context.Context, req *pb.LTrimRequest) (*pb.LTrimResponse, error) {
+
+	// trim the list, deleting it when the range is empty
+	if err := s.cache.LTrim(req.EntryKey, clampIndex(req.Start), clampIndex(req.Stop)); err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.LTrimResponse{}, nil
+}
+
+func (s *Server) LLen(ctx context.Context, req *pb.LLenRequest) (*pb.LLenResponse, error) {
+
+	// get the length, 0 for a missing key
+	length, err := s.cache.LLen(req.EntryKey)
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.LLenResponse{Length: int64(length)}, nil
+}
+
+func (s *Server) SAdd(ctx context.Context, req *pb.SAddRequest) (*pb.SAddResponse, error) {
+
+	// a set cannot be created without members
+	if len(req.Members) == 0 {
+		return nil, invalidArgument("members", errors.New("at least one member is required"))
+	}
+
+	// add the members, creating the set when missing
+	added, err := s.cache.SAdd(req.EntryKey, req.Members...)
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.SAddResponse{Added: int64(added)}, nil
+}
+
+func (s *Server) SRem(ctx context.Context, req *pb.SRemRequest) (*pb.SRemResponse, error) {
+
+	// remove the members, and the set with its last member
+	removed, err := s.cache.SRem(req.EntryKey, req.Members...)
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.SRemResponse{Removed: int64(removed)}, nil
+}
+
+func (s *Server) SIsMember(ctx context.Context, req *pb.SIsMemberRequest) (*pb.SIsMemberResponse, error) {
+
+	// check the member, absent from a missing key
+	ok, err := s.cache.SIsMember(req.EntryKey, req.Member)
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.SIsMemberResponse{IsMember: ok}, nil
+}
+
+func (s *Server) SCard(ctx context.Context, req *pb.SCardRequest) (*pb.SCardResponse, error) {
+
+	// count the members, 0 for a missing key
+	n, err := s.cache.SCard(req.EntryKey)
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.SCardResponse{Cardinality: int64(n)}, nil
+}
+
+func (s *Server) SMembers(ctx context.Context, req *pb.SMembersRequest) (*pb.SMembersResponse, error) {
+
+	// read every member, none for a missing key
+	members, err := s.cache.SMembers(req.EntryKey)
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.SMembersResponse{Members: members}, nil
+}
+
+func (s *Server) SRandMember(ctx context.Context, req *pb.SRandMemberRequest) (*pb.SRandMemberResponse, error) {
+
+	// a count of 0 picks a single member
+	count := req.Count
+	if count < 0 || count > math.MaxInt32 {
+		return nil, invalidArgument("count", errors.New("count is out of range"))
+	}
+	count = max(count, 1)
+
+	members, err := s.cache.SRandMember(req.EntryKey, int(count))
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.SRandMemberResponse{Members: members}, nil
+}
+
+func (s *Server) SInter(ctx context.Context, req *pb.SetAlgebraRequest) (*pb.SetAlgebraResponse, error) {
+	return s.setAlgebra(req, s.cache.SInter)
+}
+
+func (s *Server) SUnion(ctx context.Context, req *pb.SetAlgebraRequest) (*pb.SetAlgebraResponse, error) {
+	return s.setAlgebra(req, s.cache.SUnion)
+}
+
+func (s *Server) SDiff(ctx context.Context, req *pb.SetAlgebraRequest) (*pb.SetAlgebraResponse, error) {
+	return s.setAlgebra(req, s.cache.SDiff)
+}
+
+func (s *Server) setAlgebra(req *pb.SetAlgebraRequest, combine func(keys ...string) ([]string, error)) (*pb.SetAlgebraResponse, error) {
+
+	if len(req.EntryKeys) == 0 {
+		return nil, invalidArgument("entryKeys", errors.New("at least one key is required"))
+	}
+
+	// combine the sets, a wrong type error names the key holding another type
+	members, err := combine(req.EntryKeys...)
+	if err != nil {
+		return nil, keyError(err, req.EntryKeys[0])
+	}
+
+	return &pb.SetAlgebraResponse{Members: members}, nil
+}
+
+func (s *Server) SInterStore(ctx context.Context, req *pb.SetAlgebraStoreRequest) (*pb.SetAlgebraStoreResponse, error) {
+	return s.setAlgebraStore(req, s.cache.SInterStore)
+}
+
+func (s *Server) SUnionStore(ctx context.Context, req *pb.SetAlgebraStoreRequest) (*pb.SetAlgebraStoreResponse, error) {
+	return s.setAlgebraStore(req, s.cache.SUnionStore)
+}
+
+func (s *Server) SDiffStore(ctx context.Context, req *pb.SetAlgebraStoreRequest) (*pb.SetAlgebraStoreResponse, error) {
+	return s.setAlgebraStore(req, s.cache.SDiffStore)
+}
+
+func (s *Server) setAlgebraStore(req *pb.SetAlgebraStoreRequest, combine func(dest string, keys ...string) (int, error)) (*pb.SetAlgebraStoreResponse, error) {
+
+	if len(req.EntryKeys) == 0 {
+		return nil, invalidArgument("entryKeys", errors.New("at least one key is required"))
+	}
+
+	// combine the sets and store the result, replacing the destination
+	n, err := combine(req.Destination, req.EntryKeys...)
+	if err != nil {
+		return nil, keyError(err, req.Destination)
+	}
+
+	return &pb.SetAlgebraStoreResponse{Cardinality: int64(n)}, nil
+}
+
+func (s *Server) ZAdd(ctx context.Context, req *pb.ZAddRequest) (*pb.ZAddResponse, error) {
+
+	// a sorted set cannot be created without members
+	if len(req.Members) == 0 {
+		return nil, invalidArgument("members", errors.New("at least one member is required"))
 	}
-	return false
+	if (req.Nx && req.Xx) || (req.Gt && req.Lt) || (req.Nx && (req.Gt || req.Lt)) {
+		return nil, invalidArgument("nx", errors.New("nx, xx, gt and lt flags are incompatible"))
+	}
+
+	members := make([]cache.ZMember, len(req.Members))
+	for i, m := range req.Members {
+		members[i] = cache.ZMember{Member: m.GetMember(), Score: m.GetScore()}
+	}
+
+	// set the scores, creating the sorted set when missing
+	flags := cache.ZAddFlags{NX: req.Nx, XX: req.Xx, GT: req.Gt, LT: req.Lt}
+	added, updated, err := s.cache.ZAdd(req.EntryKey, members, flags)
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.ZAddResponse{Added: int64(added), Updated: int64(updated)}, nil
+}
+
+func (s *Server) ZIncrBy(ctx context.Context, req *pb.ZIncrByRequest) (*pb.ZIncrByResponse, error) {
+
+	// increment the score, creating the sorted set and the member at 0 when missing
+	score, err := s.cache.ZIncrBy(req.EntryKey, req.Member, req.Delta)
+	if err != nil {
+		return nil, memberError(err, req.EntryKey, req.Member)
+	}
+
+	return &pb.ZIncrByResponse{Score: score}, nil
 }
 
-func genKey(ip string) string {
-	return fmt.Sprintf("%s-%d", ip, time.Now().UnixNano())
+func (s *Server) ZRem(ctx context.Context, req *pb.ZRemRequest) (*pb.ZRemResponse, error) {
+
+	// remove the members, and the sorted set with its last member
+	removed, err := s.cache.ZRem(req.EntryKey, req.Members...)
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.ZRemResponse{Removed: int64(removed)}, nil
+}
+
+func (s *Server) ZScore(ctx context.Context, req *pb.ZScoreRequest) (*pb.ZScoreResponse, error) {
+
+	// get the score of the member
+	score, err := s.cache.ZScore(req.EntryKey, req.Member)
+	if err != nil {
+		return nil, memberError(err, req.EntryKey, req.Member)
+	}
+
+	return &pb.ZScoreResponse{Score: score}, nil
+}
+
+func (s *Server) ZCard(ctx context.Context, req *pb.ZCardRequest) (*pb.ZCardResponse, error) {
+
+	// count the members, 0 for a missing key
+	n, err := s.cache.ZCard(req.EntryKey)
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.ZCardResponse{Cardinality: int64(n)}, nil
+}
+
+func (s *Server) ZRank(ctx context.Context, req *pb.ZRankRequest) (*pb.ZRankResponse, error) {
+
+	// get the rank of the member along with its score
+	rank, score, err := s.cache.ZRank(req.EntryKey, req.Member, req.Reverse)
+	if err != nil {
+		return nil, memberError(err, req.EntryKey, req.Member)
+	}
+
+	return &pb.ZRankResponse{Rank: int64(rank), Score: score}, nil
+}
+
+func (s *Server) ZRange(ctx context.Context, req *pb.ZRangeRequest) (*pb.ZRangeResponse, error) {
+
+	// read the ranks, empty for a missing key
+	members, err := s.cache.ZRange(req.EntryKey, clampIndex(req.Start), clampIndex(req.Stop), req.Reverse)
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.ZRangeResponse{Members: zmembers(members)}, nil
+}
+
+func (s *Server) ZRangeByScore(ctx context.Context, req *pb.ZRangeByScoreRequest) (*pb.ZRangeResponse, error) {
+
+	offset, limit, err := rangeLimit(req.Offset, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	min, max, err := scoreBounds(req.Min, req.Max)
+	if err != nil {
+		return nil, err
+	}
+
+	// read the scores, empty for a missing key
+	members, err := s.cache.ZRangeByScore(req.EntryKey, min, max, req.Reverse, offset, limit)
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.ZRangeResponse{Members: zmembers(members)}, nil
+}
+
+func (s *Server) ZRangeByLex(ctx context.Context, req *pb.ZRangeByLexRequest) (*pb.ZRangeResponse, error) {
+
+	offset, limit, err := rangeLimit(req.Offset, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// a missing bound leaves the range open on its side
+	min, max := cache.LexBound{Unbounded: true}, cache.LexBound{Unbounded: true}
+	if req.Min != nil {
+		min = cache.LexBound{Value: req.Min.Value, Exclusive: req.Min.Exclusive, Unbounded: req.Min.Unbounded}
+	}
+	if req.Max != nil {
+		max = cache.LexBound{Value: req.Max.Value, Exclusive: req.Max.Exclusive, Unbounded: req.Max.Unbounded}
+	}
+
+	// read the members, empty for a missing key
+	members, err := s.cache.ZRangeByLex(req.EntryKey, min, max, req.Reverse, offset, limit)
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.ZRangeResponse{Members: zmembers(members)}, nil
+}
+
+func (s *Server) ZRemRangeByScore(ctx context.Context, req *pb.ZRemRangeByScoreRequest) (*pb.ZRemRangeByScoreResponse, error) {
+
+	min, max, err := scoreBounds(req.Min, req.Max)
+	if err != nil {
+		return nil, err
+	}
+
+	// remove the scores, and the sorted set with its last member
+	removed, err := s.cache.ZRemRangeByScore(req.EntryKey, min, max)
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.ZRemRangeByScoreResponse{Removed: int64(removed)}, nil
+}
+
+// rangeLimit validates the offset and limit of a range request
+func rangeLimit(offset, limit int64) (int, int, error) {
+	if offset < 0 || offset > math.MaxInt32 {
+		return 0, 0, invalidArgument("offset", errors.New("offset is out of range"))
+	}
+	if limit < 0 || limit > math.MaxInt32 {
+		return 0, 0, invalidArgument("limit", errors.New("limit is out of range"))
+	}
+	return int(offset), int(limit), nil
+}
+
+// scoreBounds converts the bounds of a score range, a missing bound leaving the range open on its side
+func scoreBounds(min, max *pb.ScoreBound) (cache.ScoreBound, cache.ScoreBound, error) {
+	lower, upper := cache.ScoreBound{Value: math.Inf(-1)}, cache.ScoreBound{Value: math.Inf(1)}
+	if min != nil {
+		lower = cache.ScoreBound{Value: min.Value, Exclusive: min.Exclusive}
+	}
+	if max != nil {
+		upper = cache.ScoreBound{Value: max.Value, Exclusive: max.Exclusive}
+	}
+	if math.IsNaN(lower.Value) || math.IsNaN(upper.Value) {
+		return lower, upper, invalidArgument("min", errors.New("bounds must be numbers"))
+	}
+	return lower, upper, nil
+}
+
+// zmembers converts the members of a sorted set into their messages
+func zmembers(members []cache.ZMember) []*pb.ZMember {
+	result := make([]*pb.ZMember, len(members))
+	for i, m := range members {
+		result[i] = &pb.ZMember{Member: m.Member, Score: m.Score}
+	}
+	return result
+}
+
+func (s *Server) XAdd(ctx context.Context, req *pb.XAddRequest) (*pb.XAddResponse, error) {
+
+	// an entry cannot be added without fields
+	if len(req.Fields) == 0 {
+		return nil, invalidArgument("fields", errors.New("at least one field is required"))
+	}
+	if req.MaxLen < 0 || req.MaxLen > math.MaxInt32 {
+		return nil, invalidArgument("maxLen", errors.New("maxLen is out of range"))
+	}
+
+	// append the entry, creating the stream when missing
+	id, err := s.cache.XAdd(req.EntryKey, req.Fields, int(req.MaxLen))
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.XAddResponse{Id: id.String()}, nil
+}
+
+func (s *Server) XLen(ctx context.Context, req *pb.XLenRequest) (*pb.XLenResponse, error) {
+
+	// count the entries, 0 for a missing key
+	n, err := s.cache.XLen(req.EntryKey)
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.XLenResponse{Length: int64(n)}, nil
+}
+
+func (s *Server) XRange(ctx context.Context, req *pb.XRangeRequest) (*pb.XRangeResponse, error) {
+
+	if req.Count < 0 || req.Count > math.MaxInt32 {
+		return nil, invalidArgument("count", errors.New("count is out of range"))
+	}
+
+	// an empty bound leaves the range open on its side
+	start, end := cache.StreamID{}, cache.MaxStreamID
+	var err error
+	if req.Start != "" && req.Start != "-" {
+		if start, err = cache.ParseStreamID(req.Start, 0); err != nil {
+			return nil, invalidArgument("start", err)
+		}
+	}
+	if req.End != "" && req.End != "+" {
+		if end, err = cache.ParseStreamID(req.End, math.MaxUint64); err != nil {
+			return nil, invalidArgument("end", err)
+		}
+	}
+
+	// read the entries, empty for a missing key
+	entries, err := s.cache.XRange(req.EntryKey, start, end, int(req.Count), req.Reverse)
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.XRangeResponse{Entries: streamEntries(entries)}, nil
+}
+
+func (s *Server) XGroupCreate(ctx context.Context, req *pb.XGroupCreateRequest) (*pb.XGroupCreateResponse, error) {
+
+	// an empty start only delivers the entries added from now on
+	var start *cache.StreamID
+	if req.Start != "" && req.Start != "$" {
+		id, err := cache.ParseStreamID(req.Start, 0)
+		if err != nil {
+			return nil, invalidArgument("start", err)
+		}
+		start = &id
+	}
+
+	// create the group, and the stream when missing
+	if err := s.cache.XGroupCreate(req.EntryKey, req.Group, start); err != nil {
+		return nil, groupError(err, req.EntryKey, req.Group)
+	}
+
+	return &pb.XGroupCreateResponse{}, nil
+}
+
+func (s *Server) XGroupDestroy(ctx context.Context, req *pb.XGroupDestroyRequest) (*pb.XGroupDestroyResponse, error) {
+
+	// delete the group along with its pending entries
+	destroyed, err := s.cache.XGroupDestroy(req.EntryKey, req.Group)
+	if err != nil {
+		return nil, groupError(err, req.EntryKey, req.Group)
+	}
+
+	return &pb.XGroupDestroyResponse{Destroyed: destroyed}, nil
+}
+
+func (s *Server) XReadGroup(req *pb.XReadGroupRequest, stream pb.MemoraService_XReadGroupServer) error {
+
+	count := req.Count
+	if count == 0 {
+		count = 1
+	}
+	if count < 0 || count > math.MaxInt32 {
+		return invalidArgument("count", errors.New("count is out of range"))
+	}
+
+	// the stream lasts until the call is canceled or the server shuts down
+	ctx := stream.Context()
+	wait, cancel := s.waitContext(ctx, 0)
+	defer cancel()
+
+	for {
+		// deliver the next entries, waiting for them to be added
+		entries, err := s.cache.XReadGroup(wait, req.EntryKey, req.Group, req.Consumer, int(count))
+		switch {
+		case err == nil:
+		case ctx.Err() != nil:
+			return status.FromContextError(ctx.Err()).Err()
+		case errors.Is(err, context.Canceled):
+			return errShuttingDown
+		default:
+			return groupError(err, req.EntryKey, req.Group)
+		}
+
+		// entries lost with the connection stay pending until they are claimed
+		if err := stream.Send(&pb.XReadGroupResponse{Entries: streamEntries(entries)}); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) XAck(ctx context.Context, req *pb.XAckRequest) (*pb.XAckResponse, error) {
+
+	ids, err := streamIDs("ids", req.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	// remove the pending entries
+	acknowledged, err := s.cache.XAck(req.EntryKey, req.Group, ids...)
+	if err != nil {
+		return nil, groupError(err, req.EntryKey, req.Group)
+	}
+
+	return &pb.XAckResponse{Acknowledged: int64(acknowledged)}, nil
+}
+
+func (s *Server) XPending(ctx context.Context, req *pb.XPendingRequest) (*pb.XPendingResponse, error) {
+
+	if req.Count < 0 || req.Count > math.MaxInt32 {
+		return nil, invalidArgument("count", errors.New("count is out of range"))
+	}
+
+	// read the pending entries of the group, or of one of its consumers
+	pending, err := s.cache.XPending(req.EntryKey, req.Group, req.Consumer, int(req.Count))
+	if err != nil {
+		return nil, groupError(err, req.EntryKey, req.Group)
+	}
+
+	now := time.Now().UnixMilli()
+	entries := make([]*pb.PendingEntry, len(pending))
+	for i, p := range pending {
+		entries[i] = &pb.PendingEntry{
+			Id:         p.ID.String(),
+			Consumer:   p.Consumer,
+			Idle:       max(now-p.Delivered, 0),
+			Deliveries: int64(p.Deliveries),
+		}
+	}
+
+	return &pb.XPendingResponse{Entries: entries}, nil
+}
+
+func (s *Server) XAutoClaim(ctx context.Context, req *pb.XAutoClaimRequest) (*pb.XAutoClaimResponse, error) {
+
+	count := req.Count
+	if count == 0 {
+		count = 100
+	}
+	if count < 0 || count > math.MaxInt32 {
+		return nil, invalidArgument("count", errors.New("count is out of range"))
+	}
+	if req.MinIdle < 0 {
+		return nil, invalidArgument("minIdle", errors.New("minIdle cannot be negative"))
+	}
+	var start cache.StreamID
+	if req.Start != "" {
+		var err error
+		if start, err = cache.ParseStreamID(req.Start, 0); err != nil {
+			return nil, invalidArgument("start", err)
+		}
+	}
+
+	// hand the idle pending entries to the consumer
+	entries, deleted, next, err := s.cache.XAutoClaim(req.EntryKey, req.Group, req.Consumer, req.MinIdle, start, int(count))
+	if err != nil {
+		return nil, groupError(err, req.EntryKey, req.Group)
+	}
+
+	deletedIDs := make([]string, len(deleted))
+	for i, id := range deleted {
+		deletedIDs[i] = id.String()
+	}
+
+	return &pb.XAutoClaimResponse{Next: next.String(), Entries: streamEntries(entries), DeletedIds: deletedIDs}, nil
+}
+
+// streamIDs parses the ids of a request field
+func streamIDs(field string, values []string) ([]cache.StreamID, error) {
+	ids := make([]cache.StreamID, len(values))
+	for i, value := range values {
+		id, err := cache.ParseStreamID(value, 0)
+		if err != nil {
+			return nil, invalidArgument(field, err)
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+// streamEntries converts the entries of a stream into their messages
+func streamEntries(entries []cache.StreamEntry) []*pb.StreamEntry {
+	result := make([]*pb.StreamEntry, len(entries))
+	for i, e := range entries {
+		result[i] = &pb.StreamEntry{Id: e.ID.String(), Fields: e.Fields}
+	}
+	return result
+}
+
+func (s *Server) PFAdd(ctx context.Context, req *pb.PFAddRequest) (*pb.PFAddResponse, error) {
+
+	// add the elements, creating the HyperLogLog when missing
+	changed, err := s.cache.PFAdd(req.EntryKey, req.Elements...)
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.PFAddResponse{Changed: changed}, nil
+}
+
+func (s *Server) PFCount(ctx context.Context, req *pb.PFCountRequest) (*pb.PFCountResponse, error) {
+
+	if len(req.EntryKeys) == 0 {
+		return nil, invalidArgument("entryKeys", errors.New("at least one key is required"))
+	}
+
+	// estimate the distinct elements of the union, a wrong type error names the key holding another type
+	count, err := s.cache.PFCount(req.EntryKeys...)
+	if err != nil {
+		return nil, keyError(err, req.EntryKeys[0])
+	}
+
+	return &pb.PFCountResponse{Count: count}, nil
+}
+
+func (s *Server) PFMerge(ctx context.Context, req *pb.PFMergeRequest) (*pb.PFMergeResponse, error) {
+
+	// merge the HyperLogLogs into the destination
+	if err := s.cache.PFMerge(req.Destination, req.EntryKeys...); err != nil {
+		return nil, keyError(err, req.Destination)
+	}
+
+	return &pb.PFMergeResponse{}, nil
+}
+
+func (s *Server) BFReserve(ctx context.Context, req *pb.BFReserveRequest) (*pb.BFReserveResponse, error) {
+
+	if !(req.ErrorRate > 0 && req.ErrorRate < 1) {
+		return nil, invalidArgument("errorRate", cache.ErrInvalidProbability)
+	}
+	if req.Capacity < 1 {
+		return nil, invalidArgument("capacity", cache.ErrInvalidCapacity)
+	}
+
+	// create the filter, failing when the key exists
+	if err := s.cache.BFReserve(req.EntryKey, req.ErrorRate, req.Capacity); err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.BFReserveResponse{}, nil
+}
+
+func (s *Server) BFAdd(ctx context.Context, req *pb.BFAddRequest) (*pb.BFAddResponse, error) {
+
+	if len(req.Items) == 0 {
+		return nil, invalidArgument("items", errors.New("at least one item is required"))
+	}
+
+	// add the items, creating the filter when missing
+	added, err := s.cache.BFAdd(req.EntryKey, req.Items...)
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.BFAddResponse{Added: added}, nil
+}
+
+func (s *Server) BFExists(ctx context.Context, req *pb.BFExistsRequest) (*pb.BFExistsResponse, error) {
+
+	// check the items, none is held by a missing key
+	exists, err := s.cache.BFExists(req.EntryKey, req.Items...)
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.BFExistsResponse{Exists: exists}, nil
+}
+
+func (s *Server) CMSInitByDim(ctx context.Context, req *pb.CMSInitByDimRequest) (*pb.CMSInitResponse, error) {
+
+	// create the sketch, failing when the key exists
+	if err := s.cache.CMSInitByDim(req.EntryKey, req.Width, req.Depth); err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.CMSInitResponse{}, nil
+}
+
+func (s *Server) CMSInitByProb(ctx context.Context, req *pb.CMSInitByProbRequest) (*pb.CMSInitResponse, error) {
+
+	if !(req.ErrorRate > 0 && req.ErrorRate < 1) {
+		return nil, invalidArgument("errorRate", cache.ErrInvalidProbability)
+	}
+	if !(req.Probability > 0 && req.Probability < 1) {
+		return nil, invalidArgument("probability", cache.ErrInvalidProbability)
+	}
+
+	// size the sketch for the error rate and create it, failing when the key exists
+	if err := s.cache.CMSInitByProb(req.EntryKey, req.ErrorRate, req.Probability); err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.CMSInitResponse{}, nil
+}
+
+func (s *Server) CMSIncrBy(ctx context.Context, req *pb.CMSIncrByRequest) (*pb.CMSIncrByResponse, error) {
+
+	if len(req.Items) == 0 {
+		return nil, invalidArgument("items", errors.New("at least one item is required"))
+	}
+
+	items := make([]cache.CMSItem, len(req.Items))
+	for i, item := range req.Items {
+		items[i] = cache.CMSItem{Item: item.Item, Increment: item.Increment}
+	}
+
+	// count the items, nothing is counted when a counter would overflow
+	counts, err := s.cache.CMSIncrBy(req.EntryKey, items)
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.CMSIncrByResponse{Counts: counts}, nil
+}
+
+func (s *Server) CMSQuery(ctx context.Context, req *pb.CMSQueryRequest) (*pb.CMSQueryResponse, error) {
+
+	// estimate the counts of the items
+	counts, total, err := s.cache.CMSQuery(req.EntryKey, req.Items...)
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.CMSQueryResponse{Counts: counts, Total: total}, nil
+}
+
+func (s *Server) MGet(ctx context.Context, req *pb.MGetRequest) (*pb.MGetResponse, error) {
+
+	// read every key in a single pass over the cache, a missing or failed key only shows in its own result
+	values, errs := s.cache.MGet(req.EntryKeys)
+
+	results := make([]*pb.MGetResult, len(values))
+	for i, err := range errs {
+		switch {
+		case err == nil:
+			results[i] = &pb.MGetResult{Found: true, Value: values[i], Result: &pb.EntryResult{Success: true}}
+		case errors.Is(err, cache.ErrNotFound):
+			results[i] = &pb.MGetResult{Result: &pb.EntryResult{Success: true}}
+		default:
+			results[i] = &pb.MGetResult{Result: entryResult(keyError(err, req.EntryKeys[i]))}
+		}
+	}
+
+	return &pb.MGetResponse{Results: results}, nil
+}
+
+func (s *Server) MSet(ctx context.Context, req *pb.MSetRequest) (*pb.MSetResponse, error) {
+
+	// resolve the ttls, entries with an invalid one are not stored
+	results := make([]*pb.EntryResult, len(req.Entries))
+	items := make([]cache.Item, 0, len(req.Entries))
+	positions := make([]int, 0, len(req.Entries))
+	for i, entry := range req.Entries {
+		ttl, err := expiration(entry.Ttl, entry.TtlMode)
+		if err != nil {
+			results[i] = entryResult(invalidArgument("ttl", err))
+			continue
+		}
+		items = append(items, cache.Item{Key: entry.EntryKey, Value: entry.Value, Ttl: ttl})
+		positions = append(positions, i)
+	}
+
+	// store the entries in a single pass over the cache
+	var stored int64
+	for j, err := range s.cache.MSet(items) {
+		if err != nil {
+			results[positions[j]] = entryResult(keyError(err, items[j].Key))
+			continue
+		}
+		results[positions[j]] = &pb.EntryResult{Success: true}
+		stored++
+	}
+
+	return &pb.MSetResponse{Results: results, Stored: stored}, nil
+}
+
+func (s *Server) MDelete(ctx context.Context, req *pb.MDeleteRequest) (*pb.MDeleteResponse, error) {
+
+	// delete the keys in a single pass over the cache, missing keys are reported as not found
+	found := make([]bool, len(req.EntryKeys))
+	var deleted int64
+	for i, err := range s.cache.MDelete(req.EntryKeys) {
+		switch {
+		case err == nil:
+			found[i] = true
+			deleted++
+		case !errors.Is(err, cache.ErrNotFound):
+			return nil, internalError(err)
+		}
+	}
+
+	return &pb.MDeleteResponse{Found: found, Deleted: deleted}, nil
+}
+
+func (s *Server) TTL(ctx context.Context, req *pb.TTLRequest) (*pb.TTLResponse, error) {
+
+	// get the entry expiration
+	expiresAt, err := s.cache.TTL(req.EntryKey)
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	if expiresAt == 0 {
+		return &pb.TTLResponse{Found: true, Status: "persistent"}, nil
+	}
+
+	// an entry expiring within this millisecond still reports a positive ttl
+	ttl := max(expiresAt-time.Now().UnixMilli(), 1)
+
+	return &pb.TTLResponse{Found: true, Status: "volatile", ExpiresAt: expiresAt, Ttl: ttl}, nil
+}
+
+func (s *Server) Expire(ctx context.Context, req *pb.ExpireRequest) (*pb.ExpireResponse, error) {
+
+	// resolve the ttl into an absolute expiration time
+	ttl, err := expiration(req.Ttl, req.TtlMode)
+	if err != nil {
+		return nil, invalidArgument("ttl", err)
+	}
+
+	// update the entry expiration
+	err = s.cache.Expire(req.EntryKey, ttl)
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	return &pb.ExpireResponse{Found: true, Status: "updated"}, nil
+}
+
+func (s *Server) Persist(ctx context.Context, req *pb.PersistRequest) (*pb.PersistResponse, error) {
+
+	// remove the entry expiration
+	persisted, err := s.cache.Persist(req.EntryKey)
+	if err != nil {
+		return nil, keyError(err, req.EntryKey)
+	}
+
+	if !persisted {
+		return &pb.PersistResponse{Found: true, Status: "already persistent"}, nil
+	}
+
+	return &pb.PersistResponse{Found: true, Status: "persisted", Persisted: true}, nil
+}
+
+func (s *Server) Snapshot(ctx context.Context, req *pb.SnapshotRequest) (*pb.SnapshotResponse, error) {
+
+	if s.snapshots == nil {
+		return nil, disabled("snapshots")
+	}
+
+	// dump the cache into a new snapshot file
+	path, err := s.snapshots.Save()
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	return &pb.SnapshotResponse{Success: true, Status: "saved " + filepath.Base(path)}, nil
+}
+
+func (s *Server) RewriteAOF(ctx context.Context, req *pb.RewriteAOFRequest) (*pb.RewriteAOFResponse, error) {
+
+	if s.aof == nil {
+		return nil, disabled("append only file")
+	}
+
+	// rebuild the append only file from the current cache contents
+	err := s.aof.Rewrite(s.cache.Dump)
+	if errors.Is(err, aof.ErrRewriting) {
+		return nil, newError(codes.Aborted, reasonInProgress, "rewrite already in progress", nil)
+	}
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	return &pb.RewriteAOFResponse{Success: true, Status: fmt.Sprintf("rewritten to %d bytes", s.aof.Size())}, nil
+}
+
+func (s *Server) Stats(ctx context.Context, req *pb.StatsRequest) (*pb.StatsResponse, error) {
+
+	stats := s.cache.Stats()
+
+	return &pb.StatsResponse{
+		Success:      true,
+		Status:       "success",
+		Keys:         int64(stats.Keys),
+		VolatileKeys: int64(stats.Volatile),
+		ExpiredKeys:  stats.Expired,
+		EvictedKeys:  stats.Evicted,
+		UsedMemory:   stats.UsedMemory,
+		MaxMemory:    stats.MaxMemory,
+	}, nil
+}
+
+func (s *Server) ACLSetUser(ctx context.Context, req *pb.ACLSetUserRequest) (*pb.ACLSetUserResponse, error) {
+
+	if s.acl == nil {
+		return nil, disabled("acl")
+	}
+
+	// the rules only live in memory until ACLSave
+	if err := s.acl.SetUser(req.Username, req.Rules); err != nil {
+		return nil, invalidArgument("rules", err)
+	}
+
+	return &pb.ACLSetUserResponse{Success: true, Status: "success"}, nil
+}
+
+func (s *Server) ACLDelUser(ctx context.Context, req *pb.ACLDelUserRequest) (*pb.ACLDelUserResponse, error) {
+
+	if s.acl == nil {
+		return nil, disabled("acl")
+	}
+
+	if !s.acl.DeleteUser(req.Username) {
+		return nil, newError(codes.NotFound, reasonUserNotFound, "user not found", map[string]string{"user": req.Username})
+	}
+
+	return &pb.ACLDelUserResponse{Found: true, Status: "deleted"}, nil
+}
+
+func (s *Server) ACLList(ctx context.Context, req *pb.ACLListRequest) (*pb.ACLListResponse, error) {
+
+	if s.acl == nil {
+		return nil, disabled("acl")
+	}
+
+	return &pb.ACLListResponse{Success: true, Status: "success", Users: s.acl.List()}, nil
+}
+
+func (s *Server) ACLLoad(ctx context.Context, req *pb.ACLLoadRequest) (*pb.ACLLoadResponse, error) {
+
+	if s.acl == nil {
+		return nil, disabled("acl")
+	}
+
+	// discard the unsaved changes, an invalid file keeps the current rules
+	if err := s.acl.Reload(); err != nil {
+		return nil, newError(codes.FailedPrecondition, reasonInvalidFile, err.Error(), nil)
+	}
+
+	return &pb.ACLLoadResponse{Success: true, Status: "loaded"}, nil
+}
+
+func (s *Server) ACLSave(ctx context.Context, req *pb.ACLSaveRequest) (*pb.ACLSaveResponse, error) {
+
+	if s.acl == nil {
+		return nil, disabled("acl")
+	}
+
+	if err := s.acl.Save(); err != nil {
+		return nil, internalError(err)
+	}
+
+	return &pb.ACLSaveResponse{Success: true, Status: "saved"}, nil
+}
+
+// certificateUser returns the subject common name of the verified client certificate of a peer,
+// empty when the connection is not mutual TLS
+func certificateUser(p *peer.Peer) string {
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return ""
+	}
+	return info.State.VerifiedChains[0][0].Subject.CommonName
+}
+
+// condition converts a request set condition into a cache condition
+func condition(cond pb.SetCondition) (cache.Condition, error) {
+	switch cond {
+	case pb.SetCondition_ALWAYS:
+		return cache.Always, nil
+	case pb.SetCondition_IF_ABSENT:
+		return cache.IfAbsent, nil
+	case pb.SetCondition_IF_PRESENT:
+		return cache.IfPresent, nil
+	case pb.SetCondition_IF_VERSION:
+		return cache.IfVersion, nil
+	}
+	return 0, fmt.Errorf("unknown set condition %v", cond)
+}
+
+// clampIndex converts a list index of a request into an int, indexes beyond the range of int being
+// beyond the end of any list anyway
+func clampIndex(i int64) int {
+	return int(max(min(i, math.MaxInt32), math.MinInt32))
+}
+
+// expiration converts a request ttl into an absolute unix millisecond time, 0 never expires
+func expiration(ttl int64, mode pb.TtlMode) (int64, error) {
+	if ttl == 0 {
+		return 0, nil
+	}
+	if ttl < 0 {
+		return 0, errors.New("ttl cannot be negative")
+	}
+
+	switch mode {
+	case pb.TtlMode_ABSOLUTE_SECONDS:
+		if ttl > math.MaxInt64/1000 {
+			return 0, errors.New("ttl is out of range")
+		}
+		return ttl * 1000, nil
+	case pb.TtlMode_RELATIVE_MILLISECONDS:
+		now := time.Now().UnixMilli()
+		if ttl > math.MaxInt64-now {
+			return 0, errors.New("ttl is out of range")
+		}
+		return now + ttl, nil
+	}
+
+	return 0, fmt.Errorf("unknown ttl mode %v", mode)
 }
@@ -0,0 +1,230 @@
+// Package session keeps track of the clients connected to the server, indexed by the
+// client key handed out on Connect.
+package session
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	ErrNotFound = errors.New("client not connected")
+	ErrExpired  = errors.New("client key expired")
+)
+
+// Session is a connected client
+type Session struct {
+	// Key is the client key sent with every request, a random token
+	Key string
+	// User is the name the client authenticated as, empty when authentication is disabled
+	User string
+	// ClientIP is the address the client reported when connecting
+	ClientIP string
+	// Peer is the remote address the connection came from
+	Peer    string
+	Created time.Time
+	// Expires is when the client key stops being accepted, zero when it does not expire
+	Expires time.Time
+
+	// lastSeen is the unix nano time of the last request, updated concurrently by lookups
+	lastSeen atomic.Int64
+}
+
+// LastSeen returns the time of the last request made with the session
+func (s *Session) LastSeen() time.Time {
+	return time.Unix(0, s.lastSeen.Load())
+}
+
+func (s *Session) touch(now time.Time) {
+	s.lastSeen.Store(now.UnixNano())
+}
+
+// expired reports whether the session outlived its token or was idle for too long
+func (s *Session) expired(now time.Time, idle time.Duration) bool {
+	if !s.Expires.IsZero() && !now.Before(s.Expires) {
+		return true
+	}
+	return idle > 0 && now.Sub(s.LastSeen()) > idle
+}
+
+// Registry is a concurrent index of the sessions by client key
+type Registry struct {
+	mu       sync.RWMutex
+	sessions map[string]*Session
+
+	idle     time.Duration
+	tokenTTL time.Duration
+
+	done chan struct{}
+	stop sync.Once
+	wg   sync.WaitGroup
+}
+
+// Option configures a registry created by NewRegistry
+type Option func(*Registry)
+
+// WithIdleTimeout ends the sessions that made no request for longer than idle
+func WithIdleTimeout(idle time.Duration) Option {
+	return func(r *Registry) {
+		r.idle = idle
+	}
+}
+
+// WithTokenTTL makes client keys expire ttl after they were issued, the client has to connect again
+func WithTokenTTL(ttl time.Duration) Option {
+	return func(r *Registry) {
+		r.tokenTTL = ttl
+	}
+}
+
+func NewRegistry(opts ...Option) *Registry {
+	r := &Registry{
+		sessions: make(map[string]*Session),
+		done:     make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Create registers a new session for a client authenticated as user and returns it
+func (r *Registry) Create(user, clientIP, peer string) (*Session, error) {
+	key, err := genKey()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	s := &Session{Key: key, User: user, ClientIP: clientIP, Peer: peer, Created: now}
+	if r.tokenTTL > 0 {
+		s.Expires = now.Add(r.tokenTTL)
+	}
+	s.touch(now)
+
+	r.mu.Lock()
+	r.sessions[key] = s
+	r.mu.Unlock()
+
+	return s, nil
+}
+
+// Lookup returns the live session of a client key and marks it as just seen. It fails with
+// ErrNotFound for unknown keys and ErrExpired for expired sessions, which are removed right away.
+func (r *Registry) Lookup(key string) (*Session, error) {
+	r.mu.RLock()
+	s, ok := r.sessions[key]
+	r.mu.RUnlock()
+
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	now := time.Now()
+	if s.expired(now, r.idle) {
+		r.Remove(key)
+		return nil, ErrExpired
+	}
+
+	s.touch(now)
+	return s, nil
+}
+
+// Remove ends the session of a client key, reporting whether it existed
+func (r *Registry) Remove(key string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, ok := r.sessions[key]
+	delete(r.sessions, key)
+	return ok
+}
+
+// Len returns the number of sessions
+func (r *Registry) Len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.sessions)
+}
+
+// Expire removes the idle sessions and the ones whose token expired, and returns how many it removed
+func (r *Registry) Expire() int {
+	now := time.Now()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	removed := 0
+	for key, s := range r.sessions {
+		if s.expired(now, r.idle) {
+			delete(r.sessions, key)
+			removed++
+		}
+	}
+	return removed
+}
+
+// StartReaper removes expired sessions in the background, so clients that went away without
+// disconnecting do not pile up. It does nothing when neither sessions nor tokens expire.
+func (r *Registry) StartReaper() {
+	period := r.idle
+	if period == 0 || (r.tokenTTL > 0 && r.tokenTTL < period) {
+		period = r.tokenTTL
+	}
+	if period == 0 {
+		return
+	}
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+
+		// expired sessions are already rejected on lookup, the reaper only reclaims them
+		ticker := time.NewTicker(max(period/4, 100*time.Millisecond))
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				r.Expire()
+			case <-r.done:
+				return
+			}
+		}
+	}()
+}
+
+// StopReaper stops the reaper started by StartReaper and waits for it to return
+func (r *Registry) StopReaper() {
+	r.stop.Do(func() { close(r.done) })
+	r.wg.Wait()
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying the session a request was authenticated with
+func NewContext(ctx context.Context, s *Session) context.Context {
+	return context.WithValue(ctx, contextKey{}, s)
+}
+
+// FromContext returns the session stored in ctx by NewContext
+func FromContext(ctx context.Context) (*Session, bool) {
+	s, ok := ctx.Value(contextKey{}).(*Session)
+	return s, ok
+}
+
+// genKey returns a cryptographically random client key
+func genKey() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate client key: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,432 @@
+// Package snapshot writes and loads point in time (.pit) copies of the cache.
+//
+// A snapshot file is laid out as
+//
+//	magic "MPIT" | version byte | created unix nano (8 bytes)
+//	journal id (8 bytes) | journal offset (8 bytes) | entry count (uvarint)
+//	entries: type (1 byte) | key length (uvarint) key | value length (uvarint) value | ttl in unix ms (varint)
+//	crc32c of everything above (4 bytes)
+//
+// The value of other types than strings is the encoding of their elements.
+package snapshot
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Lucascluz/memora-server/internal/cache"
+)
+
+const (
+	magic   = "MPIT"
+	version = 4
+
+	// the fixed part of the header before the entry count
+	headerSize = len(magic) + 1 + 8 + 8 + 8
+
+	prefix    = "memora-"
+	extension = ".pit"
+
+	// maxField bounds key and value lengths so a corrupt length cannot trigger a huge allocation
+	maxField = 1 << 29
+)
+
+var (
+	ErrBadHeader = errors.New("snapshot: invalid file header")
+	ErrVersion   = errors.New("snapshot: unsupported version")
+	ErrChecksum  = errors.New("snapshot: checksum mismatch")
+	ErrCorrupt   = errors.New("snapshot: corrupt entry")
+	ErrNotFound  = errors.New("snapshot: no valid snapshot found")
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// Journal is the append only file whose position is recorded in every snapshot
+type Journal interface {
+	// Position returns the id of the file and the offset right after its last operation
+	Position() (uint64, int64)
+	Sync() error
+}
+
+// File is a decoded snapshot
+type File struct {
+	Path    string
+	Created time.Time
+
+	// JournalID and JournalOffset locate the end of the journal operations the snapshot
+	// already contains, both are zero when no journal was attached
+	JournalID     uint64
+	JournalOffset int64
+
+	Items []cache.Item
+}
+
+// Snapshotter saves snapshots of a cache into a directory, keeping the newest ones
+type Snapshotter struct {
+	dir     string
+	keep    int
+	cache   *cache.Cache
+	journal Journal
+
+	// serializes saves so scheduled and on demand snapshots never overlap
+	mu sync.Mutex
+
+	done chan struct{}
+	stop sync.Once
+	wg   sync.WaitGroup
+}
+
+// New creates a snapshotter that keeps the newest keep snapshots of c in dir
+func New(dir string, keep int, c *cache.Cache) *Snapshotter {
+	if keep < 1 {
+		keep = 1
+	}
+	return &Snapshotter{
+		dir:   dir,
+		keep:  keep,
+		cache: c,
+		done:  make(chan struct{}),
+	}
+}
+
+// SetJournal makes every following snapshot record the position of j it covers,
+// so recovery only has to replay the operations appended after it
+func (s *Snapshotter) SetJournal(j Journal) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.journal = j
+}
+
+// Save dumps the cache into a new snapshot file and returns its path.
+// The cache is only locked while its entries are copied, encoding and writing happen afterwards.
+func (s *Snapshotter) Save() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return "", fmt.Errorf("snapshot: failed to create %s: %w", s.dir, err)
+	}
+
+	f := &File{Created: time.Now()}
+	f.Path = filepath.Join(s.dir, fmt.Sprintf("%s%020d%s", prefix, f.Created.UnixNano(), extension))
+
+	var mark func()
+	if s.journal != nil {
+		mark = func() { f.JournalID, f.JournalOffset = s.journal.Position() }
+	}
+	f.Items = s.cache.Checkpoint(mark)
+
+	// the journal must hold everything up to the recorded offset before the snapshot exists
+	if s.journal != nil {
+		if err := s.journal.Sync(); err != nil {
+			return "", fmt.Errorf("snapshot: failed to sync journal: %w", err)
+		}
+	}
+
+	if err := Write(f); err != nil {
+		return "", err
+	}
+	path := f.Path
+
+	s.prune()
+
+	return path, nil
+}
+
+// Start saves a snapshot every interval until Stop is called
+func (s *Snapshotter) Start(interval time.Duration) {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				path, err := s.Save()
+				if err != nil {
+					log.Printf("Scheduled snapshot failed: %v", err)
+					continue
+				}
+				log.Printf("Saved snapshot %s", path)
+			case <-s.done:
+				return
+			}
+		}
+	}()
+}
+
+// Stop ends the scheduled snapshots and waits for a running one to finish
+func (s *Snapshotter) Stop() {
+	s.stop.Do(func() { close(s.done) })
+	s.wg.Wait()
+}
+
+// prune removes the snapshots older than the newest s.keep ones
+func (s *Snapshotter) prune() {
+	paths, err := List(s.dir)
+	if err != nil {
+		log.Printf("Failed to list snapshots: %v", err)
+		return
+	}
+
+	for i := s.keep; i < len(paths); i++ {
+		if err := os.Remove(paths[i]); err != nil {
+			log.Printf("Failed to remove old snapshot %s: %v", paths[i], err)
+		}
+	}
+}
+
+// List returns the snapshot files in dir, newest first
+func List(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, extension) {
+			continue
+		}
+		paths = append(paths, filepath.Join(dir, name))
+	}
+
+	// the zero padded timestamp in the name makes lexical order chronological
+	sort.Sort(sort.Reverse(sort.StringSlice(paths)))
+
+	return paths, nil
+}
+
+// LoadLatest reads the newest snapshot in dir that passes validation.
+// Invalid snapshots are logged and skipped, ErrNotFound is returned when none is usable.
+func LoadLatest(dir string) (*File, error) {
+	paths, err := List(dir)
+	if err != nil {
+		return nil, fmt.Errorf("snapshot: failed to list %s: %w", dir, err)
+	}
+
+	for _, path := range paths {
+		f, err := Read(path)
+		if err != nil {
+			log.Printf("Skipping invalid snapshot %s: %v", path, err)
+			continue
+		}
+		return f, nil
+	}
+
+	return nil, ErrNotFound
+}
+
+// Write atomically stores the snapshot at f.Path.
+// The data is written to a temporary file that replaces f.Path once synced.
+func Write(f *File) error {
+	path := f.Path
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("snapshot: failed to create temporary file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := encode(tmp, f); err != nil {
+		tmp.Close()
+		return fmt.Errorf("snapshot: failed to write %s: %w", path, err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("snapshot: failed to sync %s: %w", path, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("snapshot: failed to rename %s: %w", path, err)
+	}
+
+	return syncDir(filepath.Dir(path))
+}
+
+// Read loads and validates the snapshot at path
+func Read(path string) (*File, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	f, err := decode(bufio.NewReader(file))
+	if err != nil {
+		return nil, err
+	}
+	f.Path = path
+
+	return f, nil
+}
+
+func encode(w io.Writer, f *File) error {
+	bw := bufio.NewWriter(w)
+	crc := crc32.New(crcTable)
+	mw := io.MultiWriter(bw, crc)
+
+	buf := make([]byte, 0, 64)
+	buf = append(buf, magic...)
+	buf = append(buf, version)
+	buf = binary.BigEndian.AppendUint64(buf, uint64(f.Created.UnixNano()))
+	buf = binary.BigEndian.AppendUint64(buf, f.JournalID)
+	buf = binary.BigEndian.AppendUint64(buf, uint64(f.JournalOffset))
+	buf = binary.AppendUvarint(buf, uint64(len(f.Items)))
+	if _, err := mw.Write(buf); err != nil {
+		return err
+	}
+
+	for _, item := range f.Items {
+		buf = buf[:0]
+		buf = append(buf, byte(item.Kind))
+		buf = binary.AppendUvarint(buf, uint64(len(item.Key)))
+		buf = append(buf, item.Key...)
+		buf = binary.AppendUvarint(buf, uint64(len(item.Value)))
+		if _, err := mw.Write(buf); err != nil {
+			return err
+		}
+		if _, err := mw.Write(item.Value); err != nil {
+			return err
+		}
+		if _, err := mw.Write(binary.AppendVarint(buf[:0], item.Ttl)); err != nil {
+			return err
+		}
+	}
+
+	if _, err := bw.Write(binary.BigEndian.AppendUint32(nil, crc.Sum32())); err != nil {
+		return err
+	}
+
+	return bw.Flush()
+}
+
+func decode(r *bufio.Reader) (*File, error) {
+	crc := crc32.New(crcTable)
+	hr := &hashReader{r: r, h: crc}
+
+	head := make([]byte, headerSize)
+	if _, err := io.ReadFull(hr, head); err != nil {
+		return nil, ErrBadHeader
+	}
+	if !bytes.Equal(head[:len(magic)], []byte(magic)) {
+		return nil, ErrBadHeader
+	}
+	ver := head[len(magic)]
+	if ver != version {
+		return nil, ErrVersion
+	}
+
+	fields := head[len(magic)+1:]
+	f := &File{
+		Created:       time.Unix(0, int64(binary.BigEndian.Uint64(fields[0:8]))),
+		JournalID:     binary.BigEndian.Uint64(fields[8:16]),
+		JournalOffset: int64(binary.BigEndian.Uint64(fields[16:24])),
+	}
+
+	count, err := binary.ReadUvarint(hr)
+	if err != nil {
+		return nil, ErrCorrupt
+	}
+
+	f.Items = make([]cache.Item, 0, min(count, 1<<16))
+	for i := uint64(0); i < count; i++ {
+		kind, err := hr.ReadByte()
+		if err != nil {
+			return nil, ErrCorrupt
+		}
+		key, err := readField(hr)
+		if err != nil {
+			return nil, err
+		}
+		value, err := readField(hr)
+		if err != nil {
+			return nil, err
+		}
+		ttl, err := binary.ReadVarint(hr)
+		if err != nil {
+			return nil, ErrCorrupt
+		}
+		f.Items = append(f.Items, cache.Item{Key: string(key), Kind: cache.Kind(kind), Value: value, Ttl: ttl})
+	}
+
+	sum := crc.Sum32()
+	trailer := make([]byte, 4)
+	if _, err := io.ReadFull(r, trailer); err != nil {
+		return nil, ErrCorrupt
+	}
+	if binary.BigEndian.Uint32(trailer) != sum {
+		return nil, ErrChecksum
+	}
+
+	return f, nil
+}
+
+func readField(r *hashReader) ([]byte, error) {
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, ErrCorrupt
+	}
+	if size > maxField {
+		return nil, ErrCorrupt
+	}
+
+	b := make([]byte, size)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, ErrCorrupt
+	}
+	return b, nil
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+
+	return d.Sync()
+}
+
+// hashReader feeds everything read through it into a running checksum
+type hashReader struct {
+	r *bufio.Reader
+	h hash.Hash32
+}
+
+func (hr *hashReader) Read(p []byte) (int, error) {
+	n, err := hr.r.Read(p)
+	hr.h.Write(p[:n])
+	return n, err
+}
+
+func (hr *hashReader) ReadByte() (byte, error) {
+	b, err := hr.r.ReadByte()
+	if err == nil {
+		hr.h.Write([]byte{b})
+	}
+	return b, err
+}
@@ -0,0 +1,312 @@
+package snapshot
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Lucascluz/memora-server/internal/aof"
+	"github.com/Lucascluz/memora-server/internal/cache"
+)
+
+// fill stores an entry of every type in c
+func fill(t *testing.T, c *cache.Cache) {
+	t.Helper()
+
+	later := time.Now().Add(time.Hour).UnixMilli()
+	steps := []error{
+		c.Set("string", []byte("value"), 0),
+		c.Set("volatile", []byte("value"), later),
+	}
+	_, _, err := c.HSet("hash", map[string][]byte{"a": []byte("1"), "b": []byte("2"), "c": []byte("3")})
+	steps = append(steps, err)
+	_, err = c.RPush("list", []byte("a"), []byte("b"), []byte("c"))
+	steps = append(steps, err)
+	_, err = c.SAdd("set", "x", "y", "z")
+	steps = append(steps, err)
+	_, _, err = c.ZAdd("zset", []cache.ZMember{{Member: "a", Score: 1}, {Member: "b", Score: 2}}, cache.ZAddFlags{})
+	steps = append(steps, err)
+	_, err = c.XAdd("stream", map[string][]byte{"f": []byte("v")}, 0)
+	steps = append(steps, err)
+	steps = append(steps, c.Expire("list", later))
+
+	for _, err := range steps {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// state describes every live entry of c, reading hashes back since their encoding is unordered
+func state(t *testing.T, c *cache.Cache) []string {
+	t.Helper()
+
+	var entries []string
+	for _, item := range c.Items() {
+		value := string(item.Value)
+		if item.Kind == cache.KindHash {
+			fields, _, err := c.HGetAll(item.Key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var pairs []string
+			for field, v := range fields {
+				pairs = append(pairs, field+"="+string(v))
+			}
+			slices.Sort(pairs)
+			value = strings.Join(pairs, ",")
+		}
+		entries = append(entries, fmt.Sprintf("%s %s %q %d", item.Key, item.Kind, value, item.Ttl))
+	}
+	slices.Sort(entries)
+	return entries
+}
+
+func TestSaveLoadRoundTrip(t *testing.T) {
+	c := cache.NewCache()
+	fill(t, c)
+
+	dir := t.TempDir()
+	path, err := New(dir, 3, c).Save()
+	if err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	f, err := LoadLatest(dir)
+	if err != nil {
+		t.Fatalf("LoadLatest() error = %v", err)
+	}
+	if f.Path != path {
+		t.Errorf("LoadLatest() path = %s, want %s", f.Path, path)
+	}
+	if f.JournalID != 0 || f.JournalOffset != 0 {
+		t.Errorf("LoadLatest() journal = %d at %d, want none", f.JournalID, f.JournalOffset)
+	}
+
+	restored := cache.NewCache()
+	restored.Load(f.Items)
+	want, got := state(t, c), state(t, restored)
+	if !slices.Equal(got, want) {
+		t.Errorf("restored entries = %v\nwant %v", got, want)
+	}
+	if len(got) != 7 {
+		t.Errorf("restored %d entries, want 7", len(got))
+	}
+}
+
+func TestLoadLatestSkipsInvalidSnapshots(t *testing.T) {
+	tests := []struct {
+		name string
+		// damage breaks the snapshot at path
+		damage func(t *testing.T, path string)
+	}{
+		{"partly written", func(t *testing.T, path string) {
+			info, err := os.Stat(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := os.Truncate(path, info.Size()/2); err != nil {
+				t.Fatal(err)
+			}
+		}},
+		{"flipped byte", func(t *testing.T, path string) {
+			buf, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			buf[len(buf)/2] ^= 0x40
+			if err := os.WriteFile(path, buf, 0o644); err != nil {
+				t.Fatal(err)
+			}
+		}},
+		{"empty", func(t *testing.T, path string) {
+			if err := os.WriteFile(path, nil, 0o644); err != nil {
+				t.Fatal(err)
+			}
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			c := cache.NewCache()
+			s := New(dir, 3, c)
+
+			if err := c.Set("key", []byte("old"), 0); err != nil {
+				t.Fatal(err)
+			}
+			older, err := s.Save()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := c.Set("key", []byte("new"), 0); err != nil {
+				t.Fatal(err)
+			}
+			newer, err := s.Save()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			tt.damage(t, newer)
+			if _, err := Read(newer); err == nil {
+				t.Fatal("Read() of the damaged snapshot succeeded, want an error")
+			}
+
+			f, err := LoadLatest(dir)
+			if err != nil {
+				t.Fatalf("LoadLatest() error = %v", err)
+			}
+			if f.Path != older || len(f.Items) != 1 || string(f.Items[0].Value) != "old" {
+				t.Errorf("LoadLatest() = %s with %v, want the older snapshot %s", f.Path, f.Items, older)
+			}
+
+			tt.damage(t, older)
+			if _, err := LoadLatest(dir); !errors.Is(err, ErrNotFound) {
+				t.Errorf("LoadLatest() with no valid snapshot error = %v, want %v", err, ErrNotFound)
+			}
+		})
+	}
+}
+
+func TestReadRejectsOtherVersions(t *testing.T) {
+	c := cache.NewCache()
+	fill(t, c)
+	path, err := New(t.TempDir(), 1, c).Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// version 3 files were written before entries had a type
+	for _, ver := range []byte{3, version + 1} {
+		buf[len(magic)] = ver
+		if err := os.WriteFile(path, buf, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := Read(path); !errors.Is(err, ErrVersion) {
+			t.Errorf("Read() of version %d error = %v, want %v", ver, err, ErrVersion)
+		}
+	}
+}
+
+func TestSavePrunesOldSnapshots(t *testing.T) {
+	dir := t.TempDir()
+	s := New(dir, 2, cache.NewCache())
+
+	var saved []string
+	for range 5 {
+		path, err := s.Save()
+		if err != nil {
+			t.Fatal(err)
+		}
+		saved = append(saved, path)
+	}
+
+	// files of other programs are left alone
+	other := filepath.Join(dir, "notes.txt")
+	if err := os.WriteFile(other, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	paths, err := List(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) != 2 || paths[1] != saved[4] {
+		t.Errorf("List() after pruning = %v, want the 2 newest ending with %s", paths, saved[4])
+	}
+	if _, err := os.Stat(other); err != nil {
+		t.Errorf("pruning removed an unrelated file: %v", err)
+	}
+}
+
+// TestSaveMarksJournalPosition saves while writers keep appending and checks that the snapshot
+// plus the journal operations after its offset rebuild exactly the final state
+func TestSaveMarksJournalPosition(t *testing.T) {
+	dir := t.TempDir()
+	journalPath := filepath.Join(dir, "memora.aof")
+	journal, err := aof.Open(journalPath, aof.FsyncNever)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer journal.Close()
+
+	c := cache.NewCache()
+	c.SetJournal(journal)
+	s := New(dir, 1, c)
+	s.SetJournal(journal)
+
+	// the writers keep going until every snapshot is saved
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	for w := range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; ; i++ {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				key := fmt.Sprint("counter", i%10)
+				if _, _, err := c.IncrBy(key, 1, 0, false); err != nil {
+					t.Error(err)
+					return
+				}
+				if _, err := c.RPush(fmt.Sprint("list", w), []byte(fmt.Sprint(i))); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}()
+	}
+
+	var files []*File
+	for range 5 {
+		path, err := s.Save()
+		if err != nil {
+			t.Fatal(err)
+		}
+		f, err := Read(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, f)
+		time.Sleep(time.Millisecond)
+	}
+	close(done)
+	wg.Wait()
+	if err := journal.Sync(); err != nil {
+		t.Fatal(err)
+	}
+
+	id, _ := journal.Position()
+	want := state(t, c)
+	for _, f := range files {
+		if f.JournalID != id {
+			t.Fatalf("snapshot journal id = %d, want %d", f.JournalID, id)
+		}
+
+		restored := cache.NewCache()
+		restored.Load(f.Items)
+		if _, err := aof.Replay(journalPath, f.JournalOffset, restored.Apply); err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(state(t, restored), want) {
+			t.Errorf("snapshot at offset %d plus the journal tail differs from the cache", f.JournalOffset)
+		}
+	}
+}